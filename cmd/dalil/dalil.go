@@ -1,22 +1,77 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 	"time"
 
+	apiKeysController "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/controller"
+	apiKeysDAO "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao"
+	apiKeysService "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/service"
+	attachmentsController "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/controller"
+	attachmentsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao"
+	attachmentsService "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/auth"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/blobstore"
+	boardsController "github.com/aeon-fruit/dalil.git/internal/pkg/boards/controller"
+	boardsService "github.com/aeon-fruit/dalil.git/internal/pkg/boards/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/certs"
+	checklistsController "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/controller"
+	checklistsService "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/service"
+	commentsController "github.com/aeon-fruit/dalil.git/internal/pkg/comments/controller"
+	commentsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao"
+	commentsService "github.com/aeon-fruit/dalil.git/internal/pkg/comments/service"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/config"
+	dependenciesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	healthController "github.com/aeon-fruit/dalil.git/internal/pkg/health/controller"
+	listsController "github.com/aeon-fruit/dalil.git/internal/pkg/lists/controller"
+	listsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	listsService "github.com/aeon-fruit/dalil.git/internal/pkg/lists/service"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/log"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/metrics"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/middleware"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	remindersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/notifier"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/scheduler"
+	rolesController "github.com/aeon-fruit/dalil.git/internal/pkg/roles/controller"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	rolesService "github.com/aeon-fruit/dalil.git/internal/pkg/roles/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/server"
+	tagsController "github.com/aeon-fruit/dalil.git/internal/pkg/tags/controller"
+	tagsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao"
+	tagsService "github.com/aeon-fruit/dalil.git/internal/pkg/tags/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/archiver"
 	controller "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/controller"
 	dao "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
 	service "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	templatesController "github.com/aeon-fruit/dalil.git/internal/pkg/templates/controller"
+	templatesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao"
+	templatesService "github.com/aeon-fruit/dalil.git/internal/pkg/templates/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	timeEntriesController "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/controller"
+	timeEntriesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao"
+	timeEntriesService "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tracing"
+	usersController "github.com/aeon-fruit/dalil.git/internal/pkg/users/controller"
+	usersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	usersService "github.com/aeon-fruit/dalil.git/internal/pkg/users/service"
+	wipLimitsController "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/controller"
+	wipLimitsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao"
+	wipLimitsService "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/service"
 	chiMiddleware "github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/go-logr/logr"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func main() {
@@ -24,16 +79,323 @@ func main() {
 
 	logger := log.New(appConfig, os.Stderr)
 
+	appMetrics := metrics.New()
+
+	appTracing, err := getTracing(appConfig.Tracing)
+	if err != nil {
+		logger.Error(err, "Failed to set up the tracing")
+		os.Exit(1)
+	}
+
+	tenants, quotas, err := getTenancy(appConfig.Tenancy)
+	if err != nil {
+		logger.Error(err, "Failed to load the tenant quotas", "path", appConfig.Tenancy.QuotasPath)
+		os.Exit(1)
+	}
+
+	tasksRepository := dao.New(dao.WithQuotas(quotas))
+	appMetrics.MustRegister(metrics.NewCountCollector("tasks", "Number of tasks not archived by status.", "status",
+		func() (map[string]int, error) {
+			return countByStatus(tenants, tasksRepository)
+		}))
+
+	tasksDAO := dao.Trace(dao.Instrument(tasksRepository, appMetrics), appTracing.TracerProvider())
+	listsRepository := listsDAO.Instrument(listsDAO.New(listsDAO.WithQuotas(quotas)), appMetrics)
+	rolesRepository := rolesDAO.New()
+	appPolicy := policy.New(policy.WithGrants(rolesRepository), policy.WithTasks(tasksDAO))
+	listsSvc := listsService.Authorize(
+		listsService.New(listsService.WithRepository(listsRepository), listsService.WithTasks(tasksDAO)), appPolicy)
+	tagsSvc := tagsService.New(tagsService.WithRepository(tagsDAO.New(tagsDAO.WithQuotas(quotas))), tagsService.WithTasks(tasksDAO))
+	commentsSvc := commentsService.New(commentsService.WithRepository(commentsDAO.New()), commentsService.WithTasks(tasksDAO))
+	attachmentsSvc := attachmentsService.New(
+		attachmentsService.WithRepository(attachmentsDAO.New(attachmentsDAO.WithQuotas(quotas))),
+		attachmentsService.WithTasks(tasksDAO),
+		attachmentsService.WithStore(getBlobStore(appConfig.Attachments)),
+		attachmentsService.WithMaxSize(appConfig.Attachments.MaxSize),
+	)
+	wipLimitsSvc := wipLimitsService.New(wipLimitsService.WithRepository(wipLimitsDAO.New()), wipLimitsService.WithLists(listsRepository))
+	timeEntriesSvc := timeEntriesService.New(timeEntriesService.WithRepository(timeEntriesDAO.New()), timeEntriesService.WithTasks(tasksDAO))
+	usersRepository := usersDAO.New(usersDAO.WithFile(appConfig.Users.StorePath))
+	usersSvc := usersService.New(usersService.WithRepository(usersRepository))
+	tasksService := service.Trace(service.Authorize(service.New(
+		service.WithRepository(tasksDAO),
+		service.WithUsers(usersRepository),
+		service.WithTags(tagsSvc),
+		service.WithLists(listsRepository),
+		service.WithDependencies(dependenciesDAO.New()),
+		service.WithLimits(wipLimitsSvc),
+		service.WithCleaners(commentsSvc, attachmentsSvc, timeEntriesSvc),
+		service.WithParentDeletion(service.ParentDeletion(appConfig.Tasks.ParentDeletion)),
+	), appPolicy), appTracing.TracerProvider())
+	rolesSvc := rolesService.Authorize(rolesService.New(
+		rolesService.WithRepository(rolesRepository),
+		rolesService.WithLists(listsRepository),
+		rolesService.WithUsers(usersRepository),
+	), appPolicy)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx = logr.NewContext(ctx, logger.WithName(constants.AppName))
+
+	remindersRepository := remindersDAO.Instrument(
+		remindersDAO.New(remindersDAO.WithFile(appConfig.Reminders.StorePath)), appMetrics)
+	remindersScheduler := getScheduler(appConfig.Reminders, logger, tasksService, remindersRepository, tenants)
+	remindersScheduler.Start(ctx)
+	tasksArchiver := archiver.New(
+		archiver.WithTasks(tasksService),
+		archiver.WithAge(appConfig.Tasks.ArchiveAfter),
+		archiver.WithInterval(appConfig.Tasks.ArchiveInterval),
+		archiver.WithTenants(tenants),
+	)
+	tasksArchiver.Start(ctx)
+
+	healthSvc := health.New(health.WithTimeout(appConfig.Health.CheckTimeout))
+	healthSvc.AddReadinessCheck("tasks", func(ctx context.Context) error {
+		_, err := tasksDAO.GetAll(ctx)
+		return err
+	})
+	healthSvc.AddReadinessCheck("lists", func(ctx context.Context) error {
+		_, err := listsRepository.GetAll(ctx)
+		return err
+	})
+	healthSvc.AddReadinessCheck("reminders", remindersScheduler.Check)
+	healthSvc.AddReadinessCheck("archiver", tasksArchiver.Check)
+
+	checklistsSvc := checklistsService.New(checklistsService.WithTasks(tasksDAO))
+	templatesSvc := templatesService.New(
+		templatesService.WithRepository(templatesDAO.New(templatesDAO.WithQuotas(quotas))),
+		templatesService.WithTasks(tasksService),
+		templatesService.WithChecklists(checklistsSvc),
+	)
+
+	apiKeysRepository := apiKeysDAO.New(apiKeysDAO.WithFile(appConfig.Auth.ApiKeysStorePath))
+	apiKeysSvc := apiKeysService.New(
+		apiKeysService.WithRepository(apiKeysRepository),
+		apiKeysService.WithUsers(usersRepository),
+	)
+
 	addr := fmt.Sprintf(":%v", appConfig.AppPort)
-	handler := getHandler(logger)
+	handler := getHandler(appConfig, logger, appMetrics, appTracing, getAuthenticator(appConfig, apiKeysSvc, usersSvc), tenants, services{
+		tasks:       tasksService,
+		tags:        tagsService.Authorize(tagsSvc, appPolicy),
+		lists:       listsSvc,
+		checklists:  checklistsService.Authorize(checklistsSvc, appPolicy),
+		comments:    commentsService.Authorize(commentsSvc, appPolicy),
+		attachments: attachmentsService.Authorize(attachmentsSvc, appPolicy),
+		timeEntries: timeEntriesService.Authorize(timeEntriesSvc, appPolicy),
+		templates:   templatesSvc,
+		wipLimits:   wipLimitsService.Authorize(wipLimitsSvc, appPolicy),
+		apiKeys:     apiKeysSvc,
+		users:       usersSvc,
+		roles:       rolesSvc,
+		health:      healthSvc,
+		boards: boardsService.New(
+			boardsService.WithTasks(tasksService),
+			boardsService.WithRepository(tasksDAO),
+			boardsService.WithLists(listsRepository),
+			boardsService.WithLimits(wipLimitsSvc),
+		),
+	})
+
+	tlsConfig, err := getTLSConfig(ctx, appConfig.TLS)
+	if err != nil {
+		logger.Error(err, "Failed to load the TLS certificates")
+		os.Exit(1)
+	}
+
+	if appConfig.Metrics.Port != 0 {
+		metricsAddr := fmt.Sprintf(":%v", appConfig.Metrics.Port)
+		go func() {
+			err := server.New(
+				server.WithAddr(metricsAddr),
+				server.WithHandler(getMetricsHandler(appMetrics)),
+				server.WithConfig(appConfig.Server),
+			).Run(ctx)
+			if err != nil {
+				logger.Error(err, "Metrics server failed", "addr", metricsAddr)
+			}
+		}()
+	}
+
+	err = server.New(
+		server.WithAddr(addr),
+		server.WithHandler(handler),
+		server.WithConfig(appConfig.Server),
+		server.WithTLS(tlsConfig),
+		server.WithHealth(healthSvc),
+		server.WithFlushers(remindersRepository, apiKeysRepository, usersRepository, appTracing),
+	).Run(ctx)
+	if err != nil {
+		logger.Error(err, "Server failed", "addr", addr)
+		os.Exit(1)
+	}
+}
+
+func getScheduler(remindersConfig config.RemindersConfig, logger log.Logger, tasksService service.Service,
+	remindersRepository remindersDAO.Repository, tenants tenancy.Registry) scheduler.Scheduler {
+
+	notifiers := []notifier.Notifier{notifier.NewLog(logger.WithName("reminders"))}
+	if remindersConfig.WebhookUrl != "" {
+		notifiers = append(notifiers, notifier.NewWebhook(remindersConfig.WebhookUrl))
+	}
+	if smtpConfig := remindersConfig.Smtp; smtpConfig.Addr != "" {
+		notifiers = append(notifiers, notifier.NewSmtp(smtpConfig.Addr, smtpConfig.From, smtpConfig.To,
+			notifier.WithCredentials(smtpConfig.Username, smtpConfig.Password)))
+	}
+
+	return scheduler.New(
+		scheduler.WithTasks(tasksService),
+		scheduler.WithRepository(remindersRepository),
+		scheduler.WithNotifiers(notifiers...),
+		scheduler.WithOffsets(remindersConfig.Offsets...),
+		scheduler.WithInterval(remindersConfig.Interval),
+		scheduler.WithMaxAttempts(remindersConfig.MaxAttempts),
+		scheduler.WithTenants(tenants),
+	)
+}
+
+func getTenancy(tenancyConfig config.TenancyConfig) (tenancy.Registry, tenancy.Quotas, error) {
+	overrides, err := tenancy.LoadOverrides(tenancyConfig.QuotasPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defaults := tenancy.Limits{}
+	for resource, limit := range tenancyConfig.Quotas {
+		defaults[tenancy.Resource(resource)] = limit
+	}
+	quotas := tenancy.NewQuotas(tenancy.WithDefaults(defaults), tenancy.WithOverrides(overrides))
+
+	if !tenancyConfig.Enabled {
+		return nil, quotas, nil
+	}
+
+	var known []string
+	for tenant := range overrides {
+		known = append(known, tenant)
+	}
+	return tenancy.NewRegistry(known...), quotas, nil
+}
+
+func countByStatus(tenants tenancy.Registry, tasksRepository dao.Repository) (map[string]int, error) {
+	counts := map[string]int{}
+	err := tenancy.Each(context.Background(), tenants, func(ctx context.Context) error {
+		tenantCounts, err := dao.CountByStatus(ctx, tasksRepository)
+		for status, count := range tenantCounts {
+			counts[status] += count
+		}
+		return err
+	})
+	return counts, err
+}
+
+func getTLSConfig(ctx context.Context, tlsConfig config.TLSConfig) (*tls.Config, error) {
+	if !tlsConfig.IsEnabled() {
+		return nil, nil
+	}
 
-	logger.Info("Server started", "addr", addr)
-	if err := http.ListenAndServe(addr, handler); err != nil {
-		logger.Error(err, "Failed to start the server", "addr", addr)
+	minVersion, err := certs.ParseVersion(tlsConfig.MinVersion)
+	if err != nil {
+		return nil, err
 	}
+
+	reloader := certs.New(tlsConfig.CertFile, tlsConfig.KeyFile,
+		certs.WithClientCA(tlsConfig.ClientCAFile),
+		certs.WithMinVersion(minVersion),
+		certs.WithInterval(tlsConfig.ReloadInterval))
+	if err = reloader.Reload(); err != nil {
+		return nil, err
+	}
+	reloader.Start(ctx)
+
+	return reloader.TLSConfig(), nil
+}
+
+func getTracing(tracingConfig config.TracingConfig) (tracing.Tracing, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch tracingConfig.Exporter {
+	case config.TracingExporterStdout:
+		exporter, err = tracing.NewStdoutExporter(os.Stdout)
+	case config.TracingExporterOtlp:
+		exporter, err = tracing.NewOtlpExporter(context.Background(), tracingConfig.OtlpEndpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tracing.New(tracing.WithExporter(exporter), tracing.WithSampleRatio(tracingConfig.SampleRatio)), nil
+}
+
+func getAuthenticator(appConfig config.AppConfig, apiKeysSvc apiKeysService.Service,
+	usersSvc usersService.Service) auth.Authenticator {
+
+	if !appConfig.Auth.Enabled {
+		return nil
+	}
+
+	var jwtAuthenticator auth.Authenticator
+	if jwtConfig := appConfig.Auth.Jwt; jwtConfig.IsEnabled() {
+		jwtAuthenticator = auth.NewJwt(
+			auth.WithHmacSecret(jwtConfig.HmacSecret),
+			auth.WithJwks(jwtConfig.JwksUrl, jwtConfig.JwksRefreshInterval, nil),
+			auth.WithIssuer(jwtConfig.Issuer),
+			auth.WithAudience(jwtConfig.Audience),
+			auth.WithAdminRole(jwtConfig.AdminRole),
+			auth.WithTenantClaim(getTenantClaim(appConfig)),
+		)
+	}
+
+	return auth.ResolveUsers(auth.Chain(
+		auth.NewAdminToken(appConfig.AdminToken),
+		auth.NewApiKey(apiKeysSvc),
+		jwtAuthenticator,
+	), usersSvc)
+}
+
+func getTenantClaim(appConfig config.AppConfig) string {
+	if !appConfig.Tenancy.Enabled {
+		return ""
+	}
+	return appConfig.Auth.Jwt.TenantClaim
+}
+
+func getBlobStore(attachmentsConfig config.AttachmentsConfig) blobstore.BlobStore {
+	if attachmentsConfig.Store == config.AttachmentsStoreS3 {
+		s3Config := attachmentsConfig.S3
+		return blobstore.NewS3(s3Config.Endpoint, s3Config.Bucket,
+			blobstore.WithRegion(s3Config.Region),
+			blobstore.WithCredentials(s3Config.AccessKey, s3Config.SecretKey))
+	}
+	return blobstore.NewLocal(attachmentsConfig.LocalPath)
+}
+
+type services struct {
+	tasks       service.Service
+	tags        tagsService.Service
+	lists       listsService.Service
+	checklists  checklistsService.Service
+	comments    commentsService.Service
+	attachments attachmentsService.Service
+	timeEntries timeEntriesService.Service
+	templates   templatesService.Service
+	wipLimits   wipLimitsService.Service
+	apiKeys     apiKeysService.Service
+	users       usersService.Service
+	roles       rolesService.Service
+	boards      boardsService.Service
+	health      health.Health
+}
+
+func getMetricsHandler(appMetrics metrics.Metrics) http.Handler {
+	r := chi.NewRouter()
+	r.Method(http.MethodGet, "/metrics", appMetrics.Handler())
+	return r
 }
 
-func getHandler(logger log.Logger) http.Handler {
+func getHandler(appConfig config.AppConfig, logger log.Logger, appMetrics metrics.Metrics, appTracing tracing.Tracing,
+	authenticator auth.Authenticator, tenants tenancy.Registry, services services) http.Handler {
+
 	chiMiddleware.DefaultLogger = chiMiddleware.RequestLogger(&chiMiddleware.DefaultLogFormatter{
 		Logger:  logger,
 		NoColor: runtime.GOOS != "windows",
@@ -41,41 +403,259 @@ func getHandler(logger log.Logger) http.Handler {
 
 	r := chi.NewRouter()
 
+	r.Use(appMetrics.Middleware)
 	r.Use(middleware.LoggingContext(logger))
+	r.Use(appTracing.Middleware)
+	r.Use(middleware.ClientContext)
 	r.Use(chiMiddleware.RequestID)
 	r.Use(chiMiddleware.Logger)
 	r.Use(chiMiddleware.Recoverer)
 	r.Use(chiMiddleware.Timeout(60 * time.Second))
+	r.Use(middleware.AdminContext(appConfig.AdminToken))
 
 	r.Use(render.SetContentType(render.ContentTypeJSON))
 
+	if appConfig.Metrics.Port == 0 {
+		r.Method(http.MethodGet, "/metrics", appMetrics.Handler())
+	}
+
 	r.Route("/api/", func(r chi.Router) {
-		r.Route("/v1/", v1())
+		r.Route("/v1/", v1(authenticator, getTenantContext(appConfig.Tenancy, tenants), services))
 	})
 
 	return r
 }
 
-func v1() func(r chi.Router) {
+func getTenantContext(tenancyConfig config.TenancyConfig, tenants tenancy.Registry) func(http.Handler) http.Handler {
+	if !tenancyConfig.Enabled {
+		return nil
+	}
+	return middleware.TenantContext(tenancyConfig.Header, tenants)
+}
+
+func v1(authenticator auth.Authenticator, tenantContext func(http.Handler) http.Handler,
+	services services) func(r chi.Router) {
+	tasksCtrl := controller.New(controller.WithService(services.tasks))
+	tagsCtrl := tagsController.New(tagsController.WithService(services.tags))
+	listsCtrl := listsController.New(listsController.WithService(services.lists))
+	checklistsCtrl := checklistsController.New(checklistsController.WithService(services.checklists))
+	commentsCtrl := commentsController.New(commentsController.WithService(services.comments))
+	attachmentsCtrl := attachmentsController.New(attachmentsController.WithService(services.attachments))
+	timeEntriesCtrl := timeEntriesController.New(timeEntriesController.WithService(services.timeEntries))
+	templatesCtrl := templatesController.New(templatesController.WithService(services.templates))
+	boardsCtrl := boardsController.New(boardsController.WithService(services.boards))
+	wipLimitsCtrl := wipLimitsController.New(wipLimitsController.WithService(services.wipLimits))
+	apiKeysCtrl := apiKeysController.New(apiKeysController.WithService(services.apiKeys))
+	usersCtrl := usersController.New(usersController.WithService(services.users))
+	rolesCtrl := rolesController.New(rolesController.WithService(services.roles))
+	healthCtrl := healthController.New(healthController.WithHealth(services.health))
+
 	return func(r chi.Router) {
-		r.Route("/tasks", tasksRouter())
+		r.Get("/k8s/readiness", healthCtrl.Readiness)
+		r.Get("/k8s/liveness", healthCtrl.Liveness)
+
+		r.Group(func(r chi.Router) {
+			if authenticator != nil {
+				r.Use(middleware.Authentication(authenticator))
+			}
+			if tenantContext != nil {
+				r.Use(tenantContext)
+			}
+
+			r.Get("/tasks:next", tasksCtrl.GetNext)
+			r.Post("/tasks:quick", tasksCtrl.QuickAdd)
+			r.Route("/tasks", tasksRouter(tasksCtrl, tagsCtrl, checklistsCtrl, commentsCtrl, attachmentsCtrl, timeEntriesCtrl))
+			r.Route("/tags", tagsRouter(tagsCtrl))
+			r.Route("/lists", listsRouter(listsCtrl, tasksCtrl, rolesCtrl))
+			r.Route("/templates", templatesRouter(templatesCtrl))
+			r.Route("/boards", boardsRouter(boardsCtrl))
+			r.Route("/wip-limits", wipLimitsRouter(wipLimitsCtrl))
+			r.Route("/api-keys", apiKeysRouter(apiKeysCtrl))
+			r.Route("/users", usersRouter(usersCtrl))
+			r.Get("/reports/time", timeEntriesCtrl.GetReport)
+		})
 	}
 }
 
-func tasksRouter() func(r chi.Router) {
-	tasksDAO := dao.New()
-	tasksService := service.New(service.WithRepository(tasksDAO))
-	tasksCtrl := controller.New(controller.WithService(tasksService))
+func tasksRouter(tasksCtrl controller.Controller, tagsCtrl tagsController.Controller,
+	checklistsCtrl checklistsController.Controller, commentsCtrl commentsController.Controller,
+	attachmentsCtrl attachmentsController.Controller, timeEntriesCtrl timeEntriesController.Controller) func(r chi.Router) {
 
 	return func(r chi.Router) {
 		r.Get("/", tasksCtrl.GetAll)
 		r.Post("/", tasksCtrl.Add)
+		r.With(middleware.PathParamContextInt(constants.Id)).Post("/{id}:move", tasksCtrl.Move)
+		r.With(middleware.PathParamContextInt(constants.Id)).Post("/{id}:clone", tasksCtrl.Clone)
+		r.With(middleware.PathParamContextInt(constants.Id)).Post("/{id}:archive", tasksCtrl.Archive)
+		r.With(middleware.PathParamContextInt(constants.Id)).Post("/{id}:unarchive", tasksCtrl.Unarchive)
 
 		r.Route("/{id}", func(r chi.Router) {
 			r.Use(middleware.PathParamContextInt(constants.Id))
 			r.Get("/", tasksCtrl.GetById)
 			r.Put("/", tasksCtrl.Update)
 			r.Delete("/", tasksCtrl.RemoveById)
+			r.Get("/occurrences", tasksCtrl.GetOccurrences)
+			r.Get("/children", tasksCtrl.GetChildren)
+			r.Get("/tree", tasksCtrl.GetTree)
+			r.Get("/dependencies", tasksCtrl.GetDependencies)
+			r.Get("/tags", tagsCtrl.GetByTaskId)
+
+			r.Route("/tags/{tagId}", func(r chi.Router) {
+				r.Use(middleware.PathParamContextInt(constants.TagId))
+				r.Put("/", tagsCtrl.Attach)
+				r.Delete("/", tagsCtrl.Detach)
+			})
+
+			r.Route("/checklist", func(r chi.Router) {
+				r.Get("/", checklistsCtrl.GetAll)
+				r.Post("/", checklistsCtrl.Add)
+
+				itemIdContext := middleware.PathParamContextInt(constants.ItemId)
+				r.With(itemIdContext).Post("/{itemId}:toggle", checklistsCtrl.Toggle)
+				r.With(itemIdContext).Post("/{itemId}:move", checklistsCtrl.Move)
+				r.With(itemIdContext).Delete("/{itemId}", checklistsCtrl.RemoveById)
+			})
+
+			r.Route("/comments", func(r chi.Router) {
+				r.Get("/", commentsCtrl.GetByTaskId)
+				r.Post("/", commentsCtrl.Add)
+
+				r.Route("/{commentId}", func(r chi.Router) {
+					r.Use(middleware.PathParamContextInt(constants.CommentId))
+					r.Put("/", commentsCtrl.Update)
+					r.Delete("/", commentsCtrl.RemoveById)
+				})
+			})
+
+			r.Route("/attachments", func(r chi.Router) {
+				r.Get("/", attachmentsCtrl.GetByTaskId)
+				r.Post("/", attachmentsCtrl.Add)
+
+				r.Route("/{attachmentId}", func(r chi.Router) {
+					r.Use(middleware.PathParamContextInt(constants.AttachmentId))
+					r.Get("/", attachmentsCtrl.Download)
+					r.Delete("/", attachmentsCtrl.RemoveById)
+				})
+			})
+
+			r.Route("/time", func(r chi.Router) {
+				r.Get("/", timeEntriesCtrl.GetByTaskId)
+				r.Post("/", timeEntriesCtrl.Add)
+				r.With(middleware.PathParamContextInt(constants.EntryId)).Delete("/{entryId}", timeEntriesCtrl.RemoveById)
+			})
+			r.Post("/time:start", timeEntriesCtrl.Start)
+			r.Post("/time:stop", timeEntriesCtrl.Stop)
+
+			r.Route("/dependencies/{blockerId}", func(r chi.Router) {
+				r.Use(middleware.PathParamContextInt(constants.BlockerId))
+				r.Put("/", tasksCtrl.AddDependency)
+				r.Delete("/", tasksCtrl.RemoveDependency)
+			})
+		})
+	}
+}
+
+func tagsRouter(tagsCtrl tagsController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Get("/", tagsCtrl.GetAll)
+		r.Post("/", tagsCtrl.Add)
+
+		r.Route("/{id}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.Id))
+			r.Get("/", tagsCtrl.GetById)
+			r.Put("/", tagsCtrl.Update)
+			r.Delete("/", tagsCtrl.RemoveById)
+		})
+	}
+}
+
+func listsRouter(listsCtrl listsController.Controller, tasksCtrl controller.Controller,
+	rolesCtrl rolesController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Get("/", listsCtrl.GetAll)
+		r.Post("/", listsCtrl.Add)
+
+		r.Route("/{listId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.ListId))
+			r.Get("/", listsCtrl.GetById)
+			r.Put("/", listsCtrl.Update)
+			r.Delete("/", listsCtrl.RemoveById)
+			r.Get("/tasks", tasksCtrl.GetAll)
+			r.Post("/tasks", tasksCtrl.Add)
+			r.Get("/roles", rolesCtrl.GetByList)
+
+			r.Route("/roles/{userId}", func(r chi.Router) {
+				r.Use(middleware.PathParamContextInt(constants.UserId))
+				r.Put("/", rolesCtrl.Grant)
+				r.Delete("/", rolesCtrl.Revoke)
+			})
+		})
+	}
+}
+
+func templatesRouter(templatesCtrl templatesController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Get("/", templatesCtrl.GetAll)
+		r.Post("/", templatesCtrl.Add)
+		r.With(middleware.PathParamContextInt(constants.TemplateId)).Post("/{templateId}:instantiate", templatesCtrl.Instantiate)
+
+		r.Route("/{templateId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.TemplateId))
+			r.Get("/", templatesCtrl.GetById)
+			r.Put("/", templatesCtrl.Update)
+			r.Delete("/", templatesCtrl.RemoveById)
+		})
+	}
+}
+
+func boardsRouter(boardsCtrl boardsController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Route("/{listId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.ListId))
+			r.Get("/", boardsCtrl.GetById)
+			r.With(middleware.PathParamContextInt(constants.Id)).Post("/tasks/{id}:move", boardsCtrl.Move)
+		})
+	}
+}
+
+func wipLimitsRouter(wipLimitsCtrl wipLimitsController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Get("/", wipLimitsCtrl.GetAll)
+		r.Post("/", wipLimitsCtrl.Add)
+
+		r.Route("/{limitId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.LimitId))
+			r.Get("/", wipLimitsCtrl.GetById)
+			r.Put("/", wipLimitsCtrl.Update)
+			r.Delete("/", wipLimitsCtrl.RemoveById)
+		})
+	}
+}
+
+func apiKeysRouter(apiKeysCtrl apiKeysController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Use(middleware.RequireAdmin)
+		r.Get("/", apiKeysCtrl.GetAll)
+		r.Post("/", apiKeysCtrl.Add)
+
+		r.Route("/{keyId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.KeyId))
+			r.Get("/", apiKeysCtrl.GetById)
+			r.Delete("/", apiKeysCtrl.RemoveById)
+		})
+	}
+}
+
+func usersRouter(usersCtrl usersController.Controller) func(r chi.Router) {
+	return func(r chi.Router) {
+		r.Get("/", usersCtrl.GetAll)
+		r.With(middleware.RequireAdmin).Post("/", usersCtrl.Add)
+		r.With(middleware.RequireAdmin, middleware.PathParamContextInt(constants.UserId)).
+			Post("/{userId}:deactivate", usersCtrl.Deactivate)
+
+		r.Route("/{userId}", func(r chi.Router) {
+			r.Use(middleware.PathParamContextInt(constants.UserId))
+			r.Get("/", usersCtrl.GetById)
 		})
 	}
 }
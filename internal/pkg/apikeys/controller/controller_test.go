@@ -0,0 +1,125 @@
+package controller_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/apikeys/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		keysCtrl    controller.Controller
+	)
+
+	withKeyId := func(request *http.Request, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), constants.KeyId, value))
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		keysCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("responds with status NoContent when there are no keys", func() {
+			mockService.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+
+			keysCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("GetById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(model.GetApiKeyResponse{}, err)
+
+				keysCtrl.GetById(recorder, withKeyId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("found", nil, http.StatusOK),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("Add", func() {
+		It("responds with status BadRequest when the name is blank", func() {
+			keysCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":" "}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status Created and the plaintext key in the payload", func() {
+			mockService.EXPECT().Add(gomock.Any(), model.AddApiKeyRequest{Name: "ci"}).Return(model.AddApiKeyResponse{
+				GetApiKeyResponse: model.GetApiKeyResponse{Id: 2, Name: "ci", Prefix: "abcd"},
+				Key:               "dalil_abcd_secret",
+			}, nil)
+
+			keysCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"ci"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/2"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"key":"dalil_abcd_secret"`))
+		})
+
+		It("responds with status BadRequest when the user is unknown", func() {
+			mockService.EXPECT().Add(gomock.Any(), gomock.Any()).Return(model.AddApiKeyResponse{}, errors.ErrInvalidArgument)
+
+			keysCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"ci","userId":5}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status InternalServerError when the service fails", func() {
+			mockService.EXPECT().Add(gomock.Any(), gomock.Any()).Return(model.AddApiKeyResponse{}, fmt.Errorf("custom error"))
+
+			keysCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"ci"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(err)
+
+				keysCtrl.RemoveById(recorder, withKeyId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("removed", nil, http.StatusNoContent),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+		)
+	})
+
+})
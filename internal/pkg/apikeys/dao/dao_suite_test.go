@@ -0,0 +1,13 @@
+package repository_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDao(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ApiKeys Dao Suite")
+}
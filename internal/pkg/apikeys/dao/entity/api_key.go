@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type ApiKey struct {
+	Id         int        `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	Name       string     `json:"name" gorm:"column:name;type:varchar;size:255"`
+	Prefix     string     `json:"prefix" gorm:"column:prefix;type:varchar;size:16;uniqueIndex"`
+	Hash       string     `json:"hash" gorm:"column:hash;type:varchar;size:64"`
+	Admin      bool       `json:"admin" gorm:"column:admin;type:bool"`
+	UserId     *int       `json:"userId,omitempty" gorm:"column:user_id;type:int"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"column:created_at;type:timestamp"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" gorm:"column:last_used_at;type:timestamp"`
+	Tenant     string     `json:"tenant,omitempty" gorm:"column:tenant;type:varchar;size:63;index"`
+}
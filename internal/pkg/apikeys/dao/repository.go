@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.ApiKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (entity.ApiKey, error)
+	GetAll(ctx context.Context) ([]entity.ApiKey, error)
+	Insert(ctx context.Context, key entity.ApiKey) (entity.ApiKey, error)
+	Touch(ctx context.Context, id int, usedAt time.Time) error
+	RemoveById(ctx context.Context, id int) (entity.ApiKey, error)
+	Flush() error
+}
+
+type snapshot struct {
+	Seq  int             `json:"seq,omitempty"`
+	Seqs map[string]int  `json:"seqs"`
+	Keys []entity.ApiKey `json:"keys"`
+}
+
+type memoryRepository struct {
+	mutex sync.RWMutex
+	keys  map[string]map[int]entity.ApiKey
+	seqs  map[string]int
+	path  string
+	err   error
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		keys: map[string]map[int]entity.ApiKey{},
+		seqs: map[string]int{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	if instance.path != "" {
+		instance.err = instance.load()
+	}
+
+	return &instance
+}
+
+func WithFile(path string) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.path = path
+		}
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.ApiKey, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return entity.ApiKey{}, repo.err
+	}
+
+	key, found := repo.keys[tenancy.Of(ctx)][id]
+	if !found {
+		return entity.ApiKey{}, errors.ErrNotFound
+	}
+	return key, nil
+}
+
+func (repo *memoryRepository) GetByPrefix(ctx context.Context, prefix string) (entity.ApiKey, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return entity.ApiKey{}, repo.err
+	}
+
+	tenant, scoped := tenancy.Scoped(ctx)
+	for owner, keys := range repo.keys {
+		if scoped && owner != tenant {
+			continue
+		}
+		for _, key := range keys {
+			if key.Prefix == prefix {
+				return key, nil
+			}
+		}
+	}
+	return entity.ApiKey{}, errors.ErrNotFound
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.ApiKey, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return nil, repo.err
+	}
+
+	return repo.sorted(tenancy.Of(ctx)), nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, key entity.ApiKey) (entity.ApiKey, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.ApiKey{}, repo.err
+	}
+
+	for _, others := range repo.keys {
+		for _, other := range others {
+			if other.Prefix == key.Prefix {
+				return entity.ApiKey{}, errors.ErrConflict
+			}
+		}
+	}
+
+	tenant := tenancy.Of(ctx)
+	keys := repo.partition(tenant)
+	key.Id = repo.seqs[tenant]
+	key.Tenant = tenant
+	key.CreatedAt = time.Now()
+	keys[key.Id] = key
+	repo.seqs[tenant]++
+	if err := repo.save(); err != nil {
+		delete(keys, key.Id)
+		repo.seqs[tenant]--
+		return entity.ApiKey{}, err
+	}
+	return key, nil
+}
+
+func (repo *memoryRepository) Touch(ctx context.Context, id int, usedAt time.Time) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return repo.err
+	}
+
+	keys := repo.keys[tenancy.Of(ctx)]
+	key, found := keys[id]
+	if !found {
+		return errors.ErrNotFound
+	}
+
+	key.LastUsedAt = &usedAt
+	keys[id] = key
+	return nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.ApiKey, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.ApiKey{}, repo.err
+	}
+
+	keys := repo.keys[tenancy.Of(ctx)]
+	key, found := keys[id]
+	if !found {
+		return entity.ApiKey{}, errors.ErrNotFound
+	}
+
+	delete(keys, id)
+	if err := repo.save(); err != nil {
+		keys[id] = key
+		return entity.ApiKey{}, err
+	}
+	return key, nil
+}
+
+func (repo *memoryRepository) Flush() error {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return repo.err
+	}
+
+	return repo.save()
+}
+
+func (repo *memoryRepository) partition(tenant string) map[int]entity.ApiKey {
+	keys, found := repo.keys[tenant]
+	if !found {
+		keys = map[int]entity.ApiKey{}
+		repo.keys[tenant] = keys
+	}
+	return keys
+}
+
+func (repo *memoryRepository) sorted(tenants ...string) []entity.ApiKey {
+	var keys []entity.ApiKey
+	for _, tenant := range tenants {
+		for _, key := range repo.keys[tenant] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Tenant != keys[j].Tenant {
+			return keys[i].Tenant < keys[j].Tenant
+		}
+		return keys[i].Id < keys[j].Id
+	})
+	return keys
+}
+
+func (repo *memoryRepository) load() error {
+	state := snapshot{}
+	err := persistence.ReadJSON(repo.path, &state)
+	if err == errors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for tenant, seq := range state.Seqs {
+		repo.seqs[tenant] = seq
+	}
+	if state.Seqs == nil {
+		repo.seqs[tenancy.DefaultTenant] = state.Seq
+	}
+	for _, key := range state.Keys {
+		if key.Tenant == "" {
+			key.Tenant = tenancy.DefaultTenant
+		}
+		repo.partition(key.Tenant)[key.Id] = key
+		if key.Id >= repo.seqs[key.Tenant] {
+			repo.seqs[key.Tenant] = key.Id + 1
+		}
+	}
+	return nil
+}
+
+func (repo *memoryRepository) save() error {
+	if repo.path == "" {
+		return nil
+	}
+
+	var tenants []string
+	for tenant := range repo.keys {
+		tenants = append(tenants, tenant)
+	}
+	return persistence.WriteJSON(repo.path, snapshot{
+		Seqs: repo.seqs,
+		Keys: repo.sorted(tenants...),
+	})
+}
@@ -0,0 +1,172 @@
+package repository_test
+
+import (
+	"context"
+
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	key := func(prefix string) entity.ApiKey {
+		return entity.ApiKey{Name: "ci", Prefix: prefix, Hash: "hash-" + prefix}
+	}
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(repository.New()).NotTo(BeNil())
+		})
+	})
+
+	Context("in memory", func() {
+		var repo repository.Repository
+
+		BeforeEach(func() {
+			repo = repository.New()
+		})
+
+		It("assigns sequential ids and the creation date on insert", func() {
+			first, err := repo.Insert(ctx, key("a"))
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, key("b"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first.Id).To(Equal(0))
+			Expect(second.Id).To(Equal(1))
+			Expect(first.CreatedAt).NotTo(BeZero())
+			Expect(repo.GetAll(ctx)).To(Equal([]entity.ApiKey{first, second}))
+		})
+
+		It("rejects a duplicate prefix", func() {
+			_, err := repo.Insert(ctx, key("a"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.Insert(ctx, key("a"))).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("finds the keys by id and by prefix", func() {
+			inserted, _ := repo.Insert(ctx, key("a"))
+
+			Expect(repo.GetById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetByPrefix(ctx, "a")).To(Equal(inserted))
+			Expect(repo.GetById(ctx, 10)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByPrefix(ctx, "b")).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("records the last use of existing keys only", func() {
+			inserted, _ := repo.Insert(ctx, key("a"))
+			usedAt := time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+
+			Expect(repo.Touch(ctx, inserted.Id, usedAt)).To(Succeed())
+			Expect(repo.GetById(ctx, inserted.Id)).To(HaveField("LastUsedAt", HaveValue(Equal(usedAt))))
+			Expect(repo.Touch(ctx, 10, usedAt)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("removes existing keys only", func() {
+			inserted, _ := repo.Insert(ctx, key("a"))
+
+			Expect(repo.RemoveById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+			Expect(repo.RemoveById(ctx, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Context("backed by a file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "api-keys.json")
+		})
+
+		It("survives a restart", func() {
+			repo := repository.New(repository.WithFile(path))
+			first, err := repo.Insert(ctx, key("a"))
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, key("b"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = repo.RemoveById(ctx, first.Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			restarted := repository.New(repository.WithFile(path))
+
+			Expect(restarted.GetAll(ctx)).To(HaveExactElements(HaveField("Prefix", second.Prefix)))
+			Expect(restarted.Insert(ctx, key("c"))).To(HaveField("Id", 2))
+		})
+
+		It("writes the last uses to the file on flush", func() {
+			repo := repository.New(repository.WithFile(path))
+			inserted, err := repo.Insert(ctx, key("a"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repo.Touch(ctx, inserted.Id, time.Now())).To(Succeed())
+
+			Expect(repo.Flush()).To(Succeed())
+
+			Expect(repository.New(repository.WithFile(path)).GetById(ctx, inserted.Id)).To(HaveField("LastUsedAt", Not(BeNil())))
+		})
+
+		When("the file cannot be loaded", func() {
+			It("fails every operation", func() {
+				Expect(os.WriteFile(path, []byte("not json"), 0o644)).To(Succeed())
+
+				repo := repository.New(repository.WithFile(path))
+
+				Expect(repo.GetAll(ctx)).Error().To(HaveOccurred())
+				Expect(repo.GetById(ctx, 0)).Error().To(HaveOccurred())
+				Expect(repo.GetByPrefix(ctx, "a")).Error().To(HaveOccurred())
+				Expect(repo.Insert(ctx, key("a"))).Error().To(HaveOccurred())
+				Expect(repo.Touch(ctx, 0, time.Now())).NotTo(Succeed())
+				Expect(repo.RemoveById(ctx, 0)).Error().To(HaveOccurred())
+				Expect(repo.Flush()).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+		globex := tenancy.With(ctx, "globex")
+
+		It("keeps the keys of each tenant apart", func() {
+			repo := repository.New()
+			inserted, _ := repo.Insert(acme, key("abc"))
+
+			Expect(inserted.Tenant).To(Equal("acme"))
+			Expect(repo.GetById(globex, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetById(ctx, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByPrefix(globex, "abc")).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(globex)).To(BeEmpty())
+			Expect(repo.Touch(globex, inserted.Id, time.Now())).To(Equal(errors.ErrNotFound))
+			Expect(repo.RemoveById(globex, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+
+			Expect(repo.GetById(acme, inserted.Id)).To(Equal(inserted))
+		})
+
+		It("finds the keys of every tenant by prefix from unscoped contexts", func() {
+			repo := repository.New()
+			inserted, _ := repo.Insert(acme, key("abc"))
+
+			Expect(repo.GetByPrefix(ctx, "abc")).To(Equal(inserted))
+		})
+
+		It("scopes the ids to the tenant and the prefixes to none", func() {
+			repo := repository.New()
+
+			Expect(repo.Insert(acme, key("a"))).To(HaveField("Id", 0))
+			Expect(repo.Insert(acme, key("b"))).To(HaveField("Id", 1))
+			Expect(repo.Insert(globex, key("c"))).To(HaveField("Id", 0))
+			Expect(repo.Insert(globex, key("a"))).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+})
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao/entity"
+)
+
+type GetApiKeyResponse struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Admin      bool       `json:"admin"`
+	UserId     *int       `json:"userId,omitempty"`
+	Tenant     string     `json:"tenant,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+func EntityToGetApiKeyResponse(entity entity.ApiKey) GetApiKeyResponse {
+	return GetApiKeyResponse{
+		Id:         entity.Id,
+		Name:       entity.Name,
+		Prefix:     entity.Prefix,
+		Admin:      entity.Admin,
+		UserId:     entity.UserId,
+		Tenant:     entity.Tenant,
+		CreatedAt:  entity.CreatedAt,
+		LastUsedAt: entity.LastUsedAt,
+	}
+}
+
+type AddApiKeyRequest struct {
+	Name   string `json:"name"`
+	Admin  bool   `json:"admin,omitempty"`
+	UserId *int   `json:"userId,omitempty"`
+}
+
+func (dto AddApiKeyRequest) IsValid() bool {
+	name := strings.TrimSpace(dto.Name)
+	return name != "" && len(name) <= 255 && (dto.UserId == nil || *dto.UserId >= 0)
+}
+
+func (dto AddApiKeyRequest) ToEntity() entity.ApiKey {
+	return entity.ApiKey{
+		Name:   strings.TrimSpace(dto.Name),
+		Admin:  dto.Admin,
+		UserId: dto.UserId,
+	}
+}
+
+type AddApiKeyResponse struct {
+	GetApiKeyResponse
+	Key string `json:"key"`
+}
@@ -0,0 +1,47 @@
+package model_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/model"
+)
+
+var _ = Describe("Model", func() {
+
+	DescribeTable("AddApiKeyRequest.IsValid",
+		func(request model.AddApiKeyRequest, expected bool) {
+			Expect(request.IsValid()).To(Equal(expected))
+		},
+		Entry("a named key", model.AddApiKeyRequest{Name: "ci"}, true),
+		Entry("a named admin key", model.AddApiKeyRequest{Name: "ops", Admin: true}, true),
+		Entry("a key bound to a user", model.AddApiKeyRequest{Name: "ci", UserId: new(int)}, true),
+		Entry("a blank name", model.AddApiKeyRequest{Name: "  "}, false),
+		Entry("a too long name", model.AddApiKeyRequest{Name: strings.Repeat("a", 256)}, false),
+	)
+
+	Describe("AddApiKeyRequest.ToEntity", func() {
+		It("trims the name", func() {
+			Expect(model.AddApiKeyRequest{Name: " ci ", Admin: true}.ToEntity()).
+				To(Equal(entity.ApiKey{Name: "ci", Admin: true}))
+		})
+	})
+
+	Describe("EntityToGetApiKeyResponse", func() {
+		It("never exposes the hash", func() {
+			response := model.EntityToGetApiKeyResponse(entity.ApiKey{Id: 1, Name: "ci", Prefix: "abc", Hash: "secret"})
+
+			Expect(response).To(Equal(model.GetApiKeyResponse{Id: 1, Name: "ci", Prefix: "abc"}))
+		})
+
+		It("exposes the tenant of the key", func() {
+			response := model.EntityToGetApiKeyResponse(entity.ApiKey{Id: 1, Name: "ci", Prefix: "abc", Tenant: "acme"})
+
+			Expect(response.Tenant).To(Equal("acme"))
+		})
+	})
+
+})
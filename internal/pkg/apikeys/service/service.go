@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	usersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+)
+
+const (
+	keyScheme    = "dalil"
+	prefixLength = 4
+	secretLength = 24
+	maxAttempts  = 3
+)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetApiKeyResponse, error)
+	GetAll(ctx context.Context) ([]model.GetApiKeyResponse, error)
+	Add(ctx context.Context, request model.AddApiKeyRequest) (model.AddApiKeyResponse, error)
+	RemoveById(ctx context.Context, id int) error
+	Authenticate(ctx context.Context, key string) (reqctx.Principal, error)
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	users      usersDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithUsers(users usersDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.users = users
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetApiKeyResponse, error) {
+	key, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetApiKeyResponse{}, err
+	}
+	return model.EntityToGetApiKeyResponse(key), nil
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context) ([]model.GetApiKeyResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetApiKeyResponse
+	for _, key := range entities {
+		dto = append(dto, model.EntityToGetApiKeyResponse(key))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, request model.AddApiKeyRequest) (model.AddApiKeyResponse, error) {
+	key := request.ToEntity()
+	if err := service.checkUser(ctx, key.UserId); err != nil {
+		return model.AddApiKeyResponse{}, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		prefix, err := randomHex(prefixLength)
+		if err != nil {
+			return model.AddApiKeyResponse{}, err
+		}
+		secret, err := randomHex(secretLength)
+		if err != nil {
+			return model.AddApiKeyResponse{}, err
+		}
+
+		plaintext := strings.Join([]string{keyScheme, prefix, secret}, "_")
+		key.Prefix = prefix
+		key.Hash = hash(plaintext)
+
+		inserted, err := service.repository.Insert(ctx, key)
+		if err == errors.ErrConflict && attempt < maxAttempts {
+			continue
+		}
+		if err != nil {
+			return model.AddApiKeyResponse{}, err
+		}
+
+		return model.AddApiKeyResponse{
+			GetApiKeyResponse: model.EntityToGetApiKeyResponse(inserted),
+			Key:               plaintext,
+		}, nil
+	}
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	_, err := service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) Authenticate(ctx context.Context, plaintext string) (reqctx.Principal, error) {
+	parts := strings.Split(plaintext, "_")
+	if len(parts) != 3 || parts[0] != keyScheme || parts[1] == "" || parts[2] == "" {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+
+	key, err := service.repository.GetByPrefix(ctx, parts[1])
+	if err == errors.ErrNotFound {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+	if err != nil {
+		return reqctx.Principal{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash(plaintext)), []byte(key.Hash)) != 1 {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+
+	_ = service.repository.Touch(tenancy.With(ctx, key.Tenant), key.Id, time.Now())
+
+	return reqctx.Principal{
+		Id:     strconv.Itoa(key.Id),
+		Name:   key.Name,
+		Method: reqctx.AuthMethodApiKey,
+		Admin:  key.Admin,
+		UserId: key.UserId,
+		Tenant: key.Tenant,
+	}, nil
+}
+
+func (service *serviceImpl) checkUser(ctx context.Context, userId *int) error {
+	if userId == nil || service.users == nil {
+		return nil
+	}
+
+	user, err := service.users.GetById(ctx, *userId)
+	if err == errors.ErrNotFound {
+		return errors.ErrInvalidArgument
+	}
+	if err != nil {
+		return err
+	}
+	if !user.IsActive() {
+		return errors.ErrInvalidArgument
+	}
+	return nil
+}
+
+func hash(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(size int) (string, error) {
+	buffer := make([]byte, size)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buffer), nil
+}
@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	usersEntity "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/apikeys/dao"
+	usersDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/users/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		keysSvc        service.Service
+	)
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		keysSvc = service.New(service.WithRepository(mockRepository))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Add", func() {
+		It("stores the hash of a generated key and returns the key once", func() {
+			var stored entity.ApiKey
+			mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key entity.ApiKey) (entity.ApiKey, error) {
+				stored = key
+				key.Id = 4
+				return key, nil
+			})
+
+			created, err := keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Id).To(Equal(4))
+			Expect(created.Key).To(HavePrefix("dalil_" + stored.Prefix + "_"))
+			Expect(stored.Hash).To(HaveLen(64))
+			Expect(stored.Hash).NotTo(ContainSubstring(strings.Split(created.Key, "_")[2]))
+		})
+
+		It("generates another key when the prefix is taken", func() {
+			gomock.InOrder(
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.ApiKey{}, errors.ErrConflict),
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key entity.ApiKey) (entity.ApiKey, error) {
+					return key, nil
+				}),
+			)
+
+			Expect(keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci"})).Error().NotTo(HaveOccurred())
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.ApiKey{}, customErr)
+
+			Expect(keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci"})).Error().To(Equal(customErr))
+		})
+
+		Context("bound to a user", func() {
+			var (
+				userId    int
+				mockUsers *usersDaoMock.MockRepository
+			)
+
+			BeforeEach(func() {
+				userId = 5
+				mockUsers = usersDaoMock.NewMockRepository(mockCtrl)
+				keysSvc = service.New(service.WithRepository(mockRepository), service.WithUsers(mockUsers))
+			})
+
+			It("stores the user of the key", func() {
+				mockUsers.EXPECT().GetById(gomock.Any(), userId).Return(usersEntity.User{Id: userId, Username: "jane"}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key entity.ApiKey) (entity.ApiKey, error) {
+					return key, nil
+				})
+
+				Expect(keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci", UserId: &userId})).
+					To(HaveField("UserId", HaveValue(Equal(userId))))
+			})
+
+			It("returns ErrInvalidArgument for an unknown user", func() {
+				mockUsers.EXPECT().GetById(gomock.Any(), userId).Return(usersEntity.User{}, errors.ErrNotFound)
+
+				Expect(keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci", UserId: &userId})).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("returns ErrInvalidArgument for an inactive user", func() {
+				deactivatedAt := time.Now()
+				mockUsers.EXPECT().GetById(gomock.Any(), userId).
+					Return(usersEntity.User{Id: userId, Username: "jane", DeactivatedAt: &deactivatedAt}, nil)
+
+				Expect(keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci", UserId: &userId})).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+	})
+
+	Describe("Authenticate", func() {
+		var (
+			created model.AddApiKeyResponse
+			stored  entity.ApiKey
+		)
+
+		BeforeEach(func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key entity.ApiKey) (entity.ApiKey, error) {
+				key.Id = 2
+				stored = key
+				return key, nil
+			})
+
+			var err error
+			created, err = keysSvc.Add(ctx, model.AddApiKeyRequest{Name: "ci", Admin: true})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the principal of a valid key and records its use", func() {
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+			mockRepository.EXPECT().Touch(gomock.Any(), 2, gomock.Any()).Return(nil)
+
+			Expect(keysSvc.Authenticate(ctx, created.Key)).To(Equal(reqctx.Principal{
+				Id:     "2",
+				Name:   "ci",
+				Method: reqctx.AuthMethodApiKey,
+				Admin:  true,
+			}))
+		})
+
+		It("records the use in the tenant of the key", func() {
+			stored.Tenant = "acme"
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+			mockRepository.EXPECT().Touch(gomock.Any(), 2, gomock.Any()).DoAndReturn(func(ctx context.Context, _ int, _ time.Time) error {
+				Expect(tenancy.Of(ctx)).To(Equal("acme"))
+				return nil
+			})
+
+			Expect(keysSvc.Authenticate(ctx, created.Key)).To(HaveField("Tenant", "acme"))
+		})
+
+		It("returns ErrUnauthorized for a wrong secret", func() {
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+
+			Expect(keysSvc.Authenticate(ctx, created.Key+"0")).Error().To(Equal(errors.ErrUnauthorized))
+		})
+
+		It("returns ErrUnauthorized for an unknown prefix", func() {
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), "0000").Return(entity.ApiKey{}, errors.ErrNotFound)
+
+			Expect(keysSvc.Authenticate(ctx, "dalil_0000_secret")).Error().To(Equal(errors.ErrUnauthorized))
+		})
+
+		DescribeTable("returns ErrUnauthorized for a malformed key",
+			func(key string) {
+				Expect(keysSvc.Authenticate(ctx, key)).Error().To(Equal(errors.ErrUnauthorized))
+			},
+			Entry("empty", ""),
+			Entry("another scheme", "other_0000_secret"),
+			Entry("without secret", "dalil_0000_"),
+			Entry("too many parts", "dalil_0000_secret_more"),
+		)
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(entity.ApiKey{}, customErr)
+
+			Expect(keysSvc.Authenticate(ctx, created.Key)).Error().To(Equal(customErr))
+		})
+
+		It("returns the user of the key in the principal", func() {
+			userId := 5
+			stored.UserId = &userId
+			mockRepository.EXPECT().GetByPrefix(gomock.Any(), stored.Prefix).Return(stored, nil)
+			mockRepository.EXPECT().Touch(gomock.Any(), 2, gomock.Any()).Return(nil)
+
+			Expect(keysSvc.Authenticate(ctx, created.Key)).To(HaveField("UserId", HaveValue(Equal(userId))))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().RemoveById(gomock.Any(), 1).Return(entity.ApiKey{}, errors.ErrNotFound)
+
+			Expect(keysSvc.RemoveById(ctx, 1)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+})
@@ -0,0 +1,221 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByTaskIdFailed   = "GetByTaskId failed"
+	getByTaskIdResponse = "GetByTaskId response"
+	addFailed           = "Add failed"
+	addResponse         = "Add response"
+	downloadFailed      = "Download failed"
+	removeByIdFailed    = "RemoveById failed"
+)
+
+const (
+	filePart       = "file"
+	fileRequired   = "A multipart/form-data body with a file part is required"
+	invalidName    = "Invalid file name"
+	fileTooLarge   = "The file exceeds the maximum attachment size"
+	quotaExceeded  = "The tenant has reached its quota"
+	attachmentTag  = "attachment"
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetByTaskId(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	Download(w http.ResponseWriter, r *http.Request)
+	RemoveById(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetByTaskId(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	dtos, err := ctrl.service.GetByTaskId(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByTaskIdFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(dtos) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getByTaskIdResponse, constants.Payload, dtos)
+
+	_ = marshaller.SerializeEntity(w, dtos)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		logger.Error(err, addFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, fileRequired))
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if err != io.EOF {
+				logger.Error(err, addFailed)
+			}
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, fileRequired))
+			return
+		}
+
+		if part.FormName() != filePart {
+			part.Close()
+			continue
+		}
+
+		dto, err := ctrl.service.Add(r.Context(), taskId, part.FileName(), part)
+		part.Close()
+		if err != nil {
+			if err == errors.ErrNotFound {
+				w.WriteHeader(http.StatusNotFound)
+			} else if err == errors.ErrForbidden {
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+			} else if err == errors.ErrInvalidArgument {
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, invalidName))
+			} else if err == errors.ErrTooLarge {
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusRequestEntityTooLarge, fileTooLarge))
+			} else if err == errors.ErrQuotaExceeded {
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusTooManyRequests, quotaExceeded))
+			} else {
+				logger.Error(err, addFailed, constants.Id, taskId)
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+			}
+			return
+		}
+
+		location := fmt.Sprintf("%s/%d", r.Host, dto.Id)
+		logger.V(1).Info("Added entity location", constants.Location, location)
+		logger.V(1).Info(addResponse, constants.Payload, dto)
+
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusCreated)
+		_ = marshaller.SerializeEntity(w, dto)
+		return
+	}
+}
+
+func (ctrl *controllerImpl) Download(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, id, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	dto, content, err := ctrl.service.Open(r.Context(), taskId, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, downloadFailed, constants.Id, taskId, constants.AttachmentId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", dto.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(attachmentTag, map[string]string{"filename": dto.Name}))
+	http.ServeContent(w, r, dto.Name, dto.CreatedAt, content)
+}
+
+func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, id, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.RemoveById(r.Context(), taskId, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, removeByIdFailed, constants.Id, taskId, constants.AttachmentId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getIdsOrStop(w http.ResponseWriter, r *http.Request) (taskId int, id int, stop bool) {
+	if taskId, stop = common.GetPathParamOrStop(w, r, constants.Id); stop {
+		return
+	}
+
+	id, stop = common.GetPathParamOrStop(w, r, constants.AttachmentId)
+	return
+}
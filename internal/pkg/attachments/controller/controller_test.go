@@ -0,0 +1,261 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/attachments/service"
+)
+
+type readSeekNopCloser struct {
+	*strings.Reader
+}
+
+func (readSeekNopCloser) Close() error {
+	return nil
+}
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder        *httptest.ResponseRecorder
+		mockCtrl        *gomock.Controller
+		mockService     *serviceMock.MockService
+		attachmentsCtrl controller.Controller
+	)
+
+	withIds := func(request *http.Request, attachmentId string) *http.Request {
+		ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+		if attachmentId != "" {
+			ctx = reqctx.SetPathParam(ctx, constants.AttachmentId, attachmentId)
+		}
+		return request.WithContext(ctx)
+	}
+
+	newUpload := func(field string, name string, content string) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		_ = writer.WriteField("comment", "ignored")
+		part, _ := writer.CreateFormFile(field, name)
+		_, _ = part.Write([]byte(content))
+		_ = writer.Close()
+
+		request := httptest.NewRequest(http.MethodPost, url, body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		return withIds(request, "")
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		attachmentsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+
+		When("the task id is not in the context", func() {
+			It("responds with status InternalServerError", func() {
+				attachmentsCtrl.GetByTaskId(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the task is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(nil, errors.ErrNotFound)
+
+				attachmentsCtrl.GetByTaskId(recorder, withIds(httptest.NewRequest("", url, nil), ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the caller cannot read the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(nil, errors.ErrForbidden)
+
+				attachmentsCtrl.GetByTaskId(recorder, withIds(httptest.NewRequest("", url, nil), ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the task has no attachments", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(nil, nil)
+
+				attachmentsCtrl.GetByTaskId(recorder, withIds(httptest.NewRequest("", url, nil), ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the task has attachments", func() {
+			It("responds with status OK and the attachments in the payload", func() {
+				dtos := []model.GetAttachmentResponse{{Id: 3, TaskId: 1, Name: "notes.txt", Size: 5}}
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(dtos, nil)
+
+				attachmentsCtrl.GetByTaskId(recorder, withIds(httptest.NewRequest("", url, nil), ""))
+
+				var payload []model.GetAttachmentResponse
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &payload)).To(Succeed())
+				Expect(payload).To(Equal(dtos))
+			})
+		})
+
+	})
+
+	Describe("Add", func() {
+
+		When("the body is not multipart", func() {
+			It("responds with status BadRequest", func() {
+				attachmentsCtrl.Add(recorder, withIds(httptest.NewRequest(http.MethodPost, url, strings.NewReader("{}")), ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the body has no file part", func() {
+			It("responds with status BadRequest", func() {
+				attachmentsCtrl.Add(recorder, newUpload("other", "notes.txt", "notes"))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, expectedCode int) {
+				mockService.EXPECT().Add(gomock.Any(), 1, "notes.txt", gomock.Any()).Return(model.GetAttachmentResponse{}, err)
+
+				attachmentsCtrl.Add(recorder, newUpload("file", "notes.txt", "notes"))
+
+				Expect(recorder.Code).To(Equal(expectedCode))
+			},
+			Entry("task not editable", errors.ErrForbidden, http.StatusForbidden),
+			Entry("task not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("invalid name", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("file too large", errors.ErrTooLarge, http.StatusRequestEntityTooLarge),
+			Entry("quota exceeded", errors.ErrQuotaExceeded, http.StatusTooManyRequests),
+			Entry("unexpected error", errors.ErrConflict, http.StatusInternalServerError),
+		)
+
+		When("the file is stored", func() {
+			It("responds with status Created, the location and the attachment in the payload", func() {
+				dto := model.GetAttachmentResponse{Id: 3, TaskId: 1, Name: "notes.txt", Size: 5}
+				mockService.EXPECT().Add(gomock.Any(), 1, "notes.txt", gomock.Any()).
+					DoAndReturn(func(_ any, _ int, _ string, content io.Reader) (model.GetAttachmentResponse, error) {
+						Expect(io.ReadAll(content)).To(Equal([]byte("notes")))
+						return dto, nil
+					})
+
+				attachmentsCtrl.Add(recorder, newUpload("file", "notes.txt", "notes"))
+
+				var payload model.GetAttachmentResponse
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(Equal("url/3"))
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &payload)).To(Succeed())
+				Expect(payload).To(Equal(dto))
+			})
+		})
+
+	})
+
+	Describe("Download", func() {
+
+		var dto model.GetAttachmentResponse
+
+		BeforeEach(func() {
+			dto = model.GetAttachmentResponse{Id: 3, TaskId: 1, Name: "notes.txt", ContentType: "text/plain", Size: 10}
+		})
+
+		When("the attachment is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().Open(gomock.Any(), 1, 3).Return(model.GetAttachmentResponse{}, nil, errors.ErrNotFound)
+
+				attachmentsCtrl.Download(recorder, withIds(httptest.NewRequest("", url, nil), "3"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the attachment is found", func() {
+			It("responds with status OK and the content", func() {
+				mockService.EXPECT().Open(gomock.Any(), 1, 3).
+					Return(dto, readSeekNopCloser{strings.NewReader("0123456789")}, nil)
+
+				attachmentsCtrl.Download(recorder, withIds(httptest.NewRequest("", url, nil), "3"))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Header().Get("Content-Type")).To(Equal("text/plain"))
+				Expect(recorder.Header().Get("Content-Disposition")).To(Equal("attachment; filename=notes.txt"))
+				Expect(recorder.Body.String()).To(Equal("0123456789"))
+			})
+		})
+
+		When("a range is requested", func() {
+			It("responds with status PartialContent and the requested range", func() {
+				mockService.EXPECT().Open(gomock.Any(), 1, 3).
+					Return(dto, readSeekNopCloser{strings.NewReader("0123456789")}, nil)
+
+				request := httptest.NewRequest("", url, nil)
+				request.Header.Set("Range", "bytes=2-5")
+				attachmentsCtrl.Download(recorder, withIds(request, "3"))
+
+				Expect(recorder.Code).To(Equal(http.StatusPartialContent))
+				Expect(recorder.Header().Get("Content-Range")).To(Equal("bytes 2-5/10"))
+				Expect(recorder.Body.String()).To(Equal("2345"))
+			})
+		})
+
+	})
+
+	Describe("RemoveById", func() {
+
+		When("the attachment is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 3).Return(errors.ErrNotFound)
+
+				attachmentsCtrl.RemoveById(recorder, withIds(httptest.NewRequest("", url, nil), "3"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the attachment is removed", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 3).Return(nil)
+
+				attachmentsCtrl.RemoveById(recorder, withIds(httptest.NewRequest("", url, nil), "3"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+	})
+
+})
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type Attachment struct {
+	Id          int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	TaskId      int       `json:"taskId" gorm:"column:task_id;type:int;index"`
+	Name        string    `json:"name" gorm:"column:name;type:varchar;size:255"`
+	ContentType string    `json:"contentType" gorm:"column:content_type;type:varchar;size:255"`
+	Size        int64     `json:"size" gorm:"column:size;type:bigint"`
+	Key         string    `json:"key" gorm:"column:key;type:varchar;size:255;unique"`
+	CreatedAt   time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+}
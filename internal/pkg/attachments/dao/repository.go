@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.Attachment, error)
+	GetByTaskId(ctx context.Context, taskId int) ([]entity.Attachment, error)
+	Insert(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error)
+	RemoveById(ctx context.Context, id int) (entity.Attachment, error)
+	RemoveByTaskId(ctx context.Context, taskId int) ([]entity.Attachment, error)
+}
+
+type partition struct {
+	attachments map[int]entity.Attachment
+	seq         int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+	quotas     tenancy.Quotas
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithAttachments(attachments map[int]entity.Attachment) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && attachments != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			partition.attachments = attachments
+			for id := range attachments {
+				if id >= partition.seq {
+					partition.seq = id + 1
+				}
+			}
+		}
+	}
+}
+
+func WithQuotas(quotas tenancy.Quotas) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.quotas = quotas
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		attachments: map[int]entity.Attachment{},
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.Attachment, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	attachment, found := partition.attachments[id]
+	if !found {
+		return entity.Attachment{}, errors.ErrNotFound
+	}
+	return attachment, nil
+}
+
+func (repo *memoryRepository) GetByTaskId(ctx context.Context, taskId int) ([]entity.Attachment, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var attachments []entity.Attachment
+	for _, attachment := range partition.attachments {
+		if attachment.TaskId == taskId {
+			attachments = append(attachments, attachment)
+		}
+	}
+	sortById(attachments)
+
+	return attachments, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+	if repo.quotas != nil {
+		if err := repo.quotas.Check(ctx, tenancy.ResourceAttachments, len(partition.attachments)); err != nil {
+			return entity.Attachment{}, err
+		}
+	}
+
+	attachment.Id, partition.seq = partition.seq, partition.seq+1
+	attachment.CreatedAt = time.Now()
+	partition.attachments[attachment.Id] = attachment
+	return attachment, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Attachment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	attachment, found := partition.attachments[id]
+	if !found {
+		return entity.Attachment{}, errors.ErrNotFound
+	}
+	delete(partition.attachments, id)
+	return attachment, nil
+}
+
+func (repo *memoryRepository) RemoveByTaskId(ctx context.Context, taskId int) ([]entity.Attachment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var removed []entity.Attachment
+	for id, attachment := range partition.attachments {
+		if attachment.TaskId == taskId {
+			removed = append(removed, attachment)
+			delete(partition.attachments, id)
+		}
+	}
+	sortById(removed)
+
+	return removed, nil
+}
+
+func sortById(attachments []entity.Attachment) {
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].Id < attachments[j].Id
+	})
+}
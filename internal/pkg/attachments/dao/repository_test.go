@@ -0,0 +1,83 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		repo       repository.Repository
+		attachment entity.Attachment
+	)
+
+	BeforeEach(func() {
+		repo = repository.New()
+		attachment, _ = repo.Insert(ctx, entity.Attachment{TaskId: 1, Name: "first.txt", Key: "tasks/1/a"})
+		_, _ = repo.Insert(ctx, entity.Attachment{TaskId: 2, Name: "elsewhere.txt", Key: "tasks/2/b"})
+		_, _ = repo.Insert(ctx, entity.Attachment{TaskId: 1, Name: "second.txt", Key: "tasks/1/c"})
+	})
+
+	Describe("Insert", func() {
+		It("assigns an id and a creation timestamp", func() {
+			Expect(attachment.Id).To(Equal(0))
+			Expect(attachment.CreatedAt).NotTo(BeZero())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+		It("returns the attachments of the task, oldest first", func() {
+			Expect(repo.GetByTaskId(ctx, 1)).To(HaveExactElements(HaveField("Name", "first.txt"), HaveField("Name", "second.txt")))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the attachment", func() {
+			Expect(repo.RemoveById(ctx, attachment.Id)).To(HaveField("Name", "first.txt"))
+			Expect(repo.GetById(ctx, attachment.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound for an unknown attachment", func() {
+			Expect(repo.RemoveById(ctx, 42)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveByTaskId", func() {
+		It("removes and returns the attachments of the task only", func() {
+			Expect(repo.RemoveByTaskId(ctx, 1)).To(HaveExactElements(HaveField("Key", "tasks/1/a"), HaveField("Key", "tasks/1/c")))
+			Expect(repo.GetByTaskId(ctx, 1)).To(BeEmpty())
+			Expect(repo.GetByTaskId(ctx, 2)).To(HaveLen(1))
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("isolates the attachments of each tenant", func() {
+			Expect(repo.GetById(acme, attachment.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByTaskId(acme, 1)).To(BeEmpty())
+			Expect(repo.RemoveByTaskId(acme, 1)).To(BeEmpty())
+			Expect(repo.GetByTaskId(ctx, 1)).To(HaveLen(2))
+		})
+
+		It("enforces the quota of the tenant", func() {
+			repo = repository.New(repository.WithQuotas(tenancy.NewQuotas(
+				tenancy.WithDefaults(tenancy.Limits{tenancy.ResourceAttachments: 1}),
+			)))
+
+			Expect(repo.Insert(acme, entity.Attachment{TaskId: 1, Name: "first.txt"})).Error().NotTo(HaveOccurred())
+			Expect(repo.Insert(acme, entity.Attachment{TaskId: 1, Name: "second.txt"})).Error().To(Equal(errors.ErrQuotaExceeded))
+		})
+	})
+
+})
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao/entity"
+)
+
+type GetAttachmentResponse struct {
+	Id          int       `json:"id"`
+	TaskId      int       `json:"taskId"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+}
+
+func EntityToGetAttachmentResponse(entity entity.Attachment) GetAttachmentResponse {
+	return GetAttachmentResponse{
+		Id:          entity.Id,
+		TaskId:      entity.TaskId,
+		Name:        entity.Name,
+		ContentType: entity.ContentType,
+		Size:        entity.Size,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package model_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/model"
+)
+
+var _ = Describe("Model", func() {
+
+	Describe("EntityToGetAttachmentResponse", func() {
+		It("returns a response that contains the entity's fields except the storage key", func() {
+			now := time.Now()
+			e := entity.Attachment{
+				Id:          3,
+				TaskId:      1,
+				Name:        "notes.txt",
+				ContentType: "text/plain; charset=utf-8",
+				Size:        5,
+				Key:         "tasks/1/secret",
+				CreatedAt:   now,
+			}
+
+			Expect(model.EntityToGetAttachmentResponse(e)).To(Equal(model.GetAttachmentResponse{
+				Id:          3,
+				TaskId:      1,
+				Name:        "notes.txt",
+				ContentType: "text/plain; charset=utf-8",
+				Size:        5,
+				CreatedAt:   now,
+			}))
+		})
+	})
+
+})
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetByTaskId(ctx context.Context, taskId int) ([]model.GetAttachmentResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return nil, err
+	}
+	return authorized.service.GetByTaskId(ctx, taskId)
+}
+
+func (authorized *authorizedService) Add(ctx context.Context, taskId int, name string, content io.Reader) (model.GetAttachmentResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetAttachmentResponse{}, err
+	}
+	return authorized.service.Add(ctx, taskId, name, content)
+}
+
+func (authorized *authorizedService) Open(ctx context.Context, taskId int, id int) (model.GetAttachmentResponse, io.ReadSeekCloser, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return model.GetAttachmentResponse{}, nil, err
+	}
+	return authorized.service.Open(ctx, taskId, id)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, taskId int, id int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, taskId, id)
+}
+
+func (authorized *authorizedService) RemoveTask(ctx context.Context, taskId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveTask(ctx, taskId)
+}
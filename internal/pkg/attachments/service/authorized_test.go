@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/attachments/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get the attachments of a task", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetByTaskId(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the viewer role to open an attachment", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		_, content, err := authorizedSvc.Open(ctx, 1, 3)
+
+		Expect(err).To(Equal(errors.ErrForbidden))
+		Expect(content).To(BeNil())
+	})
+
+	It("requires the editor role to add an attachment", func() {
+		content := strings.NewReader("notes")
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().Add(ctx, 1, "notes.txt", content).Return(model.GetAttachmentResponse{Id: 3}, nil)
+
+		Expect(authorizedSvc.Add(ctx, 1, "notes.txt", content)).To(HaveField("Id", 3))
+	})
+
+	It("requires the editor role to remove an attachment", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1, 3)).To(Equal(errors.ErrForbidden))
+	})
+
+})
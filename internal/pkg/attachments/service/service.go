@@ -0,0 +1,245 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/blobstore"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+const (
+	sniffLength   = 512
+	maxNameLength = 255
+	genericType   = "application/octet-stream"
+)
+
+type Service interface {
+	GetByTaskId(ctx context.Context, taskId int) ([]model.GetAttachmentResponse, error)
+	Add(ctx context.Context, taskId int, name string, content io.Reader) (model.GetAttachmentResponse, error)
+	Open(ctx context.Context, taskId int, id int) (model.GetAttachmentResponse, io.ReadSeekCloser, error)
+	RemoveById(ctx context.Context, taskId int, id int) error
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasksDAO.Repository
+	store      blobstore.BlobStore
+	maxSize    int64
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func WithStore(store blobstore.BlobStore) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.store = store
+		}
+	}
+}
+
+func WithMaxSize(maxSize int64) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && maxSize > 0 {
+			service.maxSize = maxSize
+		}
+	}
+}
+
+func (service *serviceImpl) GetByTaskId(ctx context.Context, taskId int) ([]model.GetAttachmentResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return nil, err
+	}
+
+	entities, err := service.repository.GetByTaskId(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var dtos []model.GetAttachmentResponse
+	for _, attachment := range entities {
+		dtos = append(dtos, model.EntityToGetAttachmentResponse(attachment))
+	}
+	return dtos, nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, taskId int, name string, content io.Reader) (model.GetAttachmentResponse, error) {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), "\\", "/"))
+	if name == "." || name == "/" || len(name) > maxNameLength {
+		return model.GetAttachmentResponse{}, errors.ErrInvalidArgument
+	}
+
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetAttachmentResponse{}, err
+	}
+
+	key, err := newKey(tenancy.Of(ctx), taskId)
+	if err != nil {
+		return model.GetAttachmentResponse{}, err
+	}
+
+	if service.maxSize > 0 {
+		content = &limitedReader{reader: content, remaining: service.maxSize}
+	}
+	buffered := bufio.NewReaderSize(content, sniffLength)
+	head, err := buffered.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return model.GetAttachmentResponse{}, err
+	}
+
+	size, err := service.store.Put(ctx, key, buffered)
+	if err != nil {
+		return model.GetAttachmentResponse{}, err
+	}
+
+	attachment, err := service.repository.Insert(ctx, entity.Attachment{
+		TaskId:      taskId,
+		Name:        name,
+		ContentType: detectContentType(name, head),
+		Size:        size,
+		Key:         key,
+	})
+	if err != nil {
+		_ = service.store.Delete(ctx, key)
+		return model.GetAttachmentResponse{}, err
+	}
+	return model.EntityToGetAttachmentResponse(attachment), nil
+}
+
+func (service *serviceImpl) Open(ctx context.Context, taskId int, id int) (model.GetAttachmentResponse, io.ReadSeekCloser, error) {
+	attachment, err := service.get(ctx, taskId, id)
+	if err != nil {
+		return model.GetAttachmentResponse{}, nil, err
+	}
+
+	content, err := service.store.Open(ctx, attachment.Key)
+	if err != nil {
+		return model.GetAttachmentResponse{}, nil, err
+	}
+	return model.EntityToGetAttachmentResponse(attachment), content, nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, taskId int, id int) error {
+	if _, err := service.get(ctx, taskId, id); err != nil {
+		return err
+	}
+
+	attachment, err := service.repository.RemoveById(ctx, id)
+	if err != nil {
+		return err
+	}
+	return service.store.Delete(ctx, attachment.Key)
+}
+
+func (service *serviceImpl) RemoveTask(ctx context.Context, taskId int) error {
+	attachments, err := service.repository.RemoveByTaskId(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if deleteErr := service.store.Delete(context.Background(), attachment.Key); deleteErr != nil && err == nil {
+			err = deleteErr
+		}
+	}
+	return err
+}
+
+func (service *serviceImpl) get(ctx context.Context, taskId int, id int) (entity.Attachment, error) {
+	attachment, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	if attachment.TaskId != taskId {
+		return entity.Attachment{}, errors.ErrNotFound
+	}
+	return attachment, nil
+}
+
+func (service *serviceImpl) checkTask(ctx context.Context, taskId int) error {
+	if service.tasks == nil {
+		return nil
+	}
+
+	_, err := service.tasks.GetById(ctx, taskId)
+	return err
+}
+
+func newKey(tenant string, taskId int) (string, error) {
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("tenants/%s/tasks/%d/%s", tenant, taskId, hex.EncodeToString(suffix)), nil
+}
+
+func detectContentType(name string, head []byte) string {
+	contentType := http.DetectContentType(head)
+	if contentType == genericType || strings.HasPrefix(contentType, "text/plain") {
+		if byExtension := mime.TypeByExtension(path.Ext(name)); byExtension != "" {
+			return byExtension
+		}
+	}
+	return contentType
+}
+
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (limited *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > limited.remaining+1 {
+		p = p[:limited.remaining+1]
+	}
+
+	n, err := limited.reader.Read(p)
+	if int64(n) > limited.remaining {
+		return 0, errors.ErrTooLarge
+	}
+	limited.remaining -= int64(n)
+	return n, err
+}
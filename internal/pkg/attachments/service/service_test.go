@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/attachments/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/attachments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/blobstore"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	const (
+		taskId  = 1
+		maxSize = 1024
+	)
+
+	var (
+		ctx            = context.Background()
+		mockCtrl       *gomock.Controller
+		mockTasks      *tasksDaoMock.MockRepository
+		repository     dao.Repository
+		store          blobstore.BlobStore
+		attachmentsSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil).AnyTimes()
+
+		repository = dao.New()
+		store = blobstore.NewLocal(GinkgoT().TempDir())
+		attachmentsSvc = service.New(
+			service.WithRepository(repository),
+			service.WithTasks(mockTasks),
+			service.WithStore(store),
+			service.WithMaxSize(maxSize),
+		)
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Add", func() {
+		It("returns ErrNotFound for an unknown task", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), 2).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+			Expect(attachmentsSvc.Add(ctx, 2, "notes.txt", strings.NewReader("notes"))).Error().
+				To(Equal(errors.ErrNotFound))
+		})
+
+		It("stores the content under a generated key", func() {
+			dto, err := attachmentsSvc.Add(ctx, taskId, "notes.txt", strings.NewReader("notes"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Name).To(Equal("notes.txt"))
+			Expect(dto.Size).To(Equal(int64(5)))
+
+			attachment, err := repository.GetById(ctx, dto.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attachment.Key).To(HavePrefix("tenants/default/tasks/1/"))
+		})
+
+		It("stores the content of a tenant under its own prefix", func() {
+			acme := tenancy.With(ctx, "acme")
+			dto, err := attachmentsSvc.Add(acme, taskId, "notes.txt", strings.NewReader("notes"))
+			Expect(err).NotTo(HaveOccurred())
+
+			attachment, err := repository.GetById(acme, dto.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attachment.Key).To(HavePrefix("tenants/acme/tasks/1/"))
+		})
+
+		It("keeps the base name of the file only", func() {
+			Expect(attachmentsSvc.Add(ctx, taskId, `C:\Users\alice\notes.txt`, strings.NewReader("notes"))).
+				To(HaveField("Name", "notes.txt"))
+		})
+
+		DescribeTable("detects the content type",
+			func(name string, content []byte, expected string) {
+				Expect(attachmentsSvc.Add(ctx, taskId, name, bytes.NewReader(content))).
+					To(HaveField("ContentType", expected))
+			},
+			Entry("from the content", "image", []byte("\x89PNG\x0D\x0A\x1A\x0A"), "image/png"),
+			Entry("from the extension when the content is plain text", "data.json", []byte(`{"a":1}`), "application/json"),
+			Entry("as plain text without a known extension", "notes", []byte("notes"), "text/plain; charset=utf-8"),
+		)
+
+		DescribeTable("returns ErrInvalidArgument for an invalid name",
+			func(name string) {
+				Expect(attachmentsSvc.Add(ctx, taskId, name, strings.NewReader("notes"))).Error().
+					To(Equal(errors.ErrInvalidArgument))
+			},
+			Entry("empty", ""),
+			Entry("a directory", "/"),
+			Entry("too long", strings.Repeat("a", 256)),
+		)
+
+		It("returns ErrTooLarge when the content exceeds the maximum size", func() {
+			Expect(attachmentsSvc.Add(ctx, taskId, "big.bin", bytes.NewReader(make([]byte, maxSize+1)))).Error().
+				To(Equal(errors.ErrTooLarge))
+			Expect(repository.GetByTaskId(ctx, taskId)).To(BeEmpty())
+		})
+
+		It("accepts content of exactly the maximum size", func() {
+			Expect(attachmentsSvc.Add(ctx, taskId, "big.bin", bytes.NewReader(make([]byte, maxSize)))).
+				To(HaveField("Size", int64(maxSize)))
+		})
+	})
+
+	Describe("Open", func() {
+		It("returns the metadata and the content", func() {
+			added, _ := attachmentsSvc.Add(ctx, taskId, "notes.txt", strings.NewReader("notes"))
+
+			dto, content, err := attachmentsSvc.Open(ctx, taskId, added.Id)
+			Expect(err).NotTo(HaveOccurred())
+			defer content.Close()
+
+			Expect(dto).To(Equal(added))
+			Expect(io.ReadAll(content)).To(Equal([]byte("notes")))
+		})
+
+		It("returns ErrNotFound for an attachment of another task", func() {
+			added, _ := attachmentsSvc.Add(ctx, taskId, "notes.txt", strings.NewReader("notes"))
+
+			_, _, err := attachmentsSvc.Open(ctx, 2, added.Id)
+			Expect(err).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the attachment and its content", func() {
+			added, _ := attachmentsSvc.Add(ctx, taskId, "notes.txt", strings.NewReader("notes"))
+			attachment, _ := repository.GetById(ctx, added.Id)
+
+			Expect(attachmentsSvc.RemoveById(ctx, taskId, added.Id)).To(Succeed())
+
+			Expect(repository.GetById(ctx, added.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(store.Open(ctx, attachment.Key)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveTask", func() {
+		It("removes the attachments of the task and their content", func() {
+			added, _ := attachmentsSvc.Add(ctx, taskId, "notes.txt", strings.NewReader("notes"))
+			attachment, _ := repository.GetById(ctx, added.Id)
+
+			Expect(attachmentsSvc.RemoveTask(ctx, taskId)).To(Succeed())
+
+			Expect(attachmentsSvc.GetByTaskId(ctx, taskId)).To(BeEmpty())
+			Expect(store.Open(ctx, attachment.Key)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+})
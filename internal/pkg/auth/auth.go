@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	apiKeysService "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	usersService "github.com/aeon-fruit/dalil.git/internal/pkg/users/service"
+)
+
+const bearerScheme = "Bearer"
+
+type Authenticator interface {
+	Authenticate(r *http.Request) (reqctx.Principal, error)
+	Challenge() string
+}
+
+type chain []Authenticator
+
+func Chain(authenticators ...Authenticator) Authenticator {
+	var instance chain
+	for _, authenticator := range authenticators {
+		if authenticator != nil {
+			instance = append(instance, authenticator)
+		}
+	}
+	return instance
+}
+
+func (authenticators chain) Authenticate(r *http.Request) (reqctx.Principal, error) {
+	for _, authenticator := range authenticators {
+		principal, err := authenticator.Authenticate(r)
+		if err != errors.ErrNotFound {
+			return principal, err
+		}
+	}
+	return reqctx.Principal{}, errors.ErrNotFound
+}
+
+func (authenticators chain) Challenge() string {
+	var challenges []string
+	for _, authenticator := range authenticators {
+		challenges = append(challenges, authenticator.Challenge())
+	}
+	return strings.Join(challenges, ", ")
+}
+
+type usersResolver struct {
+	authenticator Authenticator
+	users         usersService.Service
+}
+
+func ResolveUsers(authenticator Authenticator, users usersService.Service) Authenticator {
+	if authenticator == nil || users == nil {
+		return authenticator
+	}
+	return &usersResolver{authenticator: authenticator, users: users}
+}
+
+func (resolver *usersResolver) Authenticate(r *http.Request) (reqctx.Principal, error) {
+	principal, err := resolver.authenticator.Authenticate(r)
+	if err != nil {
+		return principal, err
+	}
+
+	return resolver.users.Resolve(r.Context(), principal)
+}
+
+func (resolver *usersResolver) Challenge() string {
+	return resolver.authenticator.Challenge()
+}
+
+type apiKeyAuthenticator struct {
+	keys apiKeysService.Service
+}
+
+func NewApiKey(keys apiKeysService.Service) Authenticator {
+	return &apiKeyAuthenticator{keys: keys}
+}
+
+func (authenticator *apiKeyAuthenticator) Authenticate(r *http.Request) (reqctx.Principal, error) {
+	key := strings.TrimSpace(r.Header.Get(constants.ApiKeyHeader))
+	if key == "" {
+		return reqctx.Principal{}, errors.ErrNotFound
+	}
+
+	return authenticator.keys.Authenticate(r.Context(), key)
+}
+
+func (authenticator *apiKeyAuthenticator) Challenge() string {
+	return `ApiKey header="` + constants.ApiKeyHeader + `"`
+}
+
+type adminTokenAuthenticator struct {
+	token string
+}
+
+func NewAdminToken(token string) Authenticator {
+	if token == "" {
+		return nil
+	}
+	return &adminTokenAuthenticator{token: token}
+}
+
+func (authenticator *adminTokenAuthenticator) Authenticate(r *http.Request) (reqctx.Principal, error) {
+	value := r.Header.Get(constants.AdminTokenHeader)
+	if value == "" {
+		return reqctx.Principal{}, errors.ErrNotFound
+	}
+
+	if subtle.ConstantTimeCompare([]byte(value), []byte(authenticator.token)) != 1 {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+
+	return reqctx.Principal{Id: "admin", Method: reqctx.AuthMethodAdminToken, Admin: true}, nil
+}
+
+func (authenticator *adminTokenAuthenticator) Challenge() string {
+	return `AdminToken header="` + constants.AdminTokenHeader + `"`
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, bearerScheme) {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,344 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	goErrors "errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiKeysDAO "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/model"
+	apiKeysService "github.com/aeon-fruit/dalil.git/internal/pkg/apikeys/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/auth"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	usersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	usersModel "github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+	usersService "github.com/aeon-fruit/dalil.git/internal/pkg/users/service"
+)
+
+var _ = Describe("Auth", func() {
+
+	ctx := context.Background()
+
+	const secret = "0123456789abcdef0123456789abcdef"
+
+	request := func(header string, value string) *http.Request {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/tasks", nil)
+		if value != "" {
+			request.Header.Set(header, value)
+		}
+		return request
+	}
+
+	bearer := func(token string) *http.Request {
+		return request("Authorization", "Bearer "+token)
+	}
+
+	claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		values := jwt.MapClaims{
+			"sub":  "alice",
+			"name": "Alice",
+			"exp":  time.Now().Add(time.Hour).Unix(),
+		}
+		for key, value := range overrides {
+			values[key] = value
+		}
+		return values
+	}
+
+	sign := func(method jwt.SigningMethod, key any, kid string, values jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, values)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		Expect(err).NotTo(HaveOccurred())
+		return signed
+	}
+
+	expectUnauthorized := func(_ reqctx.Principal, err error) {
+		Expect(goErrors.Is(err, errors.ErrUnauthorized)).To(BeTrue(), "unexpected error %v", err)
+	}
+
+	Describe("NewApiKey", func() {
+		var (
+			keys          apiKeysService.Service
+			authenticator auth.Authenticator
+		)
+
+		BeforeEach(func() {
+			keys = apiKeysService.New(apiKeysService.WithRepository(apiKeysDAO.New()))
+			authenticator = auth.NewApiKey(keys)
+		})
+
+		It("authenticates a stored key", func() {
+			created, err := keys.Add(ctx, model.AddApiKeyRequest{Name: "ci", Admin: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authenticator.Authenticate(request(constants.ApiKeyHeader, created.Key))).To(Equal(reqctx.Principal{
+				Id:     "0",
+				Name:   "ci",
+				Method: reqctx.AuthMethodApiKey,
+				Admin:  true,
+				Tenant: tenancy.DefaultTenant,
+			}))
+		})
+
+		It("binds the principal to the tenant of the key", func() {
+			created, err := keys.Add(tenancy.With(ctx, "acme"), model.AddApiKeyRequest{Name: "ci"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authenticator.Authenticate(request(constants.ApiKeyHeader, created.Key))).To(HaveField("Tenant", "acme"))
+		})
+
+		It("rejects an unknown key", func() {
+			expectUnauthorized(authenticator.Authenticate(request(constants.ApiKeyHeader, "dalil_00000000_ffff")))
+		})
+
+		It("ignores the requests without key", func() {
+			Expect(authenticator.Authenticate(request(constants.ApiKeyHeader, ""))).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("NewAdminToken", func() {
+		It("is disabled without token", func() {
+			Expect(auth.NewAdminToken("")).To(BeNil())
+		})
+
+		It("authenticates the configured token as an administrator", func() {
+			principal, err := auth.NewAdminToken("s3cr3t").Authenticate(request(constants.AdminTokenHeader, "s3cr3t"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(principal.Admin).To(BeTrue())
+			Expect(principal.Method).To(Equal(reqctx.AuthMethodAdminToken))
+		})
+
+		It("rejects another token", func() {
+			expectUnauthorized(auth.NewAdminToken("s3cr3t").Authenticate(request(constants.AdminTokenHeader, "guess")))
+		})
+	})
+
+	Describe("NewJwt", func() {
+		Context("with an HMAC secret", func() {
+			var authenticator auth.Authenticator
+
+			BeforeEach(func() {
+				authenticator = auth.NewJwt(
+					auth.WithHmacSecret(secret),
+					auth.WithIssuer("https://idp"),
+					auth.WithAudience("dalil"),
+				)
+			})
+
+			valid := func(overrides jwt.MapClaims) jwt.MapClaims {
+				values := claims(jwt.MapClaims{"iss": "https://idp", "aud": "dalil"})
+				for key, value := range overrides {
+					values[key] = value
+				}
+				return values
+			}
+
+			It("authenticates the subject of a valid token", func() {
+				token := sign(jwt.SigningMethodHS256, []byte(secret), "", valid(nil))
+
+				Expect(authenticator.Authenticate(bearer(token))).To(Equal(reqctx.Principal{
+					Id:     "alice",
+					Name:   "Alice",
+					Method: reqctx.AuthMethodJwt,
+				}))
+			})
+
+			It("flags the holders of the admin role", func() {
+				token := sign(jwt.SigningMethodHS256, []byte(secret), "", valid(jwt.MapClaims{"roles": []string{"user", "admin"}}))
+
+				Expect(authenticator.Authenticate(bearer(token))).To(HaveField("Admin", true))
+			})
+
+			It("reads the tenant from the configured claim", func() {
+				token := sign(jwt.SigningMethodHS256, []byte(secret), "", valid(jwt.MapClaims{"org": "acme"}))
+
+				Expect(authenticator.Authenticate(bearer(token))).To(HaveField("Tenant", BeEmpty()))
+				Expect(auth.NewJwt(auth.WithHmacSecret(secret), auth.WithTenantClaim("org")).Authenticate(bearer(token))).
+					To(HaveField("Tenant", "acme"))
+			})
+
+			It("ignores the requests without bearer token", func() {
+				Expect(authenticator.Authenticate(request("Authorization", "Basic YWxpY2U6cHdk"))).Error().To(Equal(errors.ErrNotFound))
+			})
+
+			DescribeTable("rejects an invalid token",
+				func(method jwt.SigningMethod, key any, values jwt.MapClaims) {
+					expectUnauthorized(authenticator.Authenticate(bearer(sign(method, key, "", values))))
+				},
+				Entry("signed with another secret", jwt.SigningMethodHS256, []byte("another secret"), valid(nil)),
+				Entry("expired", jwt.SigningMethodHS256, []byte(secret), valid(jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})),
+				Entry("without expiration", jwt.SigningMethodHS256, []byte(secret), valid(jwt.MapClaims{"exp": nil})),
+				Entry("from another issuer", jwt.SigningMethodHS256, []byte(secret), valid(jwt.MapClaims{"iss": "https://other"})),
+				Entry("for another audience", jwt.SigningMethodHS256, []byte(secret), valid(jwt.MapClaims{"aud": "other"})),
+				Entry("without subject", jwt.SigningMethodHS256, []byte(secret), valid(jwt.MapClaims{"sub": nil})),
+				Entry("unsigned", jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid(nil)),
+			)
+
+			It("rejects a malformed token", func() {
+				expectUnauthorized(authenticator.Authenticate(bearer("not.a.token")))
+			})
+		})
+
+		Context("with a JWKS URL", func() {
+			var (
+				rsaKey        *rsa.PrivateKey
+				ecKey         *ecdsa.PrivateKey
+				fetches       atomic.Int32
+				server        *httptest.Server
+				authenticator auth.Authenticator
+			)
+
+			encode := func(value *big.Int) string {
+				return base64.RawURLEncoding.EncodeToString(value.Bytes())
+			}
+
+			BeforeEach(func() {
+				var err error
+				rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+				ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+
+				fetches.Store(0)
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fetches.Add(1)
+					_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+						{"kid": "rsa", "kty": "RSA", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+						{"kid": "ec", "kty": "EC", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+						{"kid": "enc", "kty": "RSA", "use": "enc", "n": encode(rsaKey.N), "e": "AQAB"},
+					}})
+				}))
+				DeferCleanup(server.Close)
+
+				authenticator = auth.NewJwt(auth.WithJwks(server.URL, time.Hour, server.Client()))
+			})
+
+			It("authenticates the tokens signed with an RSA key", func() {
+				token := sign(jwt.SigningMethodRS256, rsaKey, "rsa", claims(nil))
+
+				Expect(authenticator.Authenticate(bearer(token))).To(HaveField("Id", "alice"))
+			})
+
+			It("authenticates the tokens signed with an EC key", func() {
+				token := sign(jwt.SigningMethodES256, ecKey, "ec", claims(nil))
+
+				Expect(authenticator.Authenticate(bearer(token))).To(HaveField("Id", "alice"))
+			})
+
+			It("caches the key set", func() {
+				token := sign(jwt.SigningMethodRS256, rsaKey, "rsa", claims(nil))
+
+				for i := 0; i < 3; i++ {
+					Expect(authenticator.Authenticate(bearer(token))).Error().NotTo(HaveOccurred())
+				}
+				Expect(fetches.Load()).To(BeEquivalentTo(1))
+			})
+
+			It("rejects the keys not meant for signatures", func() {
+				expectUnauthorized(authenticator.Authenticate(bearer(sign(jwt.SigningMethodRS256, rsaKey, "enc", claims(nil)))))
+			})
+
+			It("rejects an unknown key id", func() {
+				expectUnauthorized(authenticator.Authenticate(bearer(sign(jwt.SigningMethodRS256, rsaKey, "other", claims(nil)))))
+			})
+
+			It("rejects the HMAC tokens when no secret is configured", func() {
+				expectUnauthorized(authenticator.Authenticate(bearer(sign(jwt.SigningMethodHS256, []byte(secret), "", claims(nil)))))
+			})
+		})
+	})
+
+	Describe("Chain", func() {
+		var authenticator auth.Authenticator
+
+		BeforeEach(func() {
+			authenticator = auth.Chain(
+				auth.NewAdminToken(""),
+				auth.NewJwt(auth.WithHmacSecret(secret)),
+				auth.NewAdminToken("s3cr3t"),
+			)
+		})
+
+		It("uses the first authenticator finding credentials", func() {
+			Expect(authenticator.Authenticate(request(constants.AdminTokenHeader, "s3cr3t"))).To(HaveField("Admin", true))
+		})
+
+		It("stops on invalid credentials", func() {
+			req := bearer("not.a.token")
+			req.Header.Set(constants.AdminTokenHeader, "s3cr3t")
+
+			expectUnauthorized(authenticator.Authenticate(req))
+		})
+
+		It("fails with ErrNotFound without credentials", func() {
+			Expect(authenticator.Authenticate(request("", ""))).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("lists the challenges of its authenticators", func() {
+			Expect(authenticator.Challenge()).To(Equal(`Bearer, AdminToken header="X-Admin-Token"`))
+		})
+	})
+
+	Describe("ResolveUsers", func() {
+		var (
+			users         usersService.Service
+			authenticator auth.Authenticator
+		)
+
+		BeforeEach(func() {
+			users = usersService.New(usersService.WithRepository(usersDAO.New()))
+			authenticator = auth.ResolveUsers(auth.NewJwt(auth.WithHmacSecret(secret)), users)
+		})
+
+		It("returns the authenticator as is without users", func() {
+			adminToken := auth.NewAdminToken("s3cr3t")
+
+			Expect(auth.ResolveUsers(adminToken, nil)).To(BeIdenticalTo(adminToken))
+			Expect(auth.ResolveUsers(nil, users)).To(BeNil())
+		})
+
+		It("binds the principal to its user", func() {
+			user, err := users.Add(ctx, usersModel.AddUserRequest{Username: "alice"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authenticator.Authenticate(bearer(sign(jwt.SigningMethodHS256, []byte(secret), "", claims(nil))))).
+				To(HaveField("UserId", HaveValue(Equal(user.Id))))
+		})
+
+		It("rejects the principal of an inactive user", func() {
+			user, err := users.Add(ctx, usersModel.AddUserRequest{Username: "alice"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = users.Deactivate(ctx, user.Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectUnauthorized(authenticator.Authenticate(bearer(sign(jwt.SigningMethodHS256, []byte(secret), "", claims(nil)))))
+		})
+
+		It("forwards the failures and the challenge", func() {
+			Expect(authenticator.Authenticate(request("", ""))).Error().To(Equal(errors.ErrNotFound))
+			Expect(authenticator.Challenge()).To(Equal("Bearer"))
+		})
+	})
+
+})
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+const (
+	defaultJwksRefreshInterval = time.Hour
+	minJwksRefetchInterval     = 10 * time.Second
+)
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks struct {
+	mutex           sync.Mutex
+	url             string
+	refreshInterval time.Duration
+	client          *http.Client
+	keys            map[string]any
+	fetchedAt       time.Time
+}
+
+func newJwks(url string, refreshInterval time.Duration, client *http.Client) *jwks {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJwksRefreshInterval
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &jwks{
+		url:             url,
+		refreshInterval: refreshInterval,
+		client:          client,
+	}
+}
+
+func (set *jwks) key(kid string) (any, error) {
+	if set == nil {
+		return nil, fmt.Errorf("%w: no key set is configured", errors.ErrUnauthorized)
+	}
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	key, found := set.lookup(kid)
+	sinceFetch := time.Since(set.fetchedAt)
+	if sinceFetch > set.refreshInterval || (!found && sinceFetch > minJwksRefetchInterval) {
+		if err := set.fetch(); err != nil && !found {
+			return nil, err
+		}
+		key, found = set.lookup(kid)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: unknown key %q", errors.ErrUnauthorized, kid)
+	}
+	return key, nil
+}
+
+func (set *jwks) lookup(kid string) (any, bool) {
+	if kid == "" && len(set.keys) == 1 {
+		for _, key := range set.keys {
+			return key, true
+		}
+	}
+
+	key, found := set.keys[kid]
+	return key, found
+}
+
+func (set *jwks) fetch() error {
+	set.fetchedAt = time.Now()
+
+	response, err := set.client.Get(set.url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching the key set failed with status %v", response.StatusCode)
+	}
+
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&document); err != nil {
+		return err
+	}
+
+	keys := map[string]any{}
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	set.keys = keys
+	return nil
+}
+
+func (jwk jsonWebKey) publicKey() (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.ErrInvalidArgument
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.ErrInvalidArgument
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.ErrInvalidArgument
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, errors.ErrInvalidArgument
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultAdminRole = "admin"
+	defaultLeeway    = 30 * time.Second
+	rolesClaim       = "roles"
+)
+
+type jwtAuthenticator struct {
+	secret      []byte
+	jwks        *jwks
+	issuer      string
+	audience    string
+	adminRole   string
+	tenantClaim string
+	leeway      time.Duration
+	parser      *jwt.Parser
+}
+
+type JwtOption func(*jwtAuthenticator)
+
+func NewJwt(options ...JwtOption) Authenticator {
+	instance := jwtAuthenticator{
+		adminRole: defaultAdminRole,
+		leeway:    defaultLeeway,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	var methods []string
+	if len(instance.secret) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if instance.jwks != nil {
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+
+	parserOptions := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(instance.leeway),
+	}
+	if instance.issuer != "" {
+		parserOptions = append(parserOptions, jwt.WithIssuer(instance.issuer))
+	}
+	if instance.audience != "" {
+		parserOptions = append(parserOptions, jwt.WithAudience(instance.audience))
+	}
+	instance.parser = jwt.NewParser(parserOptions...)
+
+	return &instance
+}
+
+func WithHmacSecret(secret string) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil && secret != "" {
+			authenticator.secret = []byte(secret)
+		}
+	}
+}
+
+func WithJwks(url string, refreshInterval time.Duration, client *http.Client) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil && url != "" {
+			authenticator.jwks = newJwks(url, refreshInterval, client)
+		}
+	}
+}
+
+func WithIssuer(issuer string) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil {
+			authenticator.issuer = issuer
+		}
+	}
+}
+
+func WithAudience(audience string) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil {
+			authenticator.audience = audience
+		}
+	}
+}
+
+func WithAdminRole(adminRole string) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil && adminRole != "" {
+			authenticator.adminRole = adminRole
+		}
+	}
+}
+
+func WithTenantClaim(tenantClaim string) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil {
+			authenticator.tenantClaim = tenantClaim
+		}
+	}
+}
+
+func WithLeeway(leeway time.Duration) JwtOption {
+	return func(authenticator *jwtAuthenticator) {
+		if authenticator != nil && leeway >= 0 {
+			authenticator.leeway = leeway
+		}
+	}
+}
+
+func (authenticator *jwtAuthenticator) Authenticate(r *http.Request) (reqctx.Principal, error) {
+	tokenString, found := bearerToken(r)
+	if !found {
+		return reqctx.Principal{}, errors.ErrNotFound
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := authenticator.parser.ParseWithClaims(tokenString, claims, authenticator.key)
+	if err != nil {
+		return reqctx.Principal{}, fmt.Errorf("%w: %v", errors.ErrUnauthorized, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return reqctx.Principal{}, fmt.Errorf("%w: the token has no subject", errors.ErrUnauthorized)
+	}
+
+	principal := reqctx.Principal{
+		Id:     subject,
+		Name:   stringClaim(claims, "name", "preferred_username"),
+		Method: reqctx.AuthMethodJwt,
+		Admin:  hasRole(claims, authenticator.adminRole),
+	}
+	if authenticator.tenantClaim != "" {
+		principal.Tenant = stringClaim(claims, authenticator.tenantClaim)
+	}
+	return principal, nil
+}
+
+func (authenticator *jwtAuthenticator) Challenge() string {
+	return bearerScheme
+}
+
+func (authenticator *jwtAuthenticator) key(token *jwt.Token) (any, error) {
+	if _, isHmac := token.Method.(*jwt.SigningMethodHMAC); isHmac {
+		return authenticator.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	return authenticator.jwks.key(kid)
+}
+
+func stringClaim(claims jwt.MapClaims, names ...string) string {
+	for _, name := range names {
+		if value, _ := claims[name].(string); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func hasRole(claims jwt.MapClaims, role string) bool {
+	switch roles := claims[rolesClaim].(type) {
+	case []any:
+		for _, value := range roles {
+			if value == role {
+				return true
+			}
+		}
+	case string:
+		for _, value := range strings.Fields(roles) {
+			if value == role {
+				return true
+			}
+		}
+	}
+	return false
+}
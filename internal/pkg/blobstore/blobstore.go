@@ -0,0 +1,12 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+)
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
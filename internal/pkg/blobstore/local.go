@@ -0,0 +1,84 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+type localStore struct {
+	root string
+}
+
+func NewLocal(root string) BlobStore {
+	return &localStore{
+		root: root,
+	}
+}
+
+func (store *localStore) Put(_ context.Context, key string, content io.Reader) (int64, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+
+	size, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return size, os.Rename(file.Name(), path)
+}
+
+func (store *localStore) Open(_ context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, errors.ErrNotFound
+	}
+	return file, err
+}
+
+func (store *localStore) Delete(_ context.Context, key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (store *localStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", errors.ErrInvalidArgument
+	}
+	return filepath.Join(store.root, filepath.FromSlash(key)), nil
+}
+
+func validKey(key string) bool {
+	return fs.ValidPath(key) && key != "."
+}
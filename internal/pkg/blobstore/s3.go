@@ -0,0 +1,239 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+const defaultS3Region = "us-east-1"
+
+type s3Store struct {
+	endpoint  string
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	signer    *Signer
+	now       func() time.Time
+}
+
+type S3Option func(*s3Store)
+
+func NewS3(endpoint string, bucket string, options ...S3Option) BlobStore {
+	instance := s3Store{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		bucket:   bucket,
+		region:   defaultS3Region,
+		client:   http.DefaultClient,
+		now:      time.Now,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	if instance.accessKey != "" {
+		signer := NewSigner(instance.accessKey, instance.secretKey, instance.region)
+		instance.signer = &signer
+	}
+
+	return &instance
+}
+
+func WithRegion(region string) S3Option {
+	return func(store *s3Store) {
+		if store != nil && region != "" {
+			store.region = region
+		}
+	}
+}
+
+func WithCredentials(accessKey string, secretKey string) S3Option {
+	return func(store *s3Store) {
+		if store != nil {
+			store.accessKey = accessKey
+			store.secretKey = secretKey
+		}
+	}
+}
+
+func WithHttpClient(client *http.Client) S3Option {
+	return func(store *s3Store) {
+		if store != nil && client != nil {
+			store.client = client
+		}
+	}
+}
+
+func (store *s3Store) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	body, err := io.ReadAll(content)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := store.do(ctx, http.MethodPut, key, body, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if err = checkStatus(response); err != nil {
+		return 0, err
+	}
+	return int64(len(body)), nil
+}
+
+func (store *s3Store) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	response, err := store.do(ctx, http.MethodHead, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+
+	if err = checkStatus(response); err != nil {
+		return nil, err
+	}
+
+	return &s3Object{
+		ctx:   ctx,
+		store: store,
+		key:   key,
+		size:  response.ContentLength,
+	}, nil
+}
+
+func (store *s3Store) Delete(ctx context.Context, key string) error {
+	response, err := store.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if err = checkStatus(response); err != nil && err != errors.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+func (store *s3Store) do(ctx context.Context, method string, key string, body []byte, header http.Header) (*http.Response, error) {
+	if !validKey(key) {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	target, err := url.Parse(store.endpoint + "/" + url.PathEscape(store.bucket) + "/" + escapeKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.ContentLength = int64(len(body))
+	if body == nil {
+		request.Body = http.NoBody
+	}
+	for name, values := range header {
+		request.Header[name] = values
+	}
+
+	if store.signer != nil {
+		payloadHash := EmptyPayloadHash
+		if len(body) > 0 {
+			payloadHash = hashHex(body)
+		}
+		store.signer.Sign(request, payloadHash, store.now())
+	}
+
+	return store.client.Do(request)
+}
+
+func checkStatus(response *http.Response) error {
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return errors.ErrNotFound
+	case response.StatusCode < 200 || response.StatusCode >= 300:
+		return fmt.Errorf("s3 responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+type s3Object struct {
+	ctx    context.Context
+	store  *s3Store
+	key    string
+	size   int64
+	offset int64
+	body   io.ReadCloser
+}
+
+func (object *s3Object) Read(p []byte) (int, error) {
+	if object.offset >= object.size {
+		return 0, io.EOF
+	}
+
+	if object.body == nil {
+		header := http.Header{}
+		header.Set("Range", fmt.Sprintf("bytes=%d-", object.offset))
+
+		response, err := object.store.do(object.ctx, http.MethodGet, object.key, nil, header)
+		if err != nil {
+			return 0, err
+		}
+		if err = checkStatus(response); err != nil {
+			response.Body.Close()
+			return 0, err
+		}
+		object.body = response.Body
+	}
+
+	n, err := object.body.Read(p)
+	object.offset += int64(n)
+	return n, err
+}
+
+func (object *s3Object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += object.offset
+	case io.SeekEnd:
+		offset += object.size
+	}
+	if offset < 0 {
+		return 0, errors.ErrInvalidArgument
+	}
+
+	if offset != object.offset {
+		object.Close()
+		object.offset = offset
+	}
+	return offset, nil
+}
+
+func (object *s3Object) Close() error {
+	if object.body == nil {
+		return nil
+	}
+	err := object.body.Close()
+	object.body = nil
+	return err
+}
@@ -0,0 +1,155 @@
+package blobstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	amzDateFormat    = "20060102T150405Z"
+	amzDayFormat     = "20060102"
+
+	headerAmzDate          = "X-Amz-Date"
+	headerAmzContentSha256 = "X-Amz-Content-Sha256"
+
+	EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type Signer struct {
+	accessKey string
+	secretKey string
+	region    string
+}
+
+func NewSigner(accessKey string, secretKey string, region string) Signer {
+	return Signer{
+		accessKey: accessKey,
+		secretKey: secretKey,
+		region:    region,
+	}
+}
+
+func (signer Signer) Sign(request *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	request.Header.Set(headerAmzDate, now.Format(amzDateFormat))
+	request.Header.Set(headerAmzContentSha256, payloadHash)
+
+	signedHeaders, canonicalHeaders := canonicalizeHeaders(request)
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		escapePath(request.URL.EscapedPath()),
+		canonicalizeQuery(request),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(amzDayFormat), signer.region, signingService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		now.Format(amzDateFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSha256([]byte("AWS4"+signer.secretKey), now.Format(amzDayFormat))
+	key = hmacSha256(key, signer.region)
+	key = hmacSha256(key, signingService)
+	key = hmacSha256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, signer.accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalizeHeaders(request *http.Request) (string, string) {
+	values := map[string]string{
+		"host": request.Host,
+	}
+	if values["host"] == "" {
+		values["host"] = request.URL.Host
+	}
+	for name, headerValues := range request.Header {
+		name = strings.ToLower(name)
+		if name == "range" || name == "content-type" || name == "content-md5" || strings.HasPrefix(name, "x-amz-") {
+			values[name] = strings.TrimSpace(strings.Join(headerValues, ","))
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + values[name] + "\n")
+	}
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func canonicalizeQuery(request *http.Request) string {
+	query := request.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func escapePath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func escape(value string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(value) {
+		if 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' || '0' <= b && b <= '9' || strings.IndexByte("-_.~", b) >= 0 {
+			escaped.WriteByte(b)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
+
+func hashHex(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSha256(key []byte, content string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
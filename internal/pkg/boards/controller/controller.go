@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/boards/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByIdFailed   = "GetById failed"
+	getByIdResponse = "GetById response"
+	moveFailed      = "Move failed"
+	moveResponse    = "Move response"
+
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetById(w http.ResponseWriter, r *http.Request)
+	Move(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	listId, stop := common.GetPathParamOrStop(w, r, constants.ListId)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.GetById(r.Context(), listId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByIdFailed, constants.ListId, listId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(getByIdResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Move(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	listId, stop := common.GetPathParamOrStop(w, r, constants.ListId)
+	if stop {
+		return
+	}
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(err, moveFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	request := model.MoveCardRequest{}
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		logger.Error(err, moveFailed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if !request.IsValid(id) {
+		logger.Error(errors.ErrInvalidArgument, moveFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	request.OverrideWipLimit = urlparams.ParseQueryFlag(r, constants.OverrideWipLimit)
+	if request.OverrideWipLimit && !reqctx.IsAdmin(r.Context()) {
+		logger.Error(errors.ErrForbidden, moveFailed, constants.ListId, listId, constants.Id, id)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, "Only administrators can override the WIP limits"))
+		return
+	}
+
+	entity, err := ctrl.service.Move(r.Context(), listId, id, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+				"The anchors are not in the target column of the board"))
+		} else if exceeded, ok := err.(wipModel.ExceededError); ok {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, "The WIP limit of the column is reached",
+				errorModel.WithDetails(exceeded)))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, moveFailed, constants.ListId, listId, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(moveResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
@@ -0,0 +1,173 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/boards/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		boardsCtrl  controller.Controller
+	)
+
+	newRequest := func(body string, params ...string) *http.Request {
+		request := httptest.NewRequest("", url, strings.NewReader(body))
+		ctx := request.Context()
+		for i := 0; i+1 < len(params); i += 2 {
+			ctx = reqctx.SetPathParam(ctx, params[i], params[i+1])
+		}
+		return request.WithContext(ctx)
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		boardsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+		When("the list id is not found", func() {
+			It("responds with status InternalServerError", func() {
+				boardsCtrl.GetById(recorder, newRequest(""))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(model.GetBoardResponse{}, err)
+
+				boardsCtrl.GetById(recorder, newRequest("", constants.ListId, "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status OK and the board in the payload", func() {
+			board := model.GetBoardResponse{ListId: 1, Name: "Home", Columns: []model.ColumnResponse{
+				{StatusId: 0, Name: "Todo", Count: 1, Tasks: []tasksModel.GetTaskResponse{{Id: 2, Name: "A task"}}},
+			}}
+			mockService.EXPECT().GetById(gomock.Any(), 1).Return(board, nil)
+
+			boardsCtrl.GetById(recorder, newRequest("", constants.ListId, "1"))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var payload model.GetBoardResponse
+			Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+			Expect(payload).To(Equal(board))
+		})
+	})
+
+	Describe("Move", func() {
+		const body = `{"statusId": 2, "after": 3}`
+
+		DescribeTable("rejects invalid payloads",
+			func(body string) {
+				boardsCtrl.Move(recorder, newRequest(body, constants.ListId, "1", constants.Id, "2"))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("malformed", "{"),
+			Entry("without status nor anchors", "{}"),
+			Entry("to an unknown status", `{"statusId": 9}`),
+		)
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Move(gomock.Any(), 1, 2, gomock.Any()).Return(tasksModel.GetTaskResponse{}, err)
+
+				boardsCtrl.Move(recorder, newRequest(body, constants.ListId, "1", constants.Id, "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("wrong anchors", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status Conflict and the occupancy when the WIP limit is reached", func() {
+			exceeded := wipModel.ExceededError{StatusId: 2, Limit: 3, Count: 3}
+			mockService.EXPECT().Move(gomock.Any(), 1, 2, gomock.Any()).Return(tasksModel.GetTaskResponse{}, exceeded)
+
+			boardsCtrl.Move(recorder, newRequest(body, constants.ListId, "1", constants.Id, "2"))
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"details":{"statusId":2,"limit":3,"count":3}`))
+		})
+
+		It("responds with status Forbidden when a non-administrator overrides the WIP limits", func() {
+			request := newRequest(body, constants.ListId, "1", constants.Id, "2")
+			request.URL.RawQuery = constants.OverrideWipLimit
+
+			boardsCtrl.Move(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("lets an administrator override the WIP limits", func() {
+			mockService.EXPECT().Move(gomock.Any(), 1, 2, gomock.Any()).DoAndReturn(
+				func(_ any, _ int, _ int, request model.MoveCardRequest) (tasksModel.GetTaskResponse, error) {
+					Expect(request.OverrideWipLimit).To(BeTrue())
+					return tasksModel.GetTaskResponse{Id: 2}, nil
+				})
+			request := newRequest(body, constants.ListId, "1", constants.Id, "2")
+			request.URL.RawQuery = constants.OverrideWipLimit
+			request = request.WithContext(reqctx.SetAdmin(request.Context(), true))
+
+			boardsCtrl.Move(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("responds with status OK and the moved task in the payload", func() {
+			status, after := 2, 3
+			moved := tasksModel.GetTaskResponse{Id: 2, Name: "A task", StatusId: status, ListId: 1}
+			mockService.EXPECT().Move(gomock.Any(), 1, 2, model.MoveCardRequest{StatusId: &status, After: &after}).Return(moved, nil)
+
+			boardsCtrl.Move(recorder, newRequest(body, constants.ListId, "1", constants.Id, "2"))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var payload tasksModel.GetTaskResponse
+			Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+			Expect(payload).To(Equal(moved))
+		})
+	})
+
+})
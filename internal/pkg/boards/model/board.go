@@ -0,0 +1,47 @@
+package model
+
+import (
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+)
+
+type GetBoardResponse struct {
+	ListId  int              `json:"listId"`
+	Name    string           `json:"name"`
+	Columns []ColumnResponse `json:"columns"`
+}
+
+type ColumnResponse struct {
+	StatusId int                          `json:"statusId"`
+	Name     string                       `json:"name"`
+	Count    int                          `json:"count"`
+	WipLimit *WipLimitResponse            `json:"wipLimit,omitempty"`
+	Tasks    []tasksModel.GetTaskResponse `json:"tasks"`
+}
+
+type WipLimitResponse struct {
+	ListId *int `json:"listId,omitempty"`
+	Limit  int  `json:"limit"`
+	Count  int  `json:"count"`
+}
+
+type MoveCardRequest struct {
+	StatusId *int `json:"statusId,omitempty"`
+	Before   *int `json:"before,omitempty"`
+	After    *int `json:"after,omitempty"`
+
+	OverrideWipLimit bool `json:"-"`
+}
+
+func (dto MoveCardRequest) IsValid(id int) bool {
+	return dto.ToMoveTaskRequest().IsValid(id)
+}
+
+func (dto MoveCardRequest) ToMoveTaskRequest() tasksModel.MoveTaskRequest {
+	return tasksModel.MoveTaskRequest{
+		StatusId: dto.StatusId,
+		Before:   dto.Before,
+		After:    dto.After,
+
+		OverrideWipLimit: dto.OverrideWipLimit,
+	}
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/model"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+)
+
+var _ = Describe("Model", func() {
+
+	const id = 3
+
+	anchor := func(id int) *int {
+		return &id
+	}
+
+	Describe("MoveCardRequest", func() {
+		DescribeTable("IsValid",
+			func(request model.MoveCardRequest, expected bool) {
+				Expect(request.IsValid(id)).To(Equal(expected))
+			},
+			Entry("without status nor anchors", model.MoveCardRequest{}, false),
+			Entry("to another column", model.MoveCardRequest{StatusId: anchor(tasksEntity.StatusIdDone)}, true),
+			Entry("to an unknown column", model.MoveCardRequest{StatusId: anchor(7)}, false),
+			Entry("after another task", model.MoveCardRequest{After: anchor(1)}, true),
+			Entry("before itself", model.MoveCardRequest{Before: anchor(id)}, false),
+		)
+
+		It("converts to a move request in the same list", func() {
+			request := model.MoveCardRequest{StatusId: anchor(tasksEntity.StatusIdInProgress), After: anchor(1), Before: anchor(2)}
+
+			Expect(request.ToMoveTaskRequest()).To(Equal(tasksModel.MoveTaskRequest{
+				StatusId: anchor(tasksEntity.StatusIdInProgress),
+				After:    anchor(1),
+				Before:   anchor(2),
+			}))
+		})
+	})
+
+})
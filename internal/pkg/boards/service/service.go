@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	tasks "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+)
+
+type Service interface {
+	GetById(ctx context.Context, listId int) (model.GetBoardResponse, error)
+	Move(ctx context.Context, listId int, id int, request model.MoveCardRequest) (tasksModel.GetTaskResponse, error)
+}
+
+type serviceImpl struct {
+	tasks      tasks.Service
+	repository tasksDAO.Repository
+	lists      listsDAO.Repository
+	limits     tasks.WipLimiter
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTasks(tasks tasks.Service) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func WithRepository(repository tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithLists(lists listsDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.lists = lists
+		}
+	}
+}
+
+func WithLimits(limits tasks.WipLimiter) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.limits = limits
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, listId int) (model.GetBoardResponse, error) {
+	list, err := service.lists.GetById(ctx, listId)
+	if err != nil {
+		return model.GetBoardResponse{}, err
+	}
+
+	entities, err := service.tasks.GetAll(ctx, tasksModel.GetTasksRequest{ListId: &listId, Sort: tasksModel.SortByRank})
+	if err != nil {
+		return model.GetBoardResponse{}, err
+	}
+
+	wipLimits, err := service.getWipLimits(ctx, listId)
+	if err != nil {
+		return model.GetBoardResponse{}, err
+	}
+
+	board := model.GetBoardResponse{ListId: list.Id, Name: list.Name}
+	for _, status := range tasksEntity.Workflow() {
+		column := model.ColumnResponse{StatusId: status.Id, Name: status.Name, Tasks: []tasksModel.GetTaskResponse{}}
+		for _, task := range entities {
+			if task.StatusId == status.Id {
+				column.Tasks = append(column.Tasks, task)
+			}
+		}
+		column.Count = len(column.Tasks)
+		column.WipLimit = wipLimits[status.Id]
+		board.Columns = append(board.Columns, column)
+	}
+	return board, nil
+}
+
+func (service *serviceImpl) getWipLimits(ctx context.Context, listId int) (map[int]*model.WipLimitResponse, error) {
+	if service.limits == nil || service.repository == nil {
+		return nil, nil
+	}
+
+	applicable := map[int][]*model.WipLimitResponse{}
+	for _, status := range tasksEntity.Workflow() {
+		limits, err := service.limits.GetByStatus(ctx, status.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, limit := range limits {
+			if limit.ListId == nil || *limit.ListId == listId {
+				applicable[status.Id] = append(applicable[status.Id], &model.WipLimitResponse{ListId: limit.ListId, Limit: limit.Limit})
+			}
+		}
+	}
+
+	wipLimits := map[int]*model.WipLimitResponse{}
+	if len(applicable) == 0 {
+		return wipLimits, nil
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range entities {
+		if task.IsArchived() {
+			continue
+		}
+		for _, wipLimit := range applicable[task.StatusId] {
+			if wipLimit.ListId == nil || task.ListId == *wipLimit.ListId {
+				wipLimit.Count++
+			}
+		}
+	}
+
+	for statusId, limits := range applicable {
+		for _, wipLimit := range limits {
+			if stricter, found := wipLimits[statusId]; !found || wipLimit.Limit-wipLimit.Count < stricter.Limit-stricter.Count {
+				wipLimits[statusId] = wipLimit
+			}
+		}
+	}
+	return wipLimits, nil
+}
+
+func (service *serviceImpl) Move(ctx context.Context, listId int, id int, request model.MoveCardRequest) (tasksModel.GetTaskResponse, error) {
+	task, err := service.tasks.GetById(ctx, id)
+	if err != nil {
+		return tasksModel.GetTaskResponse{}, err
+	}
+
+	if task.ListId != listId {
+		return tasksModel.GetTaskResponse{}, errors.ErrNotFound
+	}
+
+	return service.tasks.Move(ctx, id, request.ToMoveTaskRequest())
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/boards/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	listsDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/dao"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+	tasksServiceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const listId = 1
+
+	var (
+		customErr error
+		mockCtrl  *gomock.Controller
+		mockTasks *tasksServiceMock.MockService
+		mockLists *listsDaoMock.MockRepository
+		boardsSvc service.Service
+	)
+
+	anchor := func(id int) *int {
+		return &id
+	}
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTasks = tasksServiceMock.NewMockService(mockCtrl)
+		mockLists = listsDaoMock.NewMockRepository(mockCtrl)
+		boardsSvc = service.New(service.WithTasks(mockTasks), service.WithLists(mockLists))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+		It("returns the error of the lists repository", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(boardsSvc.GetById(ctx, listId)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the error of the tasks service", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{Id: listId, Name: "Home"}, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, customErr)
+
+			Expect(boardsSvc.GetById(ctx, listId)).Error().To(Equal(customErr))
+		})
+
+		It("groups the ranked tasks of the list into columns in workflow order", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{Id: listId, Name: "Home"}, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any(), tasksModel.GetTasksRequest{ListId: anchor(listId), Sort: tasksModel.SortByRank}).
+				Return([]tasksModel.GetTaskResponse{
+					{Id: 4, StatusId: tasksEntity.StatusIdTodo, Rank: "c"},
+					{Id: 2, StatusId: tasksEntity.StatusIdTodo, Rank: "i"},
+					{Id: 3, StatusId: tasksEntity.StatusIdDone, Rank: "a"},
+				}, nil)
+
+			board, err := boardsSvc.GetById(ctx, listId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(board.ListId).To(Equal(listId))
+			Expect(board.Name).To(Equal("Home"))
+			Expect(board.Columns).To(HaveLen(3))
+
+			todo, inProgress, done := board.Columns[0], board.Columns[1], board.Columns[2]
+			Expect(todo.StatusId).To(Equal(tasksEntity.StatusIdTodo))
+			Expect(todo.Name).To(Equal("Todo"))
+			Expect(todo.Count).To(Equal(2))
+			Expect(todo.Tasks[0].Id).To(Equal(4))
+			Expect(todo.Tasks[1].Id).To(Equal(2))
+			Expect(inProgress.StatusId).To(Equal(tasksEntity.StatusIdInProgress))
+			Expect(inProgress.Count).To(BeZero())
+			Expect(inProgress.Tasks).NotTo(BeNil())
+			Expect(done.Count).To(Equal(1))
+		})
+	})
+
+	Context("with WIP limits", func() {
+		var (
+			mockLimits     *tasksServiceMock.MockWipLimiter
+			mockRepository *tasksDaoMock.MockRepository
+		)
+
+		inProgress := tasksEntity.StatusIdInProgress
+
+		BeforeEach(func() {
+			mockLimits = tasksServiceMock.NewMockWipLimiter(mockCtrl)
+			mockRepository = tasksDaoMock.NewMockRepository(mockCtrl)
+			boardsSvc = service.New(service.WithTasks(mockTasks), service.WithRepository(mockRepository),
+				service.WithLists(mockLists), service.WithLimits(mockLimits))
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{Id: listId, Name: "Home"}, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any(), tasksModel.GetTasksRequest{ListId: anchor(listId), Sort: tasksModel.SortByRank}).
+				Return([]tasksModel.GetTaskResponse{{Id: 2, StatusId: inProgress, ListId: listId}}, nil)
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), tasksEntity.StatusIdTodo).Return(nil, nil)
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), tasksEntity.StatusIdDone).Return(nil, nil)
+		})
+
+		It("shows the limit of the list against the count of the column", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{
+				{StatusId: inProgress, Limit: 5},
+				{StatusId: inProgress, ListId: anchor(listId), Limit: 2},
+			}, nil)
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]tasksEntity.Task{
+				{Id: 2, StatusId: inProgress, ListId: listId},
+				{Id: 3, StatusId: inProgress, ListId: listId},
+				{Id: 4, StatusId: inProgress, ListId: 0},
+			}, nil)
+
+			board, err := boardsSvc.GetById(ctx, listId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(board.Columns[0].WipLimit).To(BeNil())
+			Expect(board.Columns[1].Count).To(Equal(1))
+			Expect(board.Columns[1].WipLimit).To(Equal(&model.WipLimitResponse{ListId: anchor(listId), Limit: 2, Count: 2}))
+		})
+
+		It("shows the global limit against the count of all the lists", func() {
+			archivedAt := time.Now()
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{{StatusId: inProgress, Limit: 5}}, nil)
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]tasksEntity.Task{
+				{Id: 2, StatusId: inProgress, ListId: listId},
+				{Id: 3, StatusId: inProgress, ListId: 0},
+				{Id: 4, StatusId: tasksEntity.StatusIdTodo, ListId: 0},
+				{Id: 5, StatusId: inProgress, ListId: 0, ArchivedAt: &archivedAt},
+			}, nil)
+
+			board, err := boardsSvc.GetById(ctx, listId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(board.Columns[1].WipLimit).To(Equal(&model.WipLimitResponse{Limit: 5, Count: 2}))
+		})
+
+		It("shows the global limit when it is stricter than the limit of the list", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{
+				{StatusId: inProgress, ListId: anchor(listId), Limit: 4},
+				{StatusId: inProgress, Limit: 3},
+			}, nil)
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]tasksEntity.Task{
+				{Id: 2, StatusId: inProgress, ListId: listId},
+				{Id: 3, StatusId: inProgress, ListId: listId},
+				{Id: 4, StatusId: inProgress, ListId: 0},
+			}, nil)
+
+			board, err := boardsSvc.GetById(ctx, listId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(board.Columns[1].WipLimit).To(Equal(&model.WipLimitResponse{Limit: 3, Count: 3}))
+		})
+	})
+
+	Describe("Move", func() {
+		request := model.MoveCardRequest{StatusId: anchor(tasksEntity.StatusIdDone)}
+
+		It("returns the error of the tasks service", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), 5).Return(tasksModel.GetTaskResponse{}, errors.ErrNotFound)
+
+			Expect(boardsSvc.Move(ctx, listId, 5, request)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound when the task is on another board", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), 5).Return(tasksModel.GetTaskResponse{Id: 5, ListId: 0}, nil)
+
+			Expect(boardsSvc.Move(ctx, listId, 5, request)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("moves the task within the list", func() {
+			moved := tasksModel.GetTaskResponse{Id: 5, ListId: listId, StatusId: tasksEntity.StatusIdDone}
+			mockTasks.EXPECT().GetById(gomock.Any(), 5).Return(tasksModel.GetTaskResponse{Id: 5, ListId: listId}, nil)
+			mockTasks.EXPECT().Move(gomock.Any(), 5, tasksModel.MoveTaskRequest{StatusId: anchor(tasksEntity.StatusIdDone)}).Return(moved, nil)
+
+			Expect(boardsSvc.Move(ctx, listId, 5, request)).To(Equal(moved))
+		})
+	})
+
+})
@@ -0,0 +1,210 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/go-logr/logr"
+)
+
+const defaultInterval = time.Minute
+
+var versions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+type Reloader interface {
+	Start(ctx context.Context)
+	Reload() error
+	TLSConfig() *tls.Config
+}
+
+type reloaderImpl struct {
+	mutex       sync.RWMutex
+	certFile    string
+	keyFile     string
+	clientCA    string
+	minVersion  uint16
+	interval    time.Duration
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    map[string]time.Time
+}
+
+type ReloaderOption func(*reloaderImpl)
+
+func ParseVersion(version string) (uint16, error) {
+	value, found := versions[version]
+	if !found {
+		return 0, fmt.Errorf("%w: unknown TLS version %q", errors.ErrInvalidArgument, version)
+	}
+	return value, nil
+}
+
+func New(certFile string, keyFile string, options ...ReloaderOption) Reloader {
+	instance := reloaderImpl{
+		certFile:   certFile,
+		keyFile:    keyFile,
+		minVersion: tls.VersionTLS12,
+		interval:   defaultInterval,
+		modTimes:   map[string]time.Time{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithClientCA(clientCA string) ReloaderOption {
+	return func(reloader *reloaderImpl) {
+		if reloader != nil {
+			reloader.clientCA = clientCA
+		}
+	}
+}
+
+func WithMinVersion(minVersion uint16) ReloaderOption {
+	return func(reloader *reloaderImpl) {
+		if reloader != nil && minVersion != 0 {
+			reloader.minVersion = minVersion
+		}
+	}
+}
+
+func WithInterval(interval time.Duration) ReloaderOption {
+	return func(reloader *reloaderImpl) {
+		if reloader != nil && interval > 0 {
+			reloader.interval = interval
+		}
+	}
+}
+
+func (reloader *reloaderImpl) Start(ctx context.Context) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	go func() {
+		ticker := time.NewTicker(reloader.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := reloader.Reload(); err != nil {
+				logger.Error(err, "Certificates reload failed, keeping the current ones")
+			}
+		}
+	}()
+}
+
+func (reloader *reloaderImpl) Reload() error {
+	files := []string{reloader.certFile, reloader.keyFile}
+	if reloader.clientCA != "" {
+		files = append(files, reloader.clientCA)
+	}
+
+	modTimes := map[string]time.Time{}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	if !reloader.isModified(modTimes) {
+		return nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(reloader.certFile, reloader.keyFile)
+	if err != nil {
+		return err
+	}
+
+	var clientCAs *x509.CertPool
+	if reloader.clientCA != "" {
+		content, err := os.ReadFile(reloader.clientCA)
+		if err != nil {
+			return err
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(content) {
+			return fmt.Errorf("%w: no certificate found in %s", errors.ErrInvalidArgument, reloader.clientCA)
+		}
+	}
+
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	reloader.certificate = &certificate
+	reloader.clientCAs = clientCAs
+	reloader.modTimes = modTimes
+	return nil
+}
+
+func (reloader *reloaderImpl) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         reloader.minVersion,
+		GetCertificate:     reloader.getCertificate,
+		GetConfigForClient: reloader.getConfigForClient,
+	}
+}
+
+func (reloader *reloaderImpl) isModified(modTimes map[string]time.Time) bool {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	if reloader.certificate == nil {
+		return true
+	}
+
+	for file, modTime := range modTimes {
+		if !modTime.Equal(reloader.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (reloader *reloaderImpl) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	if reloader.certificate == nil {
+		return nil, fmt.Errorf("%w: no certificate loaded", errors.ErrNotFound)
+	}
+	return reloader.certificate, nil
+}
+
+func (reloader *reloaderImpl) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	config := &tls.Config{
+		MinVersion:     reloader.minVersion,
+		NextProtos:     []string{"h2", "http/1.1"},
+		GetCertificate: reloader.getCertificate,
+	}
+	if reloader.clientCAs != nil {
+		config.ClientCAs = reloader.clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
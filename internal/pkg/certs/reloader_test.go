@@ -0,0 +1,280 @@
+package certs_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/certs"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/middleware"
+)
+
+type authority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func newAuthority() authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Dalil CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	certificate, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+
+	return authority{
+		certificate: certificate,
+		key:         key,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca authority) issue(serial int64, commonName string, usage x509.ExtKeyUsage) (certPEM []byte, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+var _ = Describe("Reloader", func() {
+
+	var (
+		ca       authority
+		dir      string
+		certFile string
+		keyFile  string
+		caFile   string
+		modTime  time.Time
+	)
+
+	write := func(path string, content []byte) {
+		Expect(os.WriteFile(path, content, 0o600)).To(Succeed())
+		modTime = modTime.Add(time.Second)
+		Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+	}
+
+	issueServer := func(serial int64) {
+		certPEM, keyPEM := ca.issue(serial, "localhost", x509.ExtKeyUsageServerAuth)
+		write(certFile, certPEM)
+		write(keyFile, keyPEM)
+	}
+
+	servedSerial := func(reloader certs.Reloader) int64 {
+		config, err := reloader.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		certificate, err := config.GetCertificate(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+		return leaf.SerialNumber.Int64()
+	}
+
+	BeforeEach(func() {
+		ca = newAuthority()
+		dir = GinkgoT().TempDir()
+		certFile = filepath.Join(dir, "server.crt")
+		keyFile = filepath.Join(dir, "server.key")
+		caFile = filepath.Join(dir, "ca.crt")
+		modTime = time.Now().Add(-time.Hour)
+		issueServer(2)
+		write(caFile, ca.pem)
+	})
+
+	Describe("ParseVersion", func() {
+		DescribeTable("parses the known versions",
+			func(version string, expected uint16) {
+				Expect(certs.ParseVersion(version)).To(Equal(expected))
+			},
+			Entry("1.2", "1.2", uint16(tls.VersionTLS12)),
+			Entry("1.3", "1.3", uint16(tls.VersionTLS13)),
+		)
+
+		It("returns ErrInvalidArgument for an unknown version", func() {
+			_, err := certs.ParseVersion("2.0")
+			Expect(err).To(MatchError(errors.ErrInvalidArgument))
+		})
+	})
+
+	Describe("Reload", func() {
+		It("returns the error when a file is missing", func() {
+			Expect(certs.New(certFile, filepath.Join(dir, "missing.key")).Reload()).NotTo(Succeed())
+		})
+
+		It("returns ErrInvalidArgument when the client CA file has no certificate", func() {
+			write(caFile, []byte("not a certificate"))
+
+			Expect(certs.New(certFile, keyFile, certs.WithClientCA(caFile)).Reload()).To(MatchError(errors.ErrInvalidArgument))
+		})
+
+		It("serves the rotated certificate", func() {
+			reloader := certs.New(certFile, keyFile)
+			Expect(reloader.Reload()).To(Succeed())
+			Expect(servedSerial(reloader)).To(Equal(int64(2)))
+
+			issueServer(3)
+
+			Expect(reloader.Reload()).To(Succeed())
+			Expect(servedSerial(reloader)).To(Equal(int64(3)))
+		})
+
+		It("keeps the current certificate when the rotated one is invalid", func() {
+			reloader := certs.New(certFile, keyFile)
+			Expect(reloader.Reload()).To(Succeed())
+
+			write(keyFile, []byte("not a key"))
+
+			Expect(reloader.Reload()).NotTo(Succeed())
+			Expect(servedSerial(reloader)).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("TLSConfig", func() {
+		It("has no certificate before the first reload", func() {
+			config, err := certs.New(certFile, keyFile).TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.GetCertificate(&tls.ClientHelloInfo{})).Error().To(MatchError(errors.ErrNotFound))
+		})
+
+		It("uses the minimum version and doesn't ask for client certificates without client CA", func() {
+			reloader := certs.New(certFile, keyFile, certs.WithMinVersion(tls.VersionTLS13))
+			Expect(reloader.Reload()).To(Succeed())
+
+			config, err := reloader.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reloader.TLSConfig().MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+			Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+			Expect(config.ClientAuth).To(Equal(tls.NoClientCert))
+		})
+
+		When("a client CA is configured", func() {
+			var (
+				url    string
+				client func(certificates ...tls.Certificate) *http.Client
+			)
+
+			BeforeEach(func() {
+				reloader := certs.New(certFile, keyFile, certs.WithClientCA(caFile))
+				Expect(reloader.Reload()).To(Succeed())
+
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+				url = fmt.Sprintf("https://%s/", listener.Addr().String())
+
+				server := &http.Server{
+					TLSConfig: reloader.TLSConfig(),
+					Handler: middleware.ClientContext(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						client, err := reqctx.GetClient(r.Context())
+						if err != nil {
+							w.WriteHeader(http.StatusUnauthorized)
+							return
+						}
+						_, _ = w.Write([]byte(client.CommonName))
+					})),
+				}
+				go func() {
+					_ = server.ServeTLS(listener, "", "")
+				}()
+				DeferCleanup(server.Close)
+
+				roots := x509.NewCertPool()
+				roots.AppendCertsFromPEM(ca.pem)
+				client = func(certificates ...tls.Certificate) *http.Client {
+					return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+						RootCAs:      roots,
+						Certificates: certificates,
+					}}}
+				}
+			})
+
+			It("exposes the identity of the verified client in the request context", func() {
+				certPEM, keyPEM := ca.issue(4, "worker", x509.ExtKeyUsageClientAuth)
+				certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := client(certificate).Get(url)
+				Expect(err).NotTo(HaveOccurred())
+				defer response.Body.Close()
+
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(io.ReadAll(response.Body)).To(Equal([]byte("worker")))
+			})
+
+			It("rejects the clients without certificate", func() {
+				Expect(client().Get(url)).Error().To(HaveOccurred())
+			})
+
+			It("rejects the clients having a certificate issued by another authority", func() {
+				certPEM, keyPEM := newAuthority().issue(5, "intruder", x509.ExtKeyUsageClientAuth)
+				certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client(certificate).Get(url)).Error().To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Start", func() {
+		It("reloads the certificates until the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			reloader := certs.New(certFile, keyFile, certs.WithInterval(time.Millisecond))
+			Expect(reloader.Reload()).To(Succeed())
+			reloader.Start(ctx)
+
+			issueServer(6)
+
+			Eventually(func() int64 {
+				return servedSerial(reloader)
+			}).Should(Equal(int64(6)))
+		})
+	})
+
+})
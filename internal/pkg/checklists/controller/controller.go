@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getAllFailed     = "GetAll failed"
+	getAllResponse   = "GetAll response"
+	addFailed        = "Add failed"
+	addResponse      = "Add response"
+	toggleFailed     = "Toggle failed"
+	toggleResponse   = "Toggle response"
+	moveFailed       = "Move failed"
+	moveResponse     = "Move response"
+	removeByIdFailed = "RemoveById failed"
+)
+
+const (
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	Toggle(w http.ResponseWriter, r *http.Request)
+	Move(w http.ResponseWriter, r *http.Request)
+	RemoveById(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.GetAll(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getAllFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(dto) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getAllResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetValidRequestOrStop[model.AddChecklistItemRequest](w, r, addFailed)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Add(r.Context(), taskId, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, addFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, dto.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(addResponse, constants.Payload, dto)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Toggle(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, itemId, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Toggle(r.Context(), taskId, itemId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, toggleFailed, constants.Id, taskId, constants.ItemId, itemId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(toggleResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Move(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, itemId, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetValidRequestOrStop[model.MoveChecklistItemRequest](w, r, moveFailed)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Move(r.Context(), taskId, itemId, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, moveFailed, constants.Id, taskId, constants.ItemId, itemId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(moveResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, itemId, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.RemoveById(r.Context(), taskId, itemId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, removeByIdFailed, constants.Id, taskId, constants.ItemId, itemId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getIdsOrStop(w http.ResponseWriter, r *http.Request) (taskId int, itemId int, stop bool) {
+	if taskId, stop = common.GetPathParamOrStop(w, r, constants.Id); stop {
+		return
+	}
+
+	itemId, stop = common.GetPathParamOrStop(w, r, constants.ItemId)
+	return
+}
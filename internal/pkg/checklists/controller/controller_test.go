@@ -0,0 +1,234 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/checklists/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder       *httptest.ResponseRecorder
+		mockCtrl       *gomock.Controller
+		mockService    *serviceMock.MockService
+		checklistsCtrl controller.Controller
+	)
+
+	newRequest := func(body string, itemId string) *http.Request {
+		request := httptest.NewRequest("", url, strings.NewReader(body))
+		ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+		if itemId != "" {
+			ctx = reqctx.SetPathParam(ctx, constants.ItemId, itemId)
+		}
+		return request.WithContext(ctx)
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		checklistsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+
+		When("the task id is not in the context", func() {
+			It("responds with status InternalServerError and an error response payload", func() {
+				checklistsCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+
+				var payload errorModel.Response
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the task is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).Return(nil, errors.ErrNotFound)
+
+				checklistsCtrl.GetAll(recorder, newRequest("", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the caller cannot read the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).Return(nil, errors.ErrForbidden)
+
+				checklistsCtrl.GetAll(recorder, newRequest("", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the checklist is empty", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().GetAll(gomock.Any(), 1).Return(nil, nil)
+
+				checklistsCtrl.GetAll(recorder, newRequest("", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the checklist is not empty", func() {
+			It("responds with status OK and the items in the payload", func() {
+				items := []model.GetChecklistItemResponse{{Id: 1, Text: "step", Done: true}}
+				mockService.EXPECT().GetAll(gomock.Any(), 1).Return(items, nil)
+
+				checklistsCtrl.GetAll(recorder, newRequest("", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload []model.GetChecklistItemResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(items))
+			})
+		})
+
+	})
+
+	Describe("Add", func() {
+
+		When("the body is not valid JSON", func() {
+			It("responds with status BadRequest", func() {
+				checklistsCtrl.Add(recorder, newRequest("{", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the request is not valid", func() {
+			It("responds with status BadRequest", func() {
+				checklistsCtrl.Add(recorder, newRequest(`{"text": " "}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the task is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, gomock.Any()).Return(model.GetChecklistItemResponse{}, errors.ErrNotFound)
+
+				checklistsCtrl.Add(recorder, newRequest(`{"text": "step"}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the item is added", func() {
+			It("responds with status Created, the location and the item in the payload", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, model.AddChecklistItemRequest{Text: "step"}).
+					Return(model.GetChecklistItemResponse{Id: 4, Text: "step"}, nil)
+
+				checklistsCtrl.Add(recorder, newRequest(`{"text": "step"}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(HaveSuffix("/4"))
+
+				var payload model.GetChecklistItemResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Id).To(Equal(4))
+			})
+		})
+
+	})
+
+	Describe("Toggle", func() {
+
+		When("the item id is not in the context", func() {
+			It("responds with status InternalServerError", func() {
+				checklistsCtrl.Toggle(recorder, newRequest("", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Toggle(gomock.Any(), 1, 2).Return(model.GetChecklistItemResponse{Id: 2, Done: true}, err)
+
+				checklistsCtrl.Toggle(recorder, newRequest("", "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusOK),
+			Entry("on an unknown item", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a task the caller cannot edit", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+	Describe("Move", func() {
+
+		When("the request is not valid", func() {
+			It("responds with status BadRequest", func() {
+				checklistsCtrl.Move(recorder, newRequest(`{}`, "2"))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Move(gomock.Any(), 1, 2, gomock.Any()).Return(nil, err)
+
+				checklistsCtrl.Move(recorder, newRequest(`{"position": 0}`, "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusOK),
+			Entry("on an unknown item", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a task the caller cannot edit", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+	Describe("RemoveById", func() {
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 2).Return(err)
+
+				checklistsCtrl.RemoveById(recorder, newRequest("", "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusNoContent),
+			Entry("on an unknown item", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a task the caller cannot edit", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+})
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+)
+
+const maxTextLength = 255
+
+type GetChecklistItemResponse struct {
+	Id   int    `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+func EntityToGetChecklistItemResponse(entity entity.ChecklistItem) GetChecklistItemResponse {
+	return GetChecklistItemResponse{
+		Id:   entity.Id,
+		Text: entity.Text,
+		Done: entity.Done,
+	}
+}
+
+type AddChecklistItemRequest struct {
+	Text     string `json:"text"`
+	Done     bool   `json:"done,omitempty"`
+	Position *int   `json:"position,omitempty"`
+}
+
+func (dto AddChecklistItemRequest) IsValid() bool {
+	text := strings.TrimSpace(dto.Text)
+	return text != "" && len(text) <= maxTextLength &&
+		(dto.Position == nil || *dto.Position >= 0)
+}
+
+func (dto AddChecklistItemRequest) ToEntity() entity.ChecklistItem {
+	return entity.ChecklistItem{
+		Text: strings.TrimSpace(dto.Text),
+		Done: dto.Done,
+	}
+}
+
+type MoveChecklistItemRequest struct {
+	Position *int `json:"position"`
+}
+
+func (dto MoveChecklistItemRequest) IsValid() bool {
+	return dto.Position != nil && *dto.Position >= 0
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+)
+
+var _ = Describe("Model", func() {
+
+	position := func(position int) *int {
+		return &position
+	}
+
+	Describe("EntityToGetChecklistItemResponse", func() {
+		It("returns a response that contains the same values as the entity", func() {
+			Expect(model.EntityToGetChecklistItemResponse(entity.ChecklistItem{Id: 1, Text: "step", Done: true})).
+				To(Equal(model.GetChecklistItemResponse{Id: 1, Text: "step", Done: true}))
+		})
+	})
+
+	Describe("AddChecklistItemRequest", func() {
+		DescribeTable("IsValid",
+			func(request model.AddChecklistItemRequest, expected bool) {
+				Expect(request.IsValid()).To(Equal(expected))
+			},
+			Entry("with a text", model.AddChecklistItemRequest{Text: "step"}, true),
+			Entry("with a text and a position", model.AddChecklistItemRequest{Text: "step", Position: position(0)}, true),
+			Entry("without text", model.AddChecklistItemRequest{}, false),
+			Entry("with a blank text", model.AddChecklistItemRequest{Text: "  "}, false),
+			Entry("with a too long text", model.AddChecklistItemRequest{Text: strings.Repeat("a", 256)}, false),
+			Entry("with a negative position", model.AddChecklistItemRequest{Text: "step", Position: position(-1)}, false),
+		)
+
+		Describe("ToEntity", func() {
+			It("returns an item with a trimmed text", func() {
+				Expect(model.AddChecklistItemRequest{Text: " step ", Done: true}.ToEntity()).
+					To(Equal(entity.ChecklistItem{Text: "step", Done: true}))
+			})
+		})
+	})
+
+	Describe("MoveChecklistItemRequest", func() {
+		DescribeTable("IsValid",
+			func(request model.MoveChecklistItemRequest, expected bool) {
+				Expect(request.IsValid()).To(Equal(expected))
+			},
+			Entry("with a position", model.MoveChecklistItemRequest{Position: position(2)}, true),
+			Entry("without position", model.MoveChecklistItemRequest{}, false),
+			Entry("with a negative position", model.MoveChecklistItemRequest{Position: position(-1)}, false),
+		)
+	})
+
+})
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetAll(ctx context.Context, taskId int) ([]model.GetChecklistItemResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return nil, err
+	}
+	return authorized.service.GetAll(ctx, taskId)
+}
+
+func (authorized *authorizedService) Add(ctx context.Context, taskId int, request model.AddChecklistItemRequest) (model.GetChecklistItemResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+	return authorized.service.Add(ctx, taskId, request)
+}
+
+func (authorized *authorizedService) Toggle(ctx context.Context, taskId int, itemId int) (model.GetChecklistItemResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+	return authorized.service.Toggle(ctx, taskId, itemId)
+}
+
+func (authorized *authorizedService) Move(ctx context.Context, taskId int, itemId int, request model.MoveChecklistItemRequest) ([]model.GetChecklistItemResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return nil, err
+	}
+	return authorized.service.Move(ctx, taskId, itemId, request)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, taskId int, itemId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, taskId, itemId)
+}
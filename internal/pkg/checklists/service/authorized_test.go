@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/checklists/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get the checklist of a task", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetAll(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to add an item", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Add(ctx, 1, model.AddChecklistItemRequest{Text: "step"})).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to toggle an item", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().Toggle(ctx, 1, 2).Return(model.GetChecklistItemResponse{Id: 2, Done: true}, nil)
+
+		Expect(authorizedSvc.Toggle(ctx, 1, 2)).To(HaveField("Done", true))
+	})
+
+	It("requires the editor role to move an item", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Move(ctx, 1, 2, model.MoveChecklistItemRequest{})).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to remove an item", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1, 2)).To(Equal(errors.ErrForbidden))
+	})
+
+})
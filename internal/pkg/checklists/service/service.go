@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+)
+
+type Service interface {
+	GetAll(ctx context.Context, taskId int) ([]model.GetChecklistItemResponse, error)
+	Add(ctx context.Context, taskId int, request model.AddChecklistItemRequest) (model.GetChecklistItemResponse, error)
+	Toggle(ctx context.Context, taskId int, itemId int) (model.GetChecklistItemResponse, error)
+	Move(ctx context.Context, taskId int, itemId int, request model.MoveChecklistItemRequest) ([]model.GetChecklistItemResponse, error)
+	RemoveById(ctx context.Context, taskId int, itemId int) error
+}
+
+type serviceImpl struct {
+	tasks tasksDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context, taskId int) ([]model.GetChecklistItemResponse, error) {
+	checklist, err := service.getChecklist(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toResponses(checklist), nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, taskId int, request model.AddChecklistItemRequest) (model.GetChecklistItemResponse, error) {
+	checklist, err := service.getChecklist(ctx, taskId)
+	if err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+
+	item := request.ToEntity()
+	for _, other := range checklist {
+		if other.Id > item.Id {
+			item.Id = other.Id
+		}
+	}
+	item.Id++
+
+	position := len(checklist)
+	if request.Position != nil && *request.Position < position {
+		position = *request.Position
+	}
+	checklist = insertAt(checklist, position, item)
+
+	if _, err = service.tasks.UpdateChecklist(ctx, taskId, checklist); err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+	return model.EntityToGetChecklistItemResponse(item), nil
+}
+
+func (service *serviceImpl) Toggle(ctx context.Context, taskId int, itemId int) (model.GetChecklistItemResponse, error) {
+	checklist, err := service.getChecklist(ctx, taskId)
+	if err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+
+	index := indexOf(checklist, itemId)
+	if index < 0 {
+		return model.GetChecklistItemResponse{}, errors.ErrNotFound
+	}
+	checklist[index].Done = !checklist[index].Done
+
+	if _, err = service.tasks.UpdateChecklist(ctx, taskId, checklist); err != nil {
+		return model.GetChecklistItemResponse{}, err
+	}
+	return model.EntityToGetChecklistItemResponse(checklist[index]), nil
+}
+
+func (service *serviceImpl) Move(ctx context.Context, taskId int, itemId int, request model.MoveChecklistItemRequest) ([]model.GetChecklistItemResponse, error) {
+	checklist, err := service.getChecklist(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := indexOf(checklist, itemId)
+	if index < 0 {
+		return nil, errors.ErrNotFound
+	}
+
+	item := checklist[index]
+	checklist = append(checklist[:index], checklist[index+1:]...)
+
+	position := len(checklist)
+	if *request.Position < position {
+		position = *request.Position
+	}
+	checklist = insertAt(checklist, position, item)
+
+	if _, err = service.tasks.UpdateChecklist(ctx, taskId, checklist); err != nil {
+		return nil, err
+	}
+	return toResponses(checklist), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, taskId int, itemId int) error {
+	checklist, err := service.getChecklist(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	index := indexOf(checklist, itemId)
+	if index < 0 {
+		return errors.ErrNotFound
+	}
+	checklist = append(checklist[:index], checklist[index+1:]...)
+
+	_, err = service.tasks.UpdateChecklist(ctx, taskId, checklist)
+	return err
+}
+
+func (service *serviceImpl) getChecklist(ctx context.Context, taskId int) ([]entity.ChecklistItem, error) {
+	task, err := service.tasks.GetById(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]entity.ChecklistItem(nil), task.Checklist...), nil
+}
+
+func indexOf(checklist []entity.ChecklistItem, itemId int) int {
+	for index, item := range checklist {
+		if item.Id == itemId {
+			return index
+		}
+	}
+	return -1
+}
+
+func insertAt(checklist []entity.ChecklistItem, position int, item entity.ChecklistItem) []entity.ChecklistItem {
+	checklist = append(checklist, entity.ChecklistItem{})
+	copy(checklist[position+1:], checklist[position:])
+	checklist[position] = item
+	return checklist
+}
+
+func toResponses(checklist []entity.ChecklistItem) []model.GetChecklistItemResponse {
+	var dto []model.GetChecklistItemResponse
+	for _, item := range checklist {
+		dto = append(dto, model.EntityToGetChecklistItemResponse(item))
+	}
+	return dto
+}
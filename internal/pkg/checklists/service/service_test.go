@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/checklists/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const taskId = 1
+
+	var (
+		customErr     error
+		mockCtrl      *gomock.Controller
+		mockTasks     *tasksDaoMock.MockRepository
+		checklistsSvc service.Service
+		task          entity.Task
+	)
+
+	position := func(position int) *int {
+		return &position
+	}
+
+	ids := func(checklist []entity.ChecklistItem) []int {
+		var ids []int
+		for _, item := range checklist {
+			ids = append(ids, item.Id)
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		checklistsSvc = service.New(service.WithTasks(mockTasks))
+
+		task = entity.Task{
+			Id: taskId,
+			Checklist: []entity.ChecklistItem{
+				{Id: 1, Text: "first"},
+				{Id: 3, Text: "second", Done: true},
+				{Id: 2, Text: "third"},
+			},
+		}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns the error of the repository", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(entity.Task{}, errors.ErrNotFound)
+
+			Expect(checklistsSvc.GetAll(ctx, taskId)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the items in order", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+
+			Expect(checklistsSvc.GetAll(ctx, taskId)).To(HaveExactElements(
+				HaveField("Id", 1), HaveField("Id", 3), HaveField("Id", 2),
+			))
+		})
+	})
+
+	Describe("Add", func() {
+		It("appends the item with the next id", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).
+				DoAndReturn(func(_ any, _ int, checklist []entity.ChecklistItem) (entity.Task, error) {
+					Expect(ids(checklist)).To(Equal([]int{1, 3, 2, 4}))
+					return entity.Task{}, nil
+				})
+
+			Expect(checklistsSvc.Add(ctx, taskId, model.AddChecklistItemRequest{Text: "fourth"})).
+				To(Equal(model.GetChecklistItemResponse{Id: 4, Text: "fourth"}))
+		})
+
+		It("inserts the item at the requested position", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).
+				DoAndReturn(func(_ any, _ int, checklist []entity.ChecklistItem) (entity.Task, error) {
+					Expect(ids(checklist)).To(Equal([]int{1, 4, 3, 2}))
+					return entity.Task{}, nil
+				})
+
+			Expect(checklistsSvc.Add(ctx, taskId, model.AddChecklistItemRequest{Text: "fourth", Position: position(1)})).
+				Error().NotTo(HaveOccurred())
+		})
+
+		It("returns the error of the repository", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).Return(entity.Task{}, customErr)
+
+			Expect(checklistsSvc.Add(ctx, taskId, model.AddChecklistItemRequest{Text: "fourth"})).Error().To(Equal(customErr))
+		})
+	})
+
+	Describe("Toggle", func() {
+		It("returns ErrNotFound for an unknown item", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+
+			Expect(checklistsSvc.Toggle(ctx, taskId, 9)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("flips the done flag of the item without altering the task", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).Return(entity.Task{}, nil)
+
+			Expect(checklistsSvc.Toggle(ctx, taskId, 3)).To(Equal(model.GetChecklistItemResponse{Id: 3, Text: "second"}))
+			Expect(task.Checklist[1].Done).To(BeTrue())
+		})
+	})
+
+	Describe("Move", func() {
+		It("returns ErrNotFound for an unknown item", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+
+			Expect(checklistsSvc.Move(ctx, taskId, 9, model.MoveChecklistItemRequest{Position: position(0)})).Error().
+				To(Equal(errors.ErrNotFound))
+		})
+
+		It("moves the item to the requested position", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).Return(entity.Task{}, nil)
+
+			Expect(checklistsSvc.Move(ctx, taskId, 2, model.MoveChecklistItemRequest{Position: position(0)})).To(HaveExactElements(
+				HaveField("Id", 2), HaveField("Id", 1), HaveField("Id", 3),
+			))
+		})
+
+		It("moves the item to the end when the position is out of range", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).Return(entity.Task{}, nil)
+
+			Expect(checklistsSvc.Move(ctx, taskId, 1, model.MoveChecklistItemRequest{Position: position(10)})).To(HaveExactElements(
+				HaveField("Id", 3), HaveField("Id", 2), HaveField("Id", 1),
+			))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns ErrNotFound for an unknown item", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+
+			Expect(checklistsSvc.RemoveById(ctx, taskId, 9)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("removes the item", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(task, nil)
+			mockTasks.EXPECT().UpdateChecklist(gomock.Any(), taskId, gomock.Any()).
+				DoAndReturn(func(_ any, _ int, checklist []entity.ChecklistItem) (entity.Task, error) {
+					Expect(ids(checklist)).To(Equal([]int{1, 2}))
+					return entity.Task{}, nil
+				})
+
+			Expect(checklistsSvc.RemoveById(ctx, taskId, 3)).To(Succeed())
+		})
+	})
+
+})
@@ -0,0 +1,262 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/comments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByTaskIdFailed   = "GetByTaskId failed"
+	getByTaskIdResponse = "GetByTaskId response"
+	addFailed           = "Add failed"
+	addResponse         = "Add response"
+	updateFailed        = "Update failed"
+	updateResponse      = "Update response"
+	removeByIdFailed    = "RemoveById failed"
+)
+
+const (
+	authorRequired = "The " + constants.AuthorHeader + " header is required"
+	notTheAuthor   = "Only the author of a comment can change it"
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetByTaskId(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	Update(w http.ResponseWriter, r *http.Request)
+	RemoveById(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetByTaskId(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	request, err := getPageRequest(r)
+	if err != nil || !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, getByTaskIdFailed, constants.Page, request.Page, constants.PageSize, request.PageSize)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid pagination"))
+		return
+	}
+
+	dto, err := ctrl.service.GetByTaskId(r.Context(), taskId, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByTaskIdFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if dto.Total == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getByTaskIdResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	author, stop := getAuthorOrStop(w, r, addFailed)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetValidRequestOrStop[model.UpsertCommentRequest](w, r, addFailed)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Add(r.Context(), taskId, author, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, addFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, dto.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(addResponse, constants.Payload, dto)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Update(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, id, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	author, stop := getAuthorOrStop(w, r, updateFailed)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetValidRequestOrStop[model.UpsertCommentRequest](w, r, updateFailed)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Update(r.Context(), taskId, id, author, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrNotModified {
+			w.WriteHeader(http.StatusNotModified)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, notTheAuthor))
+		} else {
+			logger.Error(err, updateFailed, constants.Id, taskId, constants.CommentId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(updateResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, id, stop := getIdsOrStop(w, r)
+	if stop {
+		return
+	}
+
+	author, stop := getAuthorOrStop(w, r, removeByIdFailed)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.RemoveById(r.Context(), taskId, id, author)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, notTheAuthor))
+		} else {
+			logger.Error(err, removeByIdFailed, constants.Id, taskId, constants.CommentId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getPageRequest(r *http.Request) (request model.GetCommentsRequest, err error) {
+	request.Page, err = getQueryInt(r, constants.Page, model.DefaultPage)
+	if err != nil {
+		return request, err
+	}
+
+	request.PageSize, err = getQueryInt(r, constants.PageSize, model.DefaultPageSize)
+	return request, err
+}
+
+func getQueryInt(r *http.Request, key string, defaultValue int) (int, error) {
+	value := urlparams.ParseQueryParam(r, key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func getAuthorOrStop(w http.ResponseWriter, r *http.Request, failed string) (author model.Author, stop bool) {
+	if principal, err := reqctx.GetPrincipal(r.Context()); err == nil {
+		author = model.Author{Id: principal.Method + ":" + principal.Id, Name: principal.Name}
+		if principal.UserId != nil {
+			author.Id = "user:" + strconv.Itoa(*principal.UserId)
+		}
+		if author.Name == "" {
+			author.Name = principal.Id
+		}
+		return author, false
+	}
+
+	name := strings.TrimSpace(r.Header.Get(constants.AuthorHeader))
+	if name == "" {
+		logr.FromContextOrDiscard(r.Context()).Error(errors.ErrInvalidArgument, failed, constants.Field, constants.AuthorHeader)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, authorRequired))
+		return author, true
+	}
+	return model.Author{Id: name, Name: name}, false
+}
+
+func getIdsOrStop(w http.ResponseWriter, r *http.Request) (taskId int, id int, stop bool) {
+	if taskId, stop = common.GetPathParamOrStop(w, r, constants.Id); stop {
+		return
+	}
+
+	id, stop = common.GetPathParamOrStop(w, r, constants.CommentId)
+	return
+}
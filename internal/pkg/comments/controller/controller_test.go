@@ -0,0 +1,253 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/comments/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const (
+		url    = "http://url"
+		author = "alice"
+	)
+
+	headerAuthor := model.Author{Id: author, Name: author}
+
+	var (
+		recorder     *httptest.ResponseRecorder
+		mockCtrl     *gomock.Controller
+		mockService  *serviceMock.MockService
+		commentsCtrl controller.Controller
+	)
+
+	newRequest := func(target string, body string, commentId string) *http.Request {
+		request := httptest.NewRequest("", target, strings.NewReader(body))
+		request.Header.Set(constants.AuthorHeader, author)
+		ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+		if commentId != "" {
+			ctx = reqctx.SetPathParam(ctx, constants.CommentId, commentId)
+		}
+		return request.WithContext(ctx)
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		commentsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+
+		When("the task id is not in the context", func() {
+			It("responds with status InternalServerError", func() {
+				commentsCtrl.GetByTaskId(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		DescribeTable("rejects an invalid pagination",
+			func(query string) {
+				commentsCtrl.GetByTaskId(recorder, newRequest(url+query, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("with a non numeric page", "?page=first"),
+			Entry("with a zero page", "?page=0"),
+			Entry("with a too large page size", "?pageSize=1000"),
+		)
+
+		When("the task is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1, gomock.Any()).Return(model.GetCommentsResponse{}, errors.ErrNotFound)
+
+				commentsCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the caller cannot read the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1, gomock.Any()).Return(model.GetCommentsResponse{}, errors.ErrForbidden)
+
+				commentsCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the task has no comments", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1, gomock.Any()).Return(model.GetCommentsResponse{}, nil)
+
+				commentsCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the task has comments", func() {
+			It("responds with status OK and the requested page in the payload", func() {
+				page := model.GetCommentsResponse{
+					Comments: []model.GetCommentResponse{{Id: 3, TaskId: 1, Author: author, Body: "hello"}},
+					Page:     2,
+					PageSize: 5,
+					Total:    6,
+				}
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1, model.GetCommentsRequest{Page: 2, PageSize: 5}).Return(page, nil)
+
+				commentsCtrl.GetByTaskId(recorder, newRequest(url+"?page=2&pageSize=5", "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetCommentsResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(page))
+			})
+		})
+
+	})
+
+	Describe("Add", func() {
+
+		When("the author header is missing", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request := newRequest(url, `{"body": "hello"}`, "")
+				request.Header.Del(constants.AuthorHeader)
+
+				commentsCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+				var payload errorModel.Response
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Message).To(ContainSubstring(constants.AuthorHeader))
+			})
+		})
+
+		When("the body is blank", func() {
+			It("responds with status BadRequest", func() {
+				commentsCtrl.Add(recorder, newRequest(url, `{"body": " "}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the caller cannot edit the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, headerAuthor, model.UpsertCommentRequest{Body: "hello"}).
+					Return(model.GetCommentResponse{}, errors.ErrForbidden)
+
+				commentsCtrl.Add(recorder, newRequest(url, `{"body": "hello"}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the request is authenticated", func() {
+			It("uses the principal as the author instead of the header", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, model.Author{Id: "jwt:alice", Name: "Alice"}, model.UpsertCommentRequest{Body: "hello"}).
+					Return(model.GetCommentResponse{Id: 3, TaskId: 1, Author: "Alice", Body: "hello"}, nil)
+
+				request := newRequest(url, `{"body": "hello"}`, "")
+				request = request.WithContext(reqctx.SetPrincipal(request.Context(),
+					reqctx.Principal{Id: "alice", Name: "Alice", Method: reqctx.AuthMethodJwt}))
+
+				commentsCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+			})
+
+			It("identifies the author by the user of the principal", func() {
+				userId := 4
+				mockService.EXPECT().Add(gomock.Any(), 1, model.Author{Id: "user:4", Name: "Alice"}, model.UpsertCommentRequest{Body: "hello"}).
+					Return(model.GetCommentResponse{Id: 3, TaskId: 1, Author: "Alice", Body: "hello"}, nil)
+
+				request := newRequest(url, `{"body": "hello"}`, "")
+				request = request.WithContext(reqctx.SetPrincipal(request.Context(),
+					reqctx.Principal{Id: "alice", Name: "Alice", Method: reqctx.AuthMethodJwt, UserId: &userId}))
+
+				commentsCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+			})
+		})
+
+		When("the comment is added", func() {
+			It("responds with status Created, the location and the comment in the payload", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, headerAuthor, model.UpsertCommentRequest{Body: "hello"}).
+					Return(model.GetCommentResponse{Id: 3, TaskId: 1, Author: author, Body: "hello"}, nil)
+
+				commentsCtrl.Add(recorder, newRequest(url, `{"body": "hello"}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(HaveSuffix("/3"))
+			})
+		})
+
+	})
+
+	Describe("Update", func() {
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Update(gomock.Any(), 1, 3, headerAuthor, model.UpsertCommentRequest{Body: "edited"}).
+					Return(model.GetCommentResponse{Id: 3, Body: "edited"}, err)
+
+				commentsCtrl.Update(recorder, newRequest(url, `{"body": "edited"}`, "3"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusOK),
+			Entry("on an unknown comment", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on an unchanged body", errors.ErrNotModified, http.StatusNotModified),
+			Entry("on a comment of another author", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+	Describe("RemoveById", func() {
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 3, headerAuthor).Return(err)
+
+				commentsCtrl.RemoveById(recorder, newRequest(url, "", "3"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusNoContent),
+			Entry("on an unknown comment", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a comment of another author", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+})
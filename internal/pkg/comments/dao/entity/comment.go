@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type Comment struct {
+	Id        int        `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	TaskId    int        `json:"taskId" gorm:"column:task_id;type:int;index"`
+	AuthorId  string     `json:"authorId" gorm:"column:author_id;type:varchar;size:255;index"`
+	Author    string     `json:"author" gorm:"column:author;type:varchar;size:255"`
+	Body      string     `json:"body" gorm:"column:body;type:text"`
+	CreatedAt time.Time  `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	EditedAt  *time.Time `json:"editedAt,omitempty" gorm:"column:edited_at;type:timestamp"`
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.Comment, error)
+	GetByTaskId(ctx context.Context, taskId int) ([]entity.Comment, error)
+	Insert(ctx context.Context, comment entity.Comment) (entity.Comment, error)
+	Update(ctx context.Context, comment entity.Comment) (entity.Comment, error)
+	RemoveById(ctx context.Context, id int) (entity.Comment, error)
+	RemoveByTaskId(ctx context.Context, taskId int) error
+}
+
+type partition struct {
+	comments map[int]entity.Comment
+	seq      int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithComments(comments map[int]entity.Comment) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && comments != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			partition.comments = comments
+			for id := range comments {
+				if id >= partition.seq {
+					partition.seq = id + 1
+				}
+			}
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		comments: map[int]entity.Comment{},
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.Comment, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	comment, found := partition.comments[id]
+	if !found {
+		return entity.Comment{}, errors.ErrNotFound
+	}
+	return comment, nil
+}
+
+func (repo *memoryRepository) GetByTaskId(ctx context.Context, taskId int) ([]entity.Comment, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var comments []entity.Comment
+	for _, comment := range partition.comments {
+		if comment.TaskId == taskId {
+			comments = append(comments, comment)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].Id < comments[j].Id
+	})
+
+	return comments, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, comment entity.Comment) (entity.Comment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	comment.Id, partition.seq = partition.seq, partition.seq+1
+	comment.CreatedAt = time.Now()
+	comment.EditedAt = nil
+	partition.comments[comment.Id] = comment
+	return comment, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, comment entity.Comment) (entity.Comment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldComment, found := partition.comments[comment.Id]
+	if !found {
+		return entity.Comment{}, errors.ErrNotFound
+	}
+
+	if oldComment.Body == comment.Body {
+		return entity.Comment{}, errors.ErrNotModified
+	}
+
+	editedAt := time.Now()
+	oldComment.Body = comment.Body
+	oldComment.EditedAt = &editedAt
+	partition.comments[comment.Id] = oldComment
+	return oldComment, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Comment, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	comment, found := partition.comments[id]
+	if !found {
+		return entity.Comment{}, errors.ErrNotFound
+	}
+	delete(partition.comments, id)
+	return comment, nil
+}
+
+func (repo *memoryRepository) RemoveByTaskId(ctx context.Context, taskId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for id, comment := range partition.comments {
+		if comment.TaskId == taskId {
+			delete(partition.comments, id)
+		}
+	}
+	return nil
+}
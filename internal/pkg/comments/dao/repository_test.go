@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		repo    repository.Repository
+		comment entity.Comment
+	)
+
+	BeforeEach(func() {
+		repo = repository.New()
+		comment, _ = repo.Insert(ctx, entity.Comment{TaskId: 1, Author: "alice", Body: "first"})
+		_, _ = repo.Insert(ctx, entity.Comment{TaskId: 2, Author: "bob", Body: "elsewhere"})
+		_, _ = repo.Insert(ctx, entity.Comment{TaskId: 1, Author: "bob", Body: "second"})
+	})
+
+	Describe("Insert", func() {
+		It("assigns an id and a creation timestamp", func() {
+			Expect(comment.Id).To(Equal(0))
+			Expect(comment.CreatedAt).NotTo(BeZero())
+			Expect(comment.EditedAt).To(BeNil())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+		It("returns the comments of the task, oldest first", func() {
+			Expect(repo.GetByTaskId(ctx, 1)).To(HaveExactElements(HaveField("Body", "first"), HaveField("Body", "second")))
+		})
+	})
+
+	Describe("Update", func() {
+		It("changes the body and sets the edition timestamp", func() {
+			updated, err := repo.Update(ctx, entity.Comment{Id: comment.Id, Author: "mallory", Body: "edited"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Body).To(Equal("edited"))
+			Expect(updated.Author).To(Equal("alice"))
+			Expect(updated.EditedAt).NotTo(BeNil())
+		})
+
+		It("returns ErrNotModified when the body is the same", func() {
+			Expect(repo.Update(ctx, entity.Comment{Id: comment.Id, Body: "first"})).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrNotFound for an unknown comment", func() {
+			Expect(repo.Update(ctx, entity.Comment{Id: 42, Body: "edited"})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the comment", func() {
+			Expect(repo.RemoveById(ctx, comment.Id)).To(HaveField("Body", "first"))
+			Expect(repo.GetById(ctx, comment.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveByTaskId", func() {
+		It("removes the comments of the task only", func() {
+			Expect(repo.RemoveByTaskId(ctx, 1)).To(Succeed())
+			Expect(repo.GetByTaskId(ctx, 1)).To(BeEmpty())
+			Expect(repo.GetByTaskId(ctx, 2)).To(HaveLen(1))
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the comments of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+
+			Expect(repo.GetById(acme, comment.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByTaskId(acme, 1)).To(BeEmpty())
+			Expect(repo.Insert(acme, entity.Comment{TaskId: 1, Author: "carol", Body: "mine"})).To(HaveField("Id", comment.Id))
+		})
+	})
+
+})
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+)
+
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+	maxBodyLength   = 10000
+)
+
+type GetCommentResponse struct {
+	Id        int        `json:"id"`
+	TaskId    int        `json:"taskId"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"createdAt,omitempty"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+}
+
+func EntityToGetCommentResponse(entity entity.Comment) GetCommentResponse {
+	return GetCommentResponse{
+		Id:        entity.Id,
+		TaskId:    entity.TaskId,
+		Author:    entity.Author,
+		Body:      entity.Body,
+		CreatedAt: entity.CreatedAt,
+		EditedAt:  entity.EditedAt,
+	}
+}
+
+type GetCommentsRequest struct {
+	Page     int
+	PageSize int
+}
+
+func (dto GetCommentsRequest) IsValid() bool {
+	return dto.Page >= 1 && dto.PageSize >= 1 && dto.PageSize <= MaxPageSize
+}
+
+type GetCommentsResponse struct {
+	Comments []GetCommentResponse `json:"comments"`
+	Page     int                  `json:"page"`
+	PageSize int                  `json:"pageSize"`
+	Total    int                  `json:"total"`
+}
+
+type Author struct {
+	Id   string
+	Name string
+}
+
+type UpsertCommentRequest struct {
+	Body string `json:"body"`
+}
+
+func (dto UpsertCommentRequest) IsValid() bool {
+	body := strings.TrimSpace(dto.Body)
+	return body != "" && len(body) <= maxBodyLength
+}
+
+func (dto UpsertCommentRequest) ToEntity(taskId int, author Author) entity.Comment {
+	return entity.Comment{
+		TaskId:   taskId,
+		AuthorId: author.Id,
+		Author:   author.Name,
+		Body:     strings.TrimSpace(dto.Body),
+	}
+}
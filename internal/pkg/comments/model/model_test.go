@@ -0,0 +1,45 @@
+package model_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+)
+
+var _ = Describe("Model", func() {
+
+	Describe("GetCommentsRequest", func() {
+		DescribeTable("IsValid",
+			func(request model.GetCommentsRequest, expected bool) {
+				Expect(request.IsValid()).To(Equal(expected))
+			},
+			Entry("with the defaults", model.GetCommentsRequest{Page: model.DefaultPage, PageSize: model.DefaultPageSize}, true),
+			Entry("with the largest page size", model.GetCommentsRequest{Page: 3, PageSize: model.MaxPageSize}, true),
+			Entry("with a zero page", model.GetCommentsRequest{Page: 0, PageSize: 10}, false),
+			Entry("with a zero page size", model.GetCommentsRequest{Page: 1, PageSize: 0}, false),
+			Entry("with a too large page size", model.GetCommentsRequest{Page: 1, PageSize: model.MaxPageSize + 1}, false),
+		)
+	})
+
+	Describe("UpsertCommentRequest", func() {
+		DescribeTable("IsValid",
+			func(body string, expected bool) {
+				Expect(model.UpsertCommentRequest{Body: body}.IsValid()).To(Equal(expected))
+			},
+			Entry("with a Markdown body", "**Looks good**", true),
+			Entry("without body", "", false),
+			Entry("with a blank body", " \n ", false),
+			Entry("with a too long body", strings.Repeat("a", 10001), false),
+		)
+
+		It("converts to an entity with a trimmed body", func() {
+			Expect(model.UpsertCommentRequest{Body: " _done_ \n"}.ToEntity(1, model.Author{Id: "user:1", Name: "alice"})).
+				To(Equal(entity.Comment{TaskId: 1, AuthorId: "user:1", Author: "alice", Body: "_done_"}))
+		})
+	})
+
+})
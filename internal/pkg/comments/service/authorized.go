@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetByTaskId(ctx context.Context, taskId int, request model.GetCommentsRequest) (model.GetCommentsResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return model.GetCommentsResponse{}, err
+	}
+	return authorized.service.GetByTaskId(ctx, taskId, request)
+}
+
+func (authorized *authorizedService) Add(ctx context.Context, taskId int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetCommentResponse{}, err
+	}
+	return authorized.service.Add(ctx, taskId, author, request)
+}
+
+func (authorized *authorizedService) Update(ctx context.Context, taskId int, id int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetCommentResponse{}, err
+	}
+	return authorized.service.Update(ctx, taskId, id, author, request)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, taskId int, id int, author model.Author) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, taskId, id, author)
+}
+
+func (authorized *authorizedService) RemoveTask(ctx context.Context, taskId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveTask(ctx, taskId)
+}
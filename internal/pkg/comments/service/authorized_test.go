@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/comments/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	bob := model.Author{Id: "user:2", Name: "bob"}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get the comments of a task", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetByTaskId(ctx, 1, model.GetCommentsRequest{})).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to add a comment", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Add(ctx, 1, bob, model.UpsertCommentRequest{Body: "hello"})).Error().
+			To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to update a comment", func() {
+		request := model.UpsertCommentRequest{Body: "hello"}
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().Update(ctx, 1, 3, bob, request).Return(model.GetCommentResponse{Id: 3}, nil)
+
+		Expect(authorizedSvc.Update(ctx, 1, 3, bob, request)).To(HaveField("Id", 3))
+	})
+
+	It("requires the editor role to remove a comment", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1, 3, bob)).To(Equal(errors.ErrForbidden))
+	})
+
+})
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+)
+
+type Service interface {
+	GetByTaskId(ctx context.Context, taskId int, request model.GetCommentsRequest) (model.GetCommentsResponse, error)
+	Add(ctx context.Context, taskId int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error)
+	Update(ctx context.Context, taskId int, id int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error)
+	RemoveById(ctx context.Context, taskId int, id int, author model.Author) error
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasksDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func (service *serviceImpl) GetByTaskId(ctx context.Context, taskId int, request model.GetCommentsRequest) (model.GetCommentsResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetCommentsResponse{}, err
+	}
+
+	entities, err := service.repository.GetByTaskId(ctx, taskId)
+	if err != nil {
+		return model.GetCommentsResponse{}, err
+	}
+
+	dto := model.GetCommentsResponse{
+		Comments: []model.GetCommentResponse{},
+		Page:     request.Page,
+		PageSize: request.PageSize,
+		Total:    len(entities),
+	}
+
+	start := (request.Page - 1) * request.PageSize
+	for index := start; index >= 0 && index < len(entities) && index < start+request.PageSize; index++ {
+		dto.Comments = append(dto.Comments, model.EntityToGetCommentResponse(entities[index]))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, taskId int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetCommentResponse{}, err
+	}
+
+	comment, err := service.repository.Insert(ctx, request.ToEntity(taskId, author))
+	if err != nil {
+		return model.GetCommentResponse{}, err
+	}
+	return model.EntityToGetCommentResponse(comment), nil
+}
+
+func (service *serviceImpl) Update(ctx context.Context, taskId int, id int, author model.Author, request model.UpsertCommentRequest) (model.GetCommentResponse, error) {
+	if _, err := service.getOwn(ctx, taskId, id, author); err != nil {
+		return model.GetCommentResponse{}, err
+	}
+
+	comment := request.ToEntity(taskId, author)
+	comment.Id = id
+
+	comment, err := service.repository.Update(ctx, comment)
+	if err != nil {
+		return model.GetCommentResponse{}, err
+	}
+	return model.EntityToGetCommentResponse(comment), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, taskId int, id int, author model.Author) error {
+	if _, err := service.getOwn(ctx, taskId, id, author); err != nil {
+		return err
+	}
+
+	_, err := service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) RemoveTask(ctx context.Context, taskId int) error {
+	return service.repository.RemoveByTaskId(ctx, taskId)
+}
+
+func (service *serviceImpl) getOwn(ctx context.Context, taskId int, id int, author model.Author) (entity.Comment, error) {
+	comment, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.Comment{}, err
+	}
+
+	if comment.TaskId != taskId {
+		return entity.Comment{}, errors.ErrNotFound
+	}
+
+	if comment.AuthorId != author.Id {
+		return entity.Comment{}, errors.ErrForbidden
+	}
+	return comment, nil
+}
+
+func (service *serviceImpl) checkTask(ctx context.Context, taskId int) error {
+	if service.tasks == nil {
+		return nil
+	}
+
+	_, err := service.tasks.GetById(ctx, taskId)
+	return err
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/comments/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/comments/dao"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const taskId = 1
+
+	author := model.Author{Id: "user:1", Name: "alice"}
+
+	var (
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockTasks      *tasksDaoMock.MockRepository
+		commentsSvc    service.Service
+		comments       []entity.Comment
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		commentsSvc = service.New(service.WithRepository(mockRepository), service.WithTasks(mockTasks))
+
+		comments = []entity.Comment{
+			{Id: 0, TaskId: taskId, AuthorId: author.Id, Author: author.Name, Body: "first"},
+			{Id: 2, TaskId: taskId, AuthorId: "user:2", Author: "bob", Body: "second"},
+			{Id: 5, TaskId: taskId, AuthorId: author.Id, Author: author.Name, Body: "third"},
+		}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+		It("returns ErrNotFound for an unknown task", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+			Expect(commentsSvc.GetByTaskId(ctx, taskId, model.GetCommentsRequest{Page: 1, PageSize: 2})).Error().
+				To(Equal(errors.ErrNotFound))
+		})
+
+		DescribeTable("returns the requested page",
+			func(page int, expectedIds []int) {
+				mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil)
+				mockRepository.EXPECT().GetByTaskId(gomock.Any(), taskId).Return(comments, nil)
+
+				dto, err := commentsSvc.GetByTaskId(ctx, taskId, model.GetCommentsRequest{Page: page, PageSize: 2})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.Page).To(Equal(page))
+				Expect(dto.PageSize).To(Equal(2))
+				Expect(dto.Total).To(Equal(3))
+
+				var ids []int
+				for _, comment := range dto.Comments {
+					ids = append(ids, comment.Id)
+				}
+				Expect(ids).To(Equal(expectedIds))
+			},
+			Entry("the first page", 1, []int{0, 2}),
+			Entry("the last page", 2, []int{5}),
+			Entry("a page out of range", 3, nil),
+		)
+	})
+
+	Describe("Add", func() {
+		It("inserts the comment of the author", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil)
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.Comment{TaskId: taskId, AuthorId: author.Id, Author: author.Name, Body: "hello"}).
+				Return(entity.Comment{Id: 6, TaskId: taskId, AuthorId: author.Id, Author: author.Name, Body: "hello"}, nil)
+
+			Expect(commentsSvc.Add(ctx, taskId, author, model.UpsertCommentRequest{Body: "hello"})).
+				To(HaveField("Id", 6))
+		})
+	})
+
+	Describe("Update", func() {
+		It("returns ErrNotFound for a comment of another task", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(entity.Comment{Id: 2, TaskId: 9, AuthorId: author.Id, Author: author.Name}, nil)
+
+			Expect(commentsSvc.Update(ctx, taskId, 2, author, model.UpsertCommentRequest{Body: "edited"})).Error().
+				To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrForbidden for a comment of another author", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(comments[1], nil)
+
+			Expect(commentsSvc.Update(ctx, taskId, 2, author, model.UpsertCommentRequest{Body: "edited"})).Error().
+				To(Equal(errors.ErrForbidden))
+		})
+
+		It("returns ErrForbidden for a comment of another author with the same name", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Comment{Id: 7, TaskId: taskId, AuthorId: "user:3", Author: author.Name}, nil)
+
+			Expect(commentsSvc.Update(ctx, taskId, 7, author, model.UpsertCommentRequest{Body: "edited"})).Error().
+				To(Equal(errors.ErrForbidden))
+		})
+
+		It("updates an own comment", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(comments[0], nil)
+			mockRepository.EXPECT().Update(gomock.Any(), entity.Comment{Id: 0, TaskId: taskId, AuthorId: author.Id, Author: author.Name, Body: "edited"}).
+				Return(entity.Comment{Id: 0, Body: "edited"}, nil)
+
+			Expect(commentsSvc.Update(ctx, taskId, 0, author, model.UpsertCommentRequest{Body: "edited"})).
+				To(HaveField("Body", "edited"))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns ErrForbidden for a comment of another author", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(comments[1], nil)
+
+			Expect(commentsSvc.RemoveById(ctx, taskId, 2, author)).To(Equal(errors.ErrForbidden))
+		})
+
+		It("returns ErrForbidden for a comment of another author with the same name", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Comment{Id: 7, TaskId: taskId, AuthorId: "user:3", Author: author.Name}, nil)
+
+			Expect(commentsSvc.RemoveById(ctx, taskId, 7, author)).To(Equal(errors.ErrForbidden))
+		})
+
+		It("removes an own comment", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 5).Return(comments[2], nil)
+			mockRepository.EXPECT().RemoveById(gomock.Any(), 5).Return(comments[2], nil)
+
+			Expect(commentsSvc.RemoveById(ctx, taskId, 5, author)).To(Succeed())
+		})
+	})
+
+	Describe("RemoveTask", func() {
+		It("removes the comments of the task", func() {
+			mockRepository.EXPECT().RemoveByTaskId(gomock.Any(), taskId).Return(nil)
+
+			Expect(commentsSvc.RemoveTask(ctx, taskId)).To(Succeed())
+		})
+	})
+
+})
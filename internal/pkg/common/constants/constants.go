@@ -3,7 +3,23 @@ package constants
 const (
 	AppName = "Dalil"
 
-	Id = "id"
+	Id           = "id"
+	TagId        = "tagId"
+	ListId       = "listId"
+	BlockerId    = "blockerId"
+	ItemId       = "itemId"
+	CommentId    = "commentId"
+	AttachmentId = "attachmentId"
+	EntryId      = "entryId"
+	TemplateId   = "templateId"
+	LimitId      = "limitId"
+	KeyId        = "keyId"
+	UserId       = "userId"
+
+	AuthorHeader     = "X-Author"
+	AdminTokenHeader = "X-Admin-Token"
+	ApiKeyHeader     = "X-API-Key"
+	TenantHeader     = "X-Tenant-Id"
 
 	Field    = "field"
 	Body     = "body"
@@ -11,4 +27,23 @@ const (
 	Location = "location"
 	Pattern  = "pattern"
 	Value    = "value"
+
+	Count    = "count"
+	Sort     = "sort"
+	Tag      = "tag"
+	TagMatch = "tagMatch"
+	Page     = "page"
+	PageSize = "pageSize"
+	Author   = "author"
+	GroupBy  = "groupBy"
+	From     = "from"
+	To       = "to"
+	Deep     = "deep"
+	Assignee = "assignee"
+
+	IncludeArchived  = "includeArchived"
+	IncludeInactive  = "includeInactive"
+	DryRun           = "dryRun"
+	Verbose          = "verbose"
+	OverrideWipLimit = "overrideWipLimit"
 )
@@ -6,4 +6,9 @@ var (
 	ErrNotFound        = errors.New("value not found")
 	ErrNotModified     = errors.New("value not modified")
 	ErrInvalidArgument = errors.New("invalid argument")
+	ErrConflict        = errors.New("value conflict")
+	ErrForbidden       = errors.New("forbidden")
+	ErrTooLarge        = errors.New("value too large")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrQuotaExceeded   = errors.New("quota exceeded")
 )
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -25,9 +26,111 @@ const (
 	keyAppPort     = "APP_PORT"
 	defaultAppPort = 8080
 
+	keyAppAdminToken = "APP_ADMIN_TOKEN"
+
+	keyAppServerReadTimeout             = "APP_SERVER_READ_TIMEOUT"
+	keyAppServerReadHeaderTimeout       = "APP_SERVER_READ_HEADER_TIMEOUT"
+	keyAppServerWriteTimeout            = "APP_SERVER_WRITE_TIMEOUT"
+	keyAppServerIdleTimeout             = "APP_SERVER_IDLE_TIMEOUT"
+	keyAppServerMaxHeaderBytes          = "APP_SERVER_MAX_HEADER_BYTES"
+	keyAppServerShutdownDelay           = "APP_SERVER_SHUTDOWN_DELAY"
+	keyAppServerShutdownGracePeriod     = "APP_SERVER_SHUTDOWN_GRACE_PERIOD"
+	defaultAppServerReadTimeout         = 15 * time.Second
+	defaultAppServerReadHeaderTimeout   = 5 * time.Second
+	defaultAppServerWriteTimeout        = 65 * time.Second
+	defaultAppServerIdleTimeout         = 2 * time.Minute
+	defaultAppServerMaxHeaderBytes      = 1 << 20
+	defaultAppServerShutdownDelay       = 0
+	defaultAppServerShutdownGracePeriod = 30 * time.Second
+
+	keyAppTlsCertFile           = "APP_TLS_CERT_FILE"
+	keyAppTlsKeyFile            = "APP_TLS_KEY_FILE"
+	keyAppTlsMinVersion         = "APP_TLS_MIN_VERSION"
+	keyAppTlsClientCaFile       = "APP_TLS_CLIENT_CA_FILE"
+	keyAppTlsReloadInterval     = "APP_TLS_RELOAD_INTERVAL"
+	defaultAppTlsMinVersion     = "1.2"
+	defaultAppTlsReloadInterval = time.Minute
+
 	keyAppLoggingVerbosityGlobal     = "APP_LOGGING_VERBOSITY_GLOBAL"
 	keyAppLoggingVerbosityModules    = "APP_LOGGING_VERBOSITY_MODULES"
 	defaultAppLoggingVerbosityGlobal = 0
+
+	keyAppRemindersOffsets         = "APP_REMINDERS_OFFSETS"
+	keyAppRemindersInterval        = "APP_REMINDERS_INTERVAL"
+	keyAppRemindersMaxAttempts     = "APP_REMINDERS_MAX_ATTEMPTS"
+	keyAppRemindersStorePath       = "APP_REMINDERS_STORE_PATH"
+	keyAppRemindersWebhookUrl      = "APP_REMINDERS_WEBHOOK_URL"
+	keyAppRemindersSmtpAddr        = "APP_REMINDERS_SMTP_ADDR"
+	keyAppRemindersSmtpFrom        = "APP_REMINDERS_SMTP_FROM"
+	keyAppRemindersSmtpTo          = "APP_REMINDERS_SMTP_TO"
+	keyAppRemindersSmtpUsername    = "APP_REMINDERS_SMTP_USERNAME"
+	keyAppRemindersSmtpPassword    = "APP_REMINDERS_SMTP_PASSWORD"
+	defaultAppRemindersOffset      = time.Hour
+	defaultAppRemindersInterval    = time.Minute
+	defaultAppRemindersMaxAttempts = 5
+
+	keyAppTasksParentDeletion      = "APP_TASKS_PARENT_DELETION"
+	keyAppTasksArchiveAfter        = "APP_TASKS_ARCHIVE_AFTER"
+	keyAppTasksArchiveInterval     = "APP_TASKS_ARCHIVE_INTERVAL"
+	defaultAppTasksParentDeletion  = "block"
+	defaultAppTasksArchiveAfter    = 30 * 24 * time.Hour
+	defaultAppTasksArchiveInterval = time.Hour
+
+	keyAppAttachmentsMaxSize       = "APP_ATTACHMENTS_MAX_SIZE"
+	keyAppAttachmentsStore         = "APP_ATTACHMENTS_STORE"
+	keyAppAttachmentsLocalPath     = "APP_ATTACHMENTS_LOCAL_PATH"
+	keyAppAttachmentsS3Endpoint    = "APP_ATTACHMENTS_S3_ENDPOINT"
+	keyAppAttachmentsS3Bucket      = "APP_ATTACHMENTS_S3_BUCKET"
+	keyAppAttachmentsS3Region      = "APP_ATTACHMENTS_S3_REGION"
+	keyAppAttachmentsS3AccessKey   = "APP_ATTACHMENTS_S3_ACCESS_KEY"
+	keyAppAttachmentsS3SecretKey   = "APP_ATTACHMENTS_S3_SECRET_KEY"
+	defaultAppAttachmentsMaxSize   = 10 << 20
+	defaultAppAttachmentsStore     = AttachmentsStoreLocal
+	defaultAppAttachmentsLocalPath = ".data/attachments"
+
+	keyAppMetricsPort = "APP_METRICS_PORT"
+
+	keyAppTracingExporter         = "APP_TRACING_EXPORTER"
+	keyAppTracingOtlpEndpoint     = "APP_TRACING_OTLP_ENDPOINT"
+	keyAppTracingSampleRatio      = "APP_TRACING_SAMPLE_RATIO"
+	defaultAppTracingExporter     = TracingExporterNone
+	defaultAppTracingOtlpEndpoint = "http://localhost:4318"
+	defaultAppTracingSampleRatio  = 1.0
+
+	keyAppAuthEnabled                    = "APP_AUTH_ENABLED"
+	keyAppAuthApiKeysStorePath           = "APP_AUTH_API_KEYS_STORE_PATH"
+	keyAppAuthJwtHmacSecret              = "APP_AUTH_JWT_HMAC_SECRET"
+	keyAppAuthJwtJwksUrl                 = "APP_AUTH_JWT_JWKS_URL"
+	keyAppAuthJwtJwksRefreshInterval     = "APP_AUTH_JWT_JWKS_REFRESH_INTERVAL"
+	keyAppAuthJwtIssuer                  = "APP_AUTH_JWT_ISSUER"
+	keyAppAuthJwtAudience                = "APP_AUTH_JWT_AUDIENCE"
+	keyAppAuthJwtAdminRole               = "APP_AUTH_JWT_ADMIN_ROLE"
+	keyAppAuthJwtTenantClaim             = "APP_AUTH_JWT_TENANT_CLAIM"
+	defaultAppAuthJwtJwksRefreshInterval = time.Hour
+	defaultAppAuthJwtAdminRole           = "admin"
+	defaultAppAuthJwtTenantClaim         = "tenant"
+
+	keyAppUsersStorePath = "APP_USERS_STORE_PATH"
+
+	keyAppTenancyEnabled    = "APP_TENANCY_ENABLED"
+	keyAppTenancyHeader     = "APP_TENANCY_HEADER"
+	keyAppTenancyQuotas     = "APP_TENANCY_QUOTAS"
+	keyAppTenancyQuotasPath = "APP_TENANCY_QUOTAS_PATH"
+	defaultAppTenancyHeader = "X-Tenant-Id"
+
+	keyAppHealthCheckTimeout     = "APP_HEALTH_CHECK_TIMEOUT"
+	defaultAppHealthCheckTimeout = 2 * time.Second
+)
+
+const (
+	AttachmentsStoreLocal = "local"
+	AttachmentsStoreS3    = "s3"
+)
+
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOtlp   = "otlp"
 )
 
 type LoggingConfig struct {
@@ -53,10 +156,127 @@ func (lc LoggingConfig) GetModules() (modules []string) {
 	return
 }
 
+type SmtpConfig struct {
+	Addr     string
+	From     string
+	To       []string
+	Username string
+	Password string
+}
+
+type RemindersConfig struct {
+	Offsets     []time.Duration
+	Interval    time.Duration
+	MaxAttempts int
+	StorePath   string
+	WebhookUrl  string
+	Smtp        SmtpConfig
+}
+
+type TasksConfig struct {
+	ParentDeletion  string
+	ArchiveAfter    time.Duration
+	ArchiveInterval time.Duration
+}
+
+type S3Config struct {
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+}
+
+type AttachmentsConfig struct {
+	MaxSize   int64
+	Store     string
+	LocalPath string
+	S3        S3Config
+}
+
+type ServerConfig struct {
+	ReadTimeout         time.Duration
+	ReadHeaderTimeout   time.Duration
+	WriteTimeout        time.Duration
+	IdleTimeout         time.Duration
+	MaxHeaderBytes      int
+	ShutdownDelay       time.Duration
+	ShutdownGracePeriod time.Duration
+}
+
+type TLSConfig struct {
+	CertFile       string
+	KeyFile        string
+	MinVersion     string
+	ClientCAFile   string
+	ReloadInterval time.Duration
+}
+
+func (tc TLSConfig) IsEnabled() bool {
+	return tc.CertFile != "" && tc.KeyFile != ""
+}
+
+type MetricsConfig struct {
+	Port int
+}
+
+type TracingConfig struct {
+	Exporter     string
+	OtlpEndpoint string
+	SampleRatio  float64
+}
+
+type JwtConfig struct {
+	HmacSecret          string
+	JwksUrl             string
+	JwksRefreshInterval time.Duration
+	Issuer              string
+	Audience            string
+	AdminRole           string
+	TenantClaim         string
+}
+
+func (jc JwtConfig) IsEnabled() bool {
+	return jc.HmacSecret != "" || jc.JwksUrl != ""
+}
+
+type AuthConfig struct {
+	Enabled          bool
+	ApiKeysStorePath string
+	Jwt              JwtConfig
+}
+
+type UsersConfig struct {
+	StorePath string
+}
+
+type TenancyConfig struct {
+	Enabled    bool
+	Header     string
+	Quotas     map[string]int
+	QuotasPath string
+}
+
+type HealthConfig struct {
+	CheckTimeout time.Duration
+}
+
 type AppConfig struct {
-	AppEnv  AppEnv
-	AppPort int
-	Logging LoggingConfig
+	AppEnv      AppEnv
+	AppPort     int
+	AdminToken  string
+	Server      ServerConfig
+	TLS         TLSConfig
+	Logging     LoggingConfig
+	Reminders   RemindersConfig
+	Tasks       TasksConfig
+	Attachments AttachmentsConfig
+	Health      HealthConfig
+	Metrics     MetricsConfig
+	Tracing     TracingConfig
+	Auth        AuthConfig
+	Users       UsersConfig
+	Tenancy     TenancyConfig
 }
 
 type AppConfigOption func(*AppConfig)
@@ -65,9 +285,55 @@ func New(options ...AppConfigOption) AppConfig {
 	instance := AppConfig{
 		AppEnv:  defaultAppEnv,
 		AppPort: defaultAppPort,
+		Server: ServerConfig{
+			ReadTimeout:         defaultAppServerReadTimeout,
+			ReadHeaderTimeout:   defaultAppServerReadHeaderTimeout,
+			WriteTimeout:        defaultAppServerWriteTimeout,
+			IdleTimeout:         defaultAppServerIdleTimeout,
+			MaxHeaderBytes:      defaultAppServerMaxHeaderBytes,
+			ShutdownDelay:       defaultAppServerShutdownDelay,
+			ShutdownGracePeriod: defaultAppServerShutdownGracePeriod,
+		},
+		TLS: TLSConfig{
+			MinVersion:     defaultAppTlsMinVersion,
+			ReloadInterval: defaultAppTlsReloadInterval,
+		},
 		Logging: LoggingConfig{
 			globalVerbosity: defaultAppLoggingVerbosityGlobal,
 		},
+		Reminders: RemindersConfig{
+			Offsets:     []time.Duration{defaultAppRemindersOffset},
+			Interval:    defaultAppRemindersInterval,
+			MaxAttempts: defaultAppRemindersMaxAttempts,
+		},
+		Tasks: TasksConfig{
+			ParentDeletion:  defaultAppTasksParentDeletion,
+			ArchiveAfter:    defaultAppTasksArchiveAfter,
+			ArchiveInterval: defaultAppTasksArchiveInterval,
+		},
+		Attachments: AttachmentsConfig{
+			MaxSize:   defaultAppAttachmentsMaxSize,
+			Store:     defaultAppAttachmentsStore,
+			LocalPath: defaultAppAttachmentsLocalPath,
+		},
+		Health: HealthConfig{
+			CheckTimeout: defaultAppHealthCheckTimeout,
+		},
+		Tracing: TracingConfig{
+			Exporter:     defaultAppTracingExporter,
+			OtlpEndpoint: defaultAppTracingOtlpEndpoint,
+			SampleRatio:  defaultAppTracingSampleRatio,
+		},
+		Auth: AuthConfig{
+			Jwt: JwtConfig{
+				JwksRefreshInterval: defaultAppAuthJwtJwksRefreshInterval,
+				AdminRole:           defaultAppAuthJwtAdminRole,
+				TenantClaim:         defaultAppAuthJwtTenantClaim,
+			},
+		},
+		Tenancy: TenancyConfig{
+			Header: defaultAppTenancyHeader,
+		},
 	}
 
 	for _, option := range options {
@@ -86,8 +352,89 @@ func WithEnvVars() AppConfigOption {
 
 			appConfig.AppEnv = getAppEnv()
 			appConfig.AppPort = getEnvVarInt(keyAppPort, defaultAppPort)
+			appConfig.AdminToken = strings.TrimSpace(os.Getenv(keyAppAdminToken))
+			appConfig.Server = ServerConfig{
+				ReadTimeout:         getEnvVarDuration(keyAppServerReadTimeout, defaultAppServerReadTimeout),
+				ReadHeaderTimeout:   getEnvVarDuration(keyAppServerReadHeaderTimeout, defaultAppServerReadHeaderTimeout),
+				WriteTimeout:        getEnvVarDuration(keyAppServerWriteTimeout, defaultAppServerWriteTimeout),
+				IdleTimeout:         getEnvVarDuration(keyAppServerIdleTimeout, defaultAppServerIdleTimeout),
+				MaxHeaderBytes:      getEnvVarInt(keyAppServerMaxHeaderBytes, defaultAppServerMaxHeaderBytes),
+				ShutdownDelay:       getEnvVarDuration(keyAppServerShutdownDelay, defaultAppServerShutdownDelay),
+				ShutdownGracePeriod: getEnvVarDuration(keyAppServerShutdownGracePeriod, defaultAppServerShutdownGracePeriod),
+			}
+			appConfig.TLS = TLSConfig{
+				CertFile:       os.Getenv(keyAppTlsCertFile),
+				KeyFile:        os.Getenv(keyAppTlsKeyFile),
+				MinVersion:     getEnvVarString(keyAppTlsMinVersion, defaultAppTlsMinVersion),
+				ClientCAFile:   os.Getenv(keyAppTlsClientCaFile),
+				ReloadInterval: getEnvVarDuration(keyAppTlsReloadInterval, defaultAppTlsReloadInterval),
+			}
 			appConfig.Logging.globalVerbosity = getEnvVarInt(keyAppLoggingVerbosityGlobal, defaultAppLoggingVerbosityGlobal)
 			appConfig.Logging.modulesVerbosity = getEnvVarInts(keyAppLoggingVerbosityModules)
+			appConfig.Reminders = RemindersConfig{
+				Offsets:     getEnvVarDurations(keyAppRemindersOffsets, []time.Duration{defaultAppRemindersOffset}),
+				Interval:    getEnvVarDuration(keyAppRemindersInterval, defaultAppRemindersInterval),
+				MaxAttempts: getEnvVarInt(keyAppRemindersMaxAttempts, defaultAppRemindersMaxAttempts),
+				StorePath:   os.Getenv(keyAppRemindersStorePath),
+				WebhookUrl:  os.Getenv(keyAppRemindersWebhookUrl),
+				Smtp: SmtpConfig{
+					Addr:     os.Getenv(keyAppRemindersSmtpAddr),
+					From:     os.Getenv(keyAppRemindersSmtpFrom),
+					To:       getEnvVarStrings(keyAppRemindersSmtpTo),
+					Username: os.Getenv(keyAppRemindersSmtpUsername),
+					Password: os.Getenv(keyAppRemindersSmtpPassword),
+				},
+			}
+			appConfig.Tasks = TasksConfig{
+				ParentDeletion:  getEnvVarString(keyAppTasksParentDeletion, defaultAppTasksParentDeletion),
+				ArchiveAfter:    getEnvVarDuration(keyAppTasksArchiveAfter, defaultAppTasksArchiveAfter),
+				ArchiveInterval: getEnvVarDuration(keyAppTasksArchiveInterval, defaultAppTasksArchiveInterval),
+			}
+			appConfig.Attachments = AttachmentsConfig{
+				MaxSize:   getEnvVarInt64(keyAppAttachmentsMaxSize, defaultAppAttachmentsMaxSize),
+				Store:     getEnvVarString(keyAppAttachmentsStore, defaultAppAttachmentsStore),
+				LocalPath: getEnvVarString(keyAppAttachmentsLocalPath, defaultAppAttachmentsLocalPath),
+				S3: S3Config{
+					Endpoint:  os.Getenv(keyAppAttachmentsS3Endpoint),
+					Bucket:    os.Getenv(keyAppAttachmentsS3Bucket),
+					Region:    os.Getenv(keyAppAttachmentsS3Region),
+					AccessKey: os.Getenv(keyAppAttachmentsS3AccessKey),
+					SecretKey: os.Getenv(keyAppAttachmentsS3SecretKey),
+				},
+			}
+			appConfig.Metrics = MetricsConfig{
+				Port: getEnvVarInt(keyAppMetricsPort, 0),
+			}
+			appConfig.Health = HealthConfig{
+				CheckTimeout: getEnvVarDuration(keyAppHealthCheckTimeout, defaultAppHealthCheckTimeout),
+			}
+			appConfig.Tracing = TracingConfig{
+				Exporter:     getEnvVarString(keyAppTracingExporter, defaultAppTracingExporter),
+				OtlpEndpoint: getEnvVarString(keyAppTracingOtlpEndpoint, defaultAppTracingOtlpEndpoint),
+				SampleRatio:  getEnvVarRatio(keyAppTracingSampleRatio, defaultAppTracingSampleRatio),
+			}
+			appConfig.Auth = AuthConfig{
+				Enabled:          getEnvVarBool(keyAppAuthEnabled, false),
+				ApiKeysStorePath: os.Getenv(keyAppAuthApiKeysStorePath),
+				Jwt: JwtConfig{
+					HmacSecret:          strings.TrimSpace(os.Getenv(keyAppAuthJwtHmacSecret)),
+					JwksUrl:             os.Getenv(keyAppAuthJwtJwksUrl),
+					JwksRefreshInterval: getEnvVarDuration(keyAppAuthJwtJwksRefreshInterval, defaultAppAuthJwtJwksRefreshInterval),
+					Issuer:              os.Getenv(keyAppAuthJwtIssuer),
+					Audience:            os.Getenv(keyAppAuthJwtAudience),
+					AdminRole:           getEnvVarString(keyAppAuthJwtAdminRole, defaultAppAuthJwtAdminRole),
+					TenantClaim:         getEnvVarString(keyAppAuthJwtTenantClaim, defaultAppAuthJwtTenantClaim),
+				},
+			}
+			appConfig.Users = UsersConfig{
+				StorePath: os.Getenv(keyAppUsersStorePath),
+			}
+			appConfig.Tenancy = TenancyConfig{
+				Enabled:    getEnvVarBool(keyAppTenancyEnabled, false),
+				Header:     getEnvVarString(keyAppTenancyHeader, defaultAppTenancyHeader),
+				Quotas:     getEnvVarInts(keyAppTenancyQuotas),
+				QuotasPath: os.Getenv(keyAppTenancyQuotasPath),
+			}
 		}
 	}
 }
@@ -108,6 +455,30 @@ func WithAppPort(appPort int) AppConfigOption {
 	}
 }
 
+func WithAdminToken(adminToken string) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.AdminToken = adminToken
+		}
+	}
+}
+
+func WithServer(server ServerConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Server = server
+		}
+	}
+}
+
+func WithTLS(tls TLSConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.TLS = tls
+		}
+	}
+}
+
 func WithLoggingGlobalVerbosity(loggingGlobalVerbosity int) AppConfigOption {
 	return func(appConfig *AppConfig) {
 		if appConfig != nil {
@@ -124,6 +495,78 @@ func WithLoggingModulesVerbosity(loggingModulesVerbosity map[string]int) AppConf
 	}
 }
 
+func WithReminders(reminders RemindersConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Reminders = reminders
+		}
+	}
+}
+
+func WithTasks(tasks TasksConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Tasks = tasks
+		}
+	}
+}
+
+func WithAttachments(attachments AttachmentsConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Attachments = attachments
+		}
+	}
+}
+
+func WithHealth(health HealthConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Health = health
+		}
+	}
+}
+
+func WithMetrics(metrics MetricsConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Metrics = metrics
+		}
+	}
+}
+
+func WithTracing(tracing TracingConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Tracing = tracing
+		}
+	}
+}
+
+func WithAuth(auth AuthConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Auth = auth
+		}
+	}
+}
+
+func WithUsers(users UsersConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Users = users
+		}
+	}
+}
+
+func WithTenancy(tenancy TenancyConfig) AppConfigOption {
+	return func(appConfig *AppConfig) {
+		if appConfig != nil {
+			appConfig.Tenancy = tenancy
+		}
+	}
+}
+
 func getAppEnv() AppEnv {
 	if value, found := os.LookupEnv(keyAppEnv); found {
 		switch value {
@@ -134,6 +577,24 @@ func getAppEnv() AppEnv {
 	return defaultAppEnv
 }
 
+func getEnvVarString(key string, defaultValue string) string {
+	if value, found := os.LookupEnv(key); found {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return defaultValue
+}
+
+func getEnvVarBool(key string, defaultValue bool) bool {
+	if value, found := os.LookupEnv(key); found {
+		if boolValue, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvVarInt(key string, defaultValue int) int {
 	if value, found := os.LookupEnv(key); found {
 		if intValue, err := strconv.Atoi(value); err == nil {
@@ -143,6 +604,59 @@ func getEnvVarInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvVarInt64(key string, defaultValue int64) int64 {
+	if value, found := os.LookupEnv(key); found {
+		if intValue, err := strconv.ParseInt(value, 10, 64); err == nil && intValue > 0 {
+			return intValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvVarRatio(key string, defaultValue float64) float64 {
+	if value, found := os.LookupEnv(key); found {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil && floatValue >= 0 && floatValue <= 1 {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvVarDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, found := os.LookupEnv(key); found {
+		if durationValue, err := time.ParseDuration(value); err == nil && durationValue > 0 {
+			return durationValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvVarDurations(key string, defaultValue []time.Duration) []time.Duration {
+	var durations []time.Duration
+	for _, value := range getEnvVarStrings(key) {
+		if durationValue, err := time.ParseDuration(value); err == nil && durationValue >= 0 {
+			durations = append(durations, durationValue)
+		}
+	}
+
+	if len(durations) > 0 {
+		return durations
+	}
+	return defaultValue
+}
+
+func getEnvVarStrings(key string) []string {
+	var values []string
+	if envVar, found := os.LookupEnv(key); found {
+		for _, value := range strings.Split(envVar, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
 func getEnvVarInts(key string) map[string]int {
 	if envVar, found := os.LookupEnv(key); found {
 		pairs := strings.Split(envVar, ",")
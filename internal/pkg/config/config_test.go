@@ -3,6 +3,7 @@ package config_test
 import (
 	"os"
 	"strconv"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -116,6 +117,463 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Context("WithEnvVars is specified with reminders environment variables", func() {
+			setEnv := func(key string, value string) {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				DeferCleanup(os.Unsetenv, key)
+			}
+
+			It("has reminders defaults when there are no reminders environment variables", func() {
+				instance := config.New(config.WithEnvVars())
+
+				Expect(instance.Reminders.Offsets).To(Equal([]time.Duration{time.Hour}))
+				Expect(instance.Reminders.Interval).To(Equal(time.Minute))
+				Expect(instance.Reminders.MaxAttempts).To(Equal(5))
+				Expect(instance.Reminders.StorePath).To(BeEmpty())
+			})
+
+			It("keeps the reminders defaults when the values cannot be parsed", func() {
+				setEnv("APP_REMINDERS_OFFSETS", "a day, soon")
+				setEnv("APP_REMINDERS_INTERVAL", "-1m")
+
+				instance := config.New(config.WithEnvVars())
+
+				Expect(instance.Reminders.Offsets).To(Equal([]time.Duration{time.Hour}))
+				Expect(instance.Reminders.Interval).To(Equal(time.Minute))
+			})
+
+			It("uses the parsed reminders values from the environment variables", func() {
+				setEnv("APP_REMINDERS_OFFSETS", "24h, 15m")
+				setEnv("APP_REMINDERS_INTERVAL", "30s")
+				setEnv("APP_REMINDERS_MAX_ATTEMPTS", "3")
+				setEnv("APP_REMINDERS_STORE_PATH", "/var/lib/dalil/reminders.json")
+				setEnv("APP_REMINDERS_WEBHOOK_URL", "http://localhost:9000/hooks")
+				setEnv("APP_REMINDERS_SMTP_ADDR", "localhost:1025")
+				setEnv("APP_REMINDERS_SMTP_FROM", "dalil@localhost")
+				setEnv("APP_REMINDERS_SMTP_TO", "me@localhost, you@localhost")
+
+				instance := config.New(config.WithEnvVars())
+
+				Expect(instance.Reminders).To(Equal(config.RemindersConfig{
+					Offsets:     []time.Duration{24 * time.Hour, 15 * time.Minute},
+					Interval:    30 * time.Second,
+					MaxAttempts: 3,
+					StorePath:   "/var/lib/dalil/reminders.json",
+					WebhookUrl:  "http://localhost:9000/hooks",
+					Smtp: config.SmtpConfig{
+						Addr: "localhost:1025",
+						From: "dalil@localhost",
+						To:   []string{"me@localhost", "you@localhost"},
+					},
+				}))
+			})
+		})
+
+		When("WithReminders is specified", func() {
+			It("has reminders settings having the value of the argument", func() {
+				reminders := config.RemindersConfig{Offsets: []time.Duration{time.Minute}, Interval: time.Second}
+
+				Expect(config.New(config.WithReminders(reminders)).Reminders).To(Equal(reminders))
+			})
+		})
+
+		Context("WithEnvVars is specified with tasks environment variables", func() {
+			It("blocks the deletion of parent tasks by default", func() {
+				Expect(config.New(config.WithEnvVars()).Tasks.ParentDeletion).To(Equal("block"))
+			})
+
+			It("uses the parent deletion from the environment variable", func() {
+				Expect(os.Setenv("APP_TASKS_PARENT_DELETION", "cascade")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TASKS_PARENT_DELETION")
+
+				Expect(config.New(config.WithEnvVars()).Tasks.ParentDeletion).To(Equal("cascade"))
+			})
+
+			It("archives the tasks done for 30 days, checking every hour, by default", func() {
+				tasks := config.New(config.WithEnvVars()).Tasks
+
+				Expect(tasks.ArchiveAfter).To(Equal(30 * 24 * time.Hour))
+				Expect(tasks.ArchiveInterval).To(Equal(time.Hour))
+			})
+
+			It("uses the archiving settings from the environment variables", func() {
+				Expect(os.Setenv("APP_TASKS_ARCHIVE_AFTER", "168h")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TASKS_ARCHIVE_AFTER")
+				Expect(os.Setenv("APP_TASKS_ARCHIVE_INTERVAL", "10m")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TASKS_ARCHIVE_INTERVAL")
+
+				tasks := config.New(config.WithEnvVars()).Tasks
+
+				Expect(tasks.ArchiveAfter).To(Equal(168 * time.Hour))
+				Expect(tasks.ArchiveInterval).To(Equal(10 * time.Minute))
+			})
+		})
+
+		When("WithTasks is specified", func() {
+			It("has tasks settings having the value of the argument", func() {
+				tasks := config.TasksConfig{ParentDeletion: "orphan"}
+
+				Expect(config.New(config.WithTasks(tasks)).Tasks).To(Equal(tasks))
+			})
+		})
+
+		Context("WithEnvVars is specified with attachments environment variables", func() {
+			setEnv := func(key string, value string) {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				DeferCleanup(os.Unsetenv, key)
+			}
+
+			It("has attachments defaults when there are no attachments environment variables", func() {
+				Expect(config.New(config.WithEnvVars()).Attachments).To(Equal(config.AttachmentsConfig{
+					MaxSize:   10 << 20,
+					Store:     config.AttachmentsStoreLocal,
+					LocalPath: ".data/attachments",
+				}))
+			})
+
+			It("keeps the default maximum size when the value is not a positive integer", func() {
+				setEnv("APP_ATTACHMENTS_MAX_SIZE", "-1")
+
+				Expect(config.New(config.WithEnvVars()).Attachments.MaxSize).To(Equal(int64(10 << 20)))
+			})
+
+			It("uses the attachments values from the environment variables", func() {
+				setEnv("APP_ATTACHMENTS_MAX_SIZE", "1024")
+				setEnv("APP_ATTACHMENTS_STORE", "s3")
+				setEnv("APP_ATTACHMENTS_S3_ENDPOINT", "http://localhost:9000")
+				setEnv("APP_ATTACHMENTS_S3_BUCKET", "dalil")
+				setEnv("APP_ATTACHMENTS_S3_REGION", "eu-west-1")
+				setEnv("APP_ATTACHMENTS_S3_ACCESS_KEY", "access")
+				setEnv("APP_ATTACHMENTS_S3_SECRET_KEY", "secret")
+
+				Expect(config.New(config.WithEnvVars()).Attachments).To(Equal(config.AttachmentsConfig{
+					MaxSize:   1024,
+					Store:     config.AttachmentsStoreS3,
+					LocalPath: ".data/attachments",
+					S3: config.S3Config{
+						Endpoint:  "http://localhost:9000",
+						Bucket:    "dalil",
+						Region:    "eu-west-1",
+						AccessKey: "access",
+						SecretKey: "secret",
+					},
+				}))
+			})
+		})
+
+		When("WithAttachments is specified", func() {
+			It("has attachments settings having the value of the argument", func() {
+				attachments := config.AttachmentsConfig{MaxSize: 1, Store: config.AttachmentsStoreS3}
+
+				Expect(config.New(config.WithAttachments(attachments)).Attachments).To(Equal(attachments))
+			})
+		})
+
+		Context("WithEnvVars is specified with server environment variables", func() {
+			setEnv := func(key string, value string) {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				DeferCleanup(os.Unsetenv, key)
+			}
+
+			It("has server defaults when there are no server environment variables", func() {
+				Expect(config.New(config.WithEnvVars()).Server).To(Equal(config.ServerConfig{
+					ReadTimeout:         15 * time.Second,
+					ReadHeaderTimeout:   5 * time.Second,
+					WriteTimeout:        65 * time.Second,
+					IdleTimeout:         2 * time.Minute,
+					MaxHeaderBytes:      1 << 20,
+					ShutdownGracePeriod: 30 * time.Second,
+				}))
+			})
+
+			It("uses the server values from the environment variables", func() {
+				setEnv("APP_SERVER_READ_TIMEOUT", "10s")
+				setEnv("APP_SERVER_READ_HEADER_TIMEOUT", "2s")
+				setEnv("APP_SERVER_WRITE_TIMEOUT", "20s")
+				setEnv("APP_SERVER_IDLE_TIMEOUT", "1m")
+				setEnv("APP_SERVER_MAX_HEADER_BYTES", "8192")
+				setEnv("APP_SERVER_SHUTDOWN_DELAY", "5s")
+				setEnv("APP_SERVER_SHUTDOWN_GRACE_PERIOD", "15s")
+
+				Expect(config.New(config.WithEnvVars()).Server).To(Equal(config.ServerConfig{
+					ReadTimeout:         10 * time.Second,
+					ReadHeaderTimeout:   2 * time.Second,
+					WriteTimeout:        20 * time.Second,
+					IdleTimeout:         time.Minute,
+					MaxHeaderBytes:      8192,
+					ShutdownDelay:       5 * time.Second,
+					ShutdownGracePeriod: 15 * time.Second,
+				}))
+			})
+		})
+
+		When("WithServer is specified", func() {
+			It("has server settings having the value of the argument", func() {
+				server := config.ServerConfig{ReadTimeout: time.Second}
+
+				Expect(config.New(config.WithServer(server)).Server).To(Equal(server))
+			})
+		})
+
+		Context("WithEnvVars is specified with TLS environment variables", func() {
+			setEnv := func(key string, value string) {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				DeferCleanup(os.Unsetenv, key)
+			}
+
+			It("has TLS disabled by default", func() {
+				tls := config.New(config.WithEnvVars()).TLS
+
+				Expect(tls).To(Equal(config.TLSConfig{MinVersion: "1.2", ReloadInterval: time.Minute}))
+				Expect(tls.IsEnabled()).To(BeFalse())
+			})
+
+			It("uses the TLS values from the environment variables", func() {
+				setEnv("APP_TLS_CERT_FILE", "server.crt")
+				setEnv("APP_TLS_KEY_FILE", "server.key")
+				setEnv("APP_TLS_MIN_VERSION", "1.3")
+				setEnv("APP_TLS_CLIENT_CA_FILE", "ca.crt")
+				setEnv("APP_TLS_RELOAD_INTERVAL", "10s")
+
+				tls := config.New(config.WithEnvVars()).TLS
+
+				Expect(tls).To(Equal(config.TLSConfig{
+					CertFile:       "server.crt",
+					KeyFile:        "server.key",
+					MinVersion:     "1.3",
+					ClientCAFile:   "ca.crt",
+					ReloadInterval: 10 * time.Second,
+				}))
+				Expect(tls.IsEnabled()).To(BeTrue())
+			})
+
+			It("has TLS disabled when the key is missing", func() {
+				setEnv("APP_TLS_CERT_FILE", "server.crt")
+
+				Expect(config.New(config.WithEnvVars()).TLS.IsEnabled()).To(BeFalse())
+			})
+		})
+
+		When("WithTLS is specified", func() {
+			It("has TLS settings having the value of the argument", func() {
+				tls := config.TLSConfig{CertFile: "server.crt", KeyFile: "server.key"}
+
+				Expect(config.New(config.WithTLS(tls)).TLS).To(Equal(tls))
+			})
+		})
+
+		Context("WithEnvVars is specified with metrics environment variables", func() {
+			It("serves the metrics on the application port by default", func() {
+				Expect(config.New(config.WithEnvVars()).Metrics.Port).To(BeZero())
+			})
+
+			It("uses the metrics port from the environment variable", func() {
+				Expect(os.Setenv("APP_METRICS_PORT", "9090")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_METRICS_PORT")
+
+				Expect(config.New(config.WithEnvVars()).Metrics.Port).To(Equal(9090))
+			})
+		})
+
+		When("WithMetrics is specified", func() {
+			It("has metrics settings having the value of the argument", func() {
+				metrics := config.MetricsConfig{Port: 9090}
+
+				Expect(config.New(config.WithMetrics(metrics)).Metrics).To(Equal(metrics))
+			})
+		})
+
+		Context("WithEnvVars is specified with health environment variables", func() {
+			It("times the health checks out after 2 seconds by default", func() {
+				Expect(config.New(config.WithEnvVars()).Health.CheckTimeout).To(Equal(2 * time.Second))
+			})
+
+			It("uses the health check timeout from the environment variable", func() {
+				Expect(os.Setenv("APP_HEALTH_CHECK_TIMEOUT", "500ms")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_HEALTH_CHECK_TIMEOUT")
+
+				Expect(config.New(config.WithEnvVars()).Health.CheckTimeout).To(Equal(500 * time.Millisecond))
+			})
+		})
+
+		When("WithHealth is specified", func() {
+			It("has health settings having the value of the argument", func() {
+				health := config.HealthConfig{CheckTimeout: time.Second}
+
+				Expect(config.New(config.WithHealth(health)).Health).To(Equal(health))
+			})
+		})
+
+		Context("WithEnvVars is specified with tracing environment variables", func() {
+			It("does not export the spans by default", func() {
+				Expect(config.New(config.WithEnvVars()).Tracing).To(Equal(config.TracingConfig{
+					Exporter:     config.TracingExporterNone,
+					OtlpEndpoint: "http://localhost:4318",
+					SampleRatio:  1,
+				}))
+			})
+
+			It("uses the tracing settings from the environment variables", func() {
+				Expect(os.Setenv("APP_TRACING_EXPORTER", "otlp")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TRACING_EXPORTER")
+				Expect(os.Setenv("APP_TRACING_OTLP_ENDPOINT", "https://collector:4318")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TRACING_OTLP_ENDPOINT")
+				Expect(os.Setenv("APP_TRACING_SAMPLE_RATIO", "0.25")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TRACING_SAMPLE_RATIO")
+
+				Expect(config.New(config.WithEnvVars()).Tracing).To(Equal(config.TracingConfig{
+					Exporter:     config.TracingExporterOtlp,
+					OtlpEndpoint: "https://collector:4318",
+					SampleRatio:  0.25,
+				}))
+			})
+
+			It("ignores a sample ratio out of range", func() {
+				Expect(os.Setenv("APP_TRACING_SAMPLE_RATIO", "1.5")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_TRACING_SAMPLE_RATIO")
+
+				Expect(config.New(config.WithEnvVars()).Tracing.SampleRatio).To(Equal(1.0))
+			})
+		})
+
+		When("WithTracing is specified", func() {
+			It("has tracing settings having the value of the argument", func() {
+				tracing := config.TracingConfig{Exporter: config.TracingExporterStdout, SampleRatio: 0.5}
+
+				Expect(config.New(config.WithTracing(tracing)).Tracing).To(Equal(tracing))
+			})
+		})
+
+		Context("WithEnvVars is specified with auth settings", func() {
+			It("has the authentication disabled by default", func() {
+				Expect(config.New(config.WithEnvVars()).Auth).To(Equal(config.AuthConfig{
+					Jwt: config.JwtConfig{JwksRefreshInterval: time.Hour, AdminRole: "admin", TenantClaim: "tenant"},
+				}))
+			})
+
+			It("uses the auth settings from the environment variables", func() {
+				for key, value := range map[string]string{
+					"APP_AUTH_ENABLED":                   "true",
+					"APP_AUTH_API_KEYS_STORE_PATH":       ".data/api-keys.json",
+					"APP_AUTH_JWT_HMAC_SECRET":           " s3cr3t ",
+					"APP_AUTH_JWT_JWKS_URL":              "https://idp/.well-known/jwks.json",
+					"APP_AUTH_JWT_JWKS_REFRESH_INTERVAL": "15m",
+					"APP_AUTH_JWT_ISSUER":                "https://idp",
+					"APP_AUTH_JWT_AUDIENCE":              "dalil",
+					"APP_AUTH_JWT_ADMIN_ROLE":            "ops",
+					"APP_AUTH_JWT_TENANT_CLAIM":          "org",
+				} {
+					Expect(os.Setenv(key, value)).To(Succeed())
+					DeferCleanup(os.Unsetenv, key)
+				}
+
+				appConfig := config.New(config.WithEnvVars())
+
+				Expect(appConfig.Auth).To(Equal(config.AuthConfig{
+					Enabled:          true,
+					ApiKeysStorePath: ".data/api-keys.json",
+					Jwt: config.JwtConfig{
+						HmacSecret:          "s3cr3t",
+						JwksUrl:             "https://idp/.well-known/jwks.json",
+						JwksRefreshInterval: 15 * time.Minute,
+						Issuer:              "https://idp",
+						Audience:            "dalil",
+						AdminRole:           "ops",
+						TenantClaim:         "org",
+					},
+				}))
+				Expect(appConfig.Auth.Jwt.IsEnabled()).To(BeTrue())
+			})
+
+			It("ignores an invalid enabled flag", func() {
+				Expect(os.Setenv("APP_AUTH_ENABLED", "maybe")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_AUTH_ENABLED")
+
+				Expect(config.New(config.WithEnvVars()).Auth.Enabled).To(BeFalse())
+			})
+		})
+
+		When("WithAuth is specified", func() {
+			It("has auth settings having the value of the argument", func() {
+				auth := config.AuthConfig{Enabled: true, Jwt: config.JwtConfig{HmacSecret: "s3cr3t"}}
+
+				Expect(config.New(config.WithAuth(auth)).Auth).To(Equal(auth))
+			})
+		})
+
+		Context("WithEnvVars is specified with users settings", func() {
+			It("keeps the users in memory by default", func() {
+				Expect(config.New(config.WithEnvVars()).Users).To(Equal(config.UsersConfig{}))
+			})
+
+			It("uses the users settings from the environment variables", func() {
+				Expect(os.Setenv("APP_USERS_STORE_PATH", ".data/users.json")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_USERS_STORE_PATH")
+
+				Expect(config.New(config.WithEnvVars()).Users).To(Equal(config.UsersConfig{StorePath: ".data/users.json"}))
+			})
+		})
+
+		When("WithUsers is specified", func() {
+			It("has users settings having the value of the argument", func() {
+				users := config.UsersConfig{StorePath: ".data/users.json"}
+
+				Expect(config.New(config.WithUsers(users)).Users).To(Equal(users))
+			})
+		})
+
+		Context("WithEnvVars is specified with tenancy settings", func() {
+			It("has the tenancy disabled by default", func() {
+				Expect(config.New(config.WithEnvVars()).Tenancy).To(Equal(config.TenancyConfig{Header: "X-Tenant-Id"}))
+			})
+
+			It("uses the tenancy settings from the environment variables", func() {
+				for key, value := range map[string]string{
+					"APP_TENANCY_ENABLED":     "true",
+					"APP_TENANCY_HEADER":      "X-Team",
+					"APP_TENANCY_QUOTAS":      "tasks=1000, lists=20,tags=x",
+					"APP_TENANCY_QUOTAS_PATH": ".data/quotas.json",
+				} {
+					Expect(os.Setenv(key, value)).To(Succeed())
+					DeferCleanup(os.Unsetenv, key)
+				}
+
+				Expect(config.New(config.WithEnvVars()).Tenancy).To(Equal(config.TenancyConfig{
+					Enabled:    true,
+					Header:     "X-Team",
+					Quotas:     map[string]int{"tasks": 1000, "lists": 20},
+					QuotasPath: ".data/quotas.json",
+				}))
+			})
+		})
+
+		When("WithTenancy is specified", func() {
+			It("has tenancy settings having the value of the argument", func() {
+				tenancy := config.TenancyConfig{Enabled: true, Header: "X-Team"}
+
+				Expect(config.New(config.WithTenancy(tenancy)).Tenancy).To(Equal(tenancy))
+			})
+		})
+
+		Context("WithEnvVars is specified with an admin token", func() {
+			It("has no admin token by default", func() {
+				Expect(config.New(config.WithEnvVars()).AdminToken).To(BeEmpty())
+			})
+
+			It("uses the trimmed admin token from the environment variable", func() {
+				Expect(os.Setenv("APP_ADMIN_TOKEN", " s3cr3t ")).To(Succeed())
+				DeferCleanup(os.Unsetenv, "APP_ADMIN_TOKEN")
+
+				Expect(config.New(config.WithEnvVars()).AdminToken).To(Equal("s3cr3t"))
+			})
+		})
+
+		When("WithAdminToken is specified", func() {
+			It("has an admin token having the value of the argument", func() {
+				Expect(config.New(config.WithAdminToken("s3cr3t")).AdminToken).To(Equal("s3cr3t"))
+			})
+		})
+
 		When("WithAppEnv is specified", func() {
 			It("has an env having the value of the argument", func() {
 				instance := config.New(config.WithAppEnv(customAppEnv))
@@ -68,3 +68,114 @@ func GetPathParam(ctx context.Context, key string) (PathParam, error) {
 
 	return *param, nil
 }
+
+type adminKey struct{}
+
+func SetAdmin(ctx context.Context, admin bool) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, adminKey{}, admin)
+}
+
+func IsAdmin(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	admin, _ := ctx.Value(adminKey{}).(bool)
+	return admin
+}
+
+type ClientIdentity struct {
+	CommonName     string   `json:"commonName"`
+	Organization   []string `json:"organization,omitempty"`
+	DNSNames       []string `json:"dnsNames,omitempty"`
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+	SerialNumber   string   `json:"serialNumber"`
+}
+
+type clientKey struct{}
+
+func SetClient(ctx context.Context, client ClientIdentity) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+func GetClient(ctx context.Context) (ClientIdentity, error) {
+	if ctx == nil {
+		return ClientIdentity{}, errors.ErrNotFound
+	}
+
+	client, found := ctx.Value(clientKey{}).(ClientIdentity)
+	if !found {
+		return ClientIdentity{}, errors.ErrNotFound
+	}
+
+	return client, nil
+}
+
+const (
+	AuthMethodApiKey     = "api_key"
+	AuthMethodJwt        = "jwt"
+	AuthMethodAdminToken = "admin_token"
+)
+
+type Principal struct {
+	Id     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Method string `json:"method"`
+	Admin  bool   `json:"admin"`
+	UserId *int   `json:"userId,omitempty"`
+	Tenant string `json:"tenant,omitempty"`
+}
+
+type principalKey struct{}
+
+func SetPrincipal(ctx context.Context, principal Principal) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func GetPrincipal(ctx context.Context) (Principal, error) {
+	if ctx == nil {
+		return Principal{}, errors.ErrNotFound
+	}
+
+	principal, found := ctx.Value(principalKey{}).(Principal)
+	if !found {
+		return Principal{}, errors.ErrNotFound
+	}
+
+	return principal, nil
+}
+
+type tenantKey struct{}
+
+func SetTenant(ctx context.Context, tenant string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+func GetTenant(ctx context.Context) (string, error) {
+	if ctx == nil {
+		return "", errors.ErrNotFound
+	}
+
+	tenant, found := ctx.Value(tenantKey{}).(string)
+	if !found {
+		return "", errors.ErrNotFound
+	}
+
+	return tenant, nil
+}
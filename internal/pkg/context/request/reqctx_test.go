@@ -162,4 +162,76 @@ var _ = Describe("ReqCtx", func() {
 
 	})
 
+	Describe("IsAdmin", func() {
+
+		It("returns false for a nil context", func() {
+			Expect(reqctx.IsAdmin(nilCtx)).To(BeFalse())
+		})
+
+		It("returns false when the flag was never set", func() {
+			Expect(reqctx.IsAdmin(context.TODO())).To(BeFalse())
+		})
+
+		It("returns the flag set in the context", func() {
+			Expect(reqctx.IsAdmin(reqctx.SetAdmin(context.TODO(), true))).To(BeTrue())
+			Expect(reqctx.IsAdmin(reqctx.SetAdmin(nilCtx, false))).To(BeFalse())
+		})
+
+	})
+
+	Describe("GetClient", func() {
+
+		client := reqctx.ClientIdentity{CommonName: "worker", DNSNames: []string{"worker.local"}, SerialNumber: "42"}
+
+		It("returns ErrNotFound for a nil context", func() {
+			Expect(reqctx.GetClient(nilCtx)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound when the client was never set", func() {
+			Expect(reqctx.GetClient(context.TODO())).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the client set in the context", func() {
+			Expect(reqctx.GetClient(reqctx.SetClient(context.TODO(), client))).To(Equal(client))
+			Expect(reqctx.GetClient(reqctx.SetClient(nilCtx, client))).To(Equal(client))
+		})
+
+	})
+
+	Describe("GetPrincipal", func() {
+
+		principal := reqctx.Principal{Id: "key-1", Name: "ci", Method: reqctx.AuthMethodApiKey, Admin: true}
+
+		It("returns ErrNotFound for a nil context", func() {
+			Expect(reqctx.GetPrincipal(nilCtx)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound when the principal was never set", func() {
+			Expect(reqctx.GetPrincipal(context.TODO())).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the principal set in the context", func() {
+			Expect(reqctx.GetPrincipal(reqctx.SetPrincipal(context.TODO(), principal))).To(Equal(principal))
+			Expect(reqctx.GetPrincipal(reqctx.SetPrincipal(nilCtx, principal))).To(Equal(principal))
+		})
+
+	})
+
+	Describe("GetTenant", func() {
+
+		It("returns ErrNotFound for a nil context", func() {
+			Expect(reqctx.GetTenant(nilCtx)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound when the tenant was never set", func() {
+			Expect(reqctx.GetTenant(context.TODO())).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the tenant set in the context", func() {
+			Expect(reqctx.GetTenant(reqctx.SetTenant(context.TODO(), "acme"))).To(Equal("acme"))
+			Expect(reqctx.GetTenant(reqctx.SetTenant(nilCtx, "acme"))).To(Equal("acme"))
+		})
+
+	})
+
 })
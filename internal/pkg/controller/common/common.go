@@ -0,0 +1,71 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/go-logr/logr"
+)
+
+type Validator interface {
+	IsValid() bool
+}
+
+func GetPathParamOrStop(w http.ResponseWriter, r *http.Request, key string) (id int, stop bool) {
+	ctx := r.Context()
+	value, err := reqctx.GetPathParam(ctx, key)
+	if err == nil {
+		id, err = value.Int()
+		if err == nil {
+			return id, false
+		}
+	}
+
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Error(err, "Cannot retrieve field from context", constants.Field, key)
+
+	_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError,
+		fmt.Sprintf("Unable to retrieve the %v", key)))
+	return 0, true
+}
+
+func GetRequestOrStop[T any](w http.ResponseWriter, r *http.Request, failed string) (request T, stop bool) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(err, failed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return request, true
+	}
+
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		logger.Error(err, failed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return request, true
+	}
+
+	return request, false
+}
+
+func GetValidRequestOrStop[T Validator](w http.ResponseWriter, r *http.Request, failed string) (request T, stop bool) {
+	if request, stop = GetRequestOrStop[T](w, r, failed); stop {
+		return request, true
+	}
+
+	if !request.IsValid() {
+		logr.FromContextOrDiscard(r.Context()).Error(errors.ErrInvalidArgument, failed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return request, true
+	}
+
+	return request, false
+}
@@ -0,0 +1,88 @@
+package common_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+)
+
+type request struct {
+	Name string `json:"name"`
+}
+
+func (request request) IsValid() bool {
+	return request.Name != ""
+}
+
+var _ = Describe("Common", func() {
+
+	var recorder *httptest.ResponseRecorder
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+	})
+
+	Describe("GetPathParamOrStop", func() {
+		It("returns the integer path parameter", func() {
+			r := httptest.NewRequest(http.MethodGet, "http://url", nil)
+			r = r.WithContext(reqctx.SetPathParam(r.Context(), constants.Id, "3"))
+
+			id, stop := common.GetPathParamOrStop(recorder, r, constants.Id)
+
+			Expect(stop).To(BeFalse())
+			Expect(id).To(Equal(3))
+		})
+
+		DescribeTable("responds with status InternalServerError",
+			func(value string) {
+				r := httptest.NewRequest(http.MethodGet, "http://url", nil)
+				if value != "" {
+					r = r.WithContext(reqctx.SetPathParam(r.Context(), constants.Id, value))
+				}
+
+				_, stop := common.GetPathParamOrStop(recorder, r, constants.Id)
+
+				Expect(stop).To(BeTrue())
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			},
+			Entry("without path parameter", ""),
+			Entry("with a non-integer path parameter", "abc"),
+		)
+	})
+
+	Describe("GetRequestOrStop", func() {
+		It("decodes the body", func() {
+			r := httptest.NewRequest(http.MethodPost, "http://url", strings.NewReader(`{"name": "task"}`))
+
+			Expect(common.GetRequestOrStop[request](recorder, r, "failed")).To(Equal(request{Name: "task"}))
+		})
+
+		It("responds with status BadRequest for a malformed body", func() {
+			r := httptest.NewRequest(http.MethodPost, "http://url", strings.NewReader(`{"name":`))
+
+			_, stop := common.GetRequestOrStop[request](recorder, r, "failed")
+
+			Expect(stop).To(BeTrue())
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("GetValidRequestOrStop", func() {
+		It("responds with status BadRequest for an invalid request", func() {
+			r := httptest.NewRequest(http.MethodPost, "http://url", strings.NewReader(`{}`))
+
+			_, stop := common.GetValidRequestOrStop[request](recorder, r, "failed")
+
+			Expect(stop).To(BeTrue())
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+})
@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+type Dependency struct {
+	BlockerId int       `json:"blockerId" gorm:"column:blocker_id;type:int;primaryKey"`
+	BlockedId int       `json:"blockedId" gorm:"column:blocked_id;type:int;primaryKey;index"`
+	CreatedAt time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+}
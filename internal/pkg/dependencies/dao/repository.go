@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]entity.Dependency, error)
+	Insert(ctx context.Context, dependency entity.Dependency) (entity.Dependency, error)
+	Remove(ctx context.Context, blockerId int, blockedId int) error
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type link struct {
+	blockerId int
+	blockedId int
+}
+
+type partition struct {
+	dependencies map[link]entity.Dependency
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithDependencies(dependencies ...entity.Dependency) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			for _, dependency := range dependencies {
+				partition.dependencies[link{dependency.BlockerId, dependency.BlockedId}] = dependency
+			}
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		dependencies: map[link]entity.Dependency{},
+	}
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Dependency, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var dependencies []entity.Dependency
+	for _, dependency := range partition.dependencies {
+		dependencies = append(dependencies, dependency)
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].BlockedId != dependencies[j].BlockedId {
+			return dependencies[i].BlockedId < dependencies[j].BlockedId
+		}
+		return dependencies[i].BlockerId < dependencies[j].BlockerId
+	})
+
+	return dependencies, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, dependency entity.Dependency) (entity.Dependency, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	key := link{dependency.BlockerId, dependency.BlockedId}
+	if _, found := partition.dependencies[key]; found {
+		return entity.Dependency{}, errors.ErrNotModified
+	}
+
+	dependency.CreatedAt = time.Now()
+	partition.dependencies[key] = dependency
+	return dependency, nil
+}
+
+func (repo *memoryRepository) Remove(ctx context.Context, blockerId int, blockedId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	key := link{blockerId, blockedId}
+	if _, found := partition.dependencies[key]; !found {
+		return errors.ErrNotFound
+	}
+	delete(partition.dependencies, key)
+	return nil
+}
+
+func (repo *memoryRepository) RemoveTask(ctx context.Context, taskId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for key := range partition.dependencies {
+		if key.blockerId == taskId || key.blockedId == taskId {
+			delete(partition.dependencies, key)
+		}
+	}
+	return nil
+}
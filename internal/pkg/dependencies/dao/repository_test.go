@@ -0,0 +1,67 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var repo repository.Repository
+
+	BeforeEach(func() {
+		repo = repository.New(repository.WithDependencies(
+			entity.Dependency{BlockerId: 1, BlockedId: 2},
+			entity.Dependency{BlockerId: 2, BlockedId: 3},
+		))
+	})
+
+	Describe("Insert", func() {
+		It("adds a new dependency", func() {
+			Expect(repo.Insert(ctx, entity.Dependency{BlockerId: 1, BlockedId: 3})).To(HaveField("CreatedAt", Not(BeZero())))
+			Expect(repo.GetAll(ctx)).To(HaveLen(3))
+		})
+
+		It("returns ErrNotModified for an existing dependency", func() {
+			Expect(repo.Insert(ctx, entity.Dependency{BlockerId: 1, BlockedId: 2})).Error().To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("Remove", func() {
+		It("removes an existing dependency", func() {
+			Expect(repo.Remove(ctx, 1, 2)).To(Succeed())
+			Expect(repo.GetAll(ctx)).To(HaveExactElements(HaveField("BlockedId", 3)))
+		})
+
+		It("returns ErrNotFound for an unknown dependency", func() {
+			Expect(repo.Remove(ctx, 2, 1)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveTask", func() {
+		It("removes the dependencies of the task on both ends", func() {
+			Expect(repo.RemoveTask(ctx, 2)).To(Succeed())
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the dependencies of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+
+			Expect(repo.GetAll(acme)).To(BeEmpty())
+			Expect(repo.Remove(acme, 1, 2)).To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(ctx)).To(HaveLen(2))
+		})
+	})
+
+})
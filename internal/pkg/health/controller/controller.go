@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	"github.com/go-logr/logr"
+)
+
+type Controller interface {
+	Liveness(w http.ResponseWriter, r *http.Request)
+	Readiness(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	health health.Health
+}
+
+type ControllerOption func(*controllerImpl)
+
+type statusResponse struct {
+	Status health.Status `json:"status"`
+}
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithHealth(health health.Health) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.health = health
+		}
+	}
+}
+
+func (ctrl *controllerImpl) Liveness(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, "Liveness probe failed", ctrl.health.Liveness(r.Context()))
+}
+
+func (ctrl *controllerImpl) Readiness(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, "Readiness probe failed", ctrl.health.Readiness(r.Context()))
+}
+
+func respond(w http.ResponseWriter, r *http.Request, failed string, report health.Report) {
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		logr.FromContextOrDiscard(r.Context()).Info(failed, constants.Payload, report)
+		status = http.StatusServiceUnavailable
+	}
+
+	w.WriteHeader(status)
+	if urlparams.ParseQueryFlag(r, constants.Verbose) {
+		_ = marshaller.SerializeEntity(w, report)
+		return
+	}
+	_ = marshaller.SerializeEntity(w, statusResponse{Status: report.Status})
+}
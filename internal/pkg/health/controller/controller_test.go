@@ -0,0 +1,116 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health/controller"
+	healthMock "github.com/aeon-fruit/dalil.git/test/mocks/health"
+)
+
+var _ = Describe("Controller", func() {
+
+	var (
+		mockCtrl   *gomock.Controller
+		mockHealth *healthMock.MockHealth
+		ctrl       controller.Controller
+		recorder   *httptest.ResponseRecorder
+	)
+
+	report := func(status health.Status) health.Report {
+		return health.Report{
+			Status: status,
+			Uptime: "1m0s",
+			Checks: map[string]health.CheckReport{
+				"repository": {Status: status, Duration: "1ms"},
+			},
+		}
+	}
+
+	decode := func() map[string]any {
+		var body map[string]any
+		Expect(json.NewDecoder(recorder.Body).Decode(&body)).To(Succeed())
+		return body
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockHealth = healthMock.NewMockHealth(mockCtrl)
+		ctrl = controller.New(controller.WithHealth(mockHealth))
+		recorder = httptest.NewRecorder()
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Liveness", func() {
+		When("the process is up", func() {
+			It("responds with status OK and the status only", func() {
+				mockHealth.EXPECT().Liveness(gomock.Any()).Return(report(health.StatusUp))
+
+				ctrl.Liveness(recorder, httptest.NewRequest(http.MethodGet, "/k8s/liveness", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(decode()).To(Equal(map[string]any{"status": "up"}))
+			})
+		})
+
+		When("a liveness check fails", func() {
+			It("responds with status Service Unavailable", func() {
+				mockHealth.EXPECT().Liveness(gomock.Any()).Return(report(health.StatusDown))
+
+				ctrl.Liveness(recorder, httptest.NewRequest(http.MethodGet, "/k8s/liveness", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(decode()).To(Equal(map[string]any{"status": "down"}))
+			})
+		})
+	})
+
+	Describe("Readiness", func() {
+		It("passes the request context to the checks", func() {
+			type key struct{}
+			ctx := context.WithValue(context.Background(), key{}, "value")
+			mockHealth.EXPECT().Readiness(ctx).Return(report(health.StatusUp))
+
+			ctrl.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/k8s/readiness", nil).WithContext(ctx))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		When("a dependency is down", func() {
+			It("responds with status Service Unavailable", func() {
+				mockHealth.EXPECT().Readiness(gomock.Any()).Return(report(health.StatusDown))
+
+				ctrl.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/k8s/readiness", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(decode()).To(Equal(map[string]any{"status": "down"}))
+			})
+		})
+
+		When("the detailed report is requested", func() {
+			It("responds with the detailed report", func() {
+				mockHealth.EXPECT().Readiness(gomock.Any()).Return(report(health.StatusDown))
+
+				ctrl.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/k8s/readiness?verbose=true", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+				var body health.Report
+				Expect(json.NewDecoder(recorder.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(report(health.StatusDown)))
+			})
+		})
+	})
+
+})
@@ -0,0 +1,212 @@
+package health
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+)
+
+const (
+	defaultTimeout = 2 * time.Second
+
+	shuttingDown = "the server is shutting down"
+)
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+type Check func(ctx context.Context) error
+
+type CheckReport struct {
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+type Report struct {
+	Status     Status                 `json:"status"`
+	CheckedAt  time.Time              `json:"checkedAt"`
+	Uptime     string                 `json:"uptime"`
+	Goroutines int                    `json:"goroutines"`
+	Checks     map[string]CheckReport `json:"checks,omitempty"`
+}
+
+type Health interface {
+	AddLivenessCheck(name string, check Check)
+	AddReadinessCheck(name string, check Check)
+	Liveness(ctx context.Context) Report
+	Readiness(ctx context.Context) Report
+	SetReady(ready bool)
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+type healthImpl struct {
+	mutex     sync.RWMutex
+	liveness  []namedCheck
+	readiness []namedCheck
+	ready     bool
+	timeout   time.Duration
+	clock     stubs.Clock
+	startedAt time.Time
+}
+
+type HealthOption func(*healthImpl)
+
+func New(options ...HealthOption) Health {
+	instance := healthImpl{
+		ready:   true,
+		timeout: defaultTimeout,
+		clock:   stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	instance.startedAt = instance.clock.Now()
+
+	return &instance
+}
+
+func WithTimeout(timeout time.Duration) HealthOption {
+	return func(health *healthImpl) {
+		if health != nil && timeout > 0 {
+			health.timeout = timeout
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) HealthOption {
+	return func(health *healthImpl) {
+		if health != nil && clock != nil {
+			health.clock = clock
+		}
+	}
+}
+
+func (health *healthImpl) AddLivenessCheck(name string, check Check) {
+	if check == nil {
+		return
+	}
+
+	health.mutex.Lock()
+	defer health.mutex.Unlock()
+
+	health.liveness = append(health.liveness, namedCheck{name: name, check: check})
+}
+
+func (health *healthImpl) AddReadinessCheck(name string, check Check) {
+	if check == nil {
+		return
+	}
+
+	health.mutex.Lock()
+	defer health.mutex.Unlock()
+
+	health.readiness = append(health.readiness, namedCheck{name: name, check: check})
+}
+
+func (health *healthImpl) Liveness(ctx context.Context) Report {
+	health.mutex.RLock()
+	checks := append([]namedCheck{}, health.liveness...)
+	health.mutex.RUnlock()
+
+	return health.run(ctx, checks)
+}
+
+func (health *healthImpl) Readiness(ctx context.Context) Report {
+	health.mutex.RLock()
+	if !health.ready {
+		health.mutex.RUnlock()
+
+		report := health.run(ctx, nil)
+		report.Status = StatusDown
+		report.Checks = map[string]CheckReport{
+			"server": {Status: StatusDown, Error: shuttingDown, Duration: time.Duration(0).String()},
+		}
+		return report
+	}
+	checks := append([]namedCheck{}, health.liveness...)
+	checks = append(checks, health.readiness...)
+	health.mutex.RUnlock()
+
+	return health.run(ctx, checks)
+}
+
+func (health *healthImpl) SetReady(ready bool) {
+	health.mutex.Lock()
+	defer health.mutex.Unlock()
+
+	health.ready = ready
+}
+
+func (health *healthImpl) run(ctx context.Context, checks []namedCheck) Report {
+	now := health.clock.Now()
+	report := Report{
+		Status:     StatusUp,
+		CheckedAt:  now,
+		Uptime:     now.Sub(health.startedAt).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+	}
+	if len(checks) == 0 {
+		return report
+	}
+
+	results := make([]CheckReport, len(checks))
+	var wg sync.WaitGroup
+	for i, named := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = health.runCheck(ctx, check)
+		}(i, named.check)
+	}
+	wg.Wait()
+
+	report.Checks = map[string]CheckReport{}
+	for i, named := range checks {
+		report.Checks[named.name] = results[i]
+		if results[i].Status == StatusDown {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+func (health *healthImpl) runCheck(ctx context.Context, check Check) CheckReport {
+	ctx, cancel := context.WithTimeout(ctx, health.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckReport{Status: StatusUp, Duration: time.Since(start).String()}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package health_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+)
+
+var _ = Describe("Health", func() {
+
+	var (
+		ctx   context.Context
+		clock *stubs.FixedClock
+	)
+
+	succeeding := func(context.Context) error {
+		return nil
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clock = stubs.NewFixed(time.Date(2023, time.March, 17, 9, 30, 0, 0, time.UTC))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(health.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Liveness", func() {
+		It("is up with the uptime of the process when there are no checks", func() {
+			instance := health.New(health.WithClock(clock))
+			clock.Set(clock.Now().Add(90 * time.Second))
+
+			report := instance.Liveness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusUp))
+			Expect(report.CheckedAt).To(Equal(clock.Now()))
+			Expect(report.Uptime).To(Equal("1m30s"))
+			Expect(report.Goroutines).To(BeNumerically(">", 0))
+			Expect(report.Checks).To(BeEmpty())
+		})
+
+		It("ignores the readiness checks", func() {
+			instance := health.New()
+			instance.AddLivenessCheck("loop", succeeding)
+			instance.AddReadinessCheck("repository", func(context.Context) error {
+				return fmt.Errorf("unavailable")
+			})
+
+			report := instance.Liveness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusUp))
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks).To(HaveKeyWithValue("loop", HaveField("Status", health.StatusUp)))
+		})
+
+		It("is down when a liveness check fails", func() {
+			instance := health.New()
+			instance.AddLivenessCheck("loop", func(context.Context) error {
+				return fmt.Errorf("stuck")
+			})
+
+			report := instance.Liveness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusDown))
+			Expect(report.Checks["loop"].Status).To(Equal(health.StatusDown))
+			Expect(report.Checks["loop"].Error).To(Equal("stuck"))
+		})
+	})
+
+	Describe("Readiness", func() {
+		It("is up when all the checks succeed", func() {
+			instance := health.New()
+			instance.AddLivenessCheck("loop", succeeding)
+			instance.AddReadinessCheck("repository", succeeding)
+
+			report := instance.Readiness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusUp))
+			Expect(report.Checks).To(HaveLen(2))
+			Expect(report.Checks["loop"].Status).To(Equal(health.StatusUp))
+			Expect(report.Checks["repository"].Status).To(Equal(health.StatusUp))
+			Expect(report.Checks["repository"].Duration).NotTo(BeEmpty())
+		})
+
+		It("is down when a check fails and reports the other checks", func() {
+			instance := health.New()
+			instance.AddReadinessCheck("repository", succeeding)
+			instance.AddReadinessCheck("scheduler", func(context.Context) error {
+				return fmt.Errorf("custom error")
+			})
+
+			report := instance.Readiness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusDown))
+			Expect(report.Checks["repository"].Status).To(Equal(health.StatusUp))
+			Expect(report.Checks["scheduler"]).To(Equal(health.CheckReport{
+				Status:   health.StatusDown,
+				Error:    "custom error",
+				Duration: report.Checks["scheduler"].Duration,
+			}))
+		})
+
+		It("is down when a check exceeds the timeout", func() {
+			release := make(chan struct{})
+			DeferCleanup(func() {
+				close(release)
+			})
+
+			instance := health.New(health.WithTimeout(10 * time.Millisecond))
+			instance.AddReadinessCheck("repository", func(context.Context) error {
+				<-release
+				return nil
+			})
+
+			report := instance.Readiness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusDown))
+			Expect(report.Checks["repository"].Error).To(Equal(context.DeadlineExceeded.Error()))
+		})
+
+		It("passes a context bound by the timeout to the checks", func() {
+			instance := health.New(health.WithTimeout(time.Minute))
+			instance.AddReadinessCheck("repository", func(ctx context.Context) error {
+				if _, found := ctx.Deadline(); !found {
+					return fmt.Errorf("no deadline")
+				}
+				return nil
+			})
+
+			Expect(instance.Readiness(ctx).Status).To(Equal(health.StatusUp))
+		})
+
+		It("is down without running the checks while the server is shutting down", func() {
+			instance := health.New()
+			instance.AddReadinessCheck("repository", func(context.Context) error {
+				Fail("the check should not run")
+				return nil
+			})
+			instance.SetReady(false)
+
+			report := instance.Readiness(ctx)
+
+			Expect(report.Status).To(Equal(health.StatusDown))
+			Expect(report.Checks).To(HaveLen(1))
+			Expect(report.Checks["server"].Error).To(Equal("the server is shutting down"))
+		})
+
+		It("runs the checks again once the server is ready", func() {
+			instance := health.New()
+			instance.AddReadinessCheck("repository", succeeding)
+			instance.SetReady(false)
+			instance.SetReady(true)
+
+			Expect(instance.Readiness(ctx).Status).To(Equal(health.StatusUp))
+		})
+
+		It("ignores nil checks", func() {
+			instance := health.New()
+			instance.AddReadinessCheck("repository", nil)
+
+			Expect(instance.Readiness(ctx).Checks).To(BeEmpty())
+		})
+	})
+
+})
@@ -0,0 +1,161 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		listsCtrl   controller.Controller
+	)
+
+	withListId := func(request *http.Request, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), constants.ListId, value))
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		listsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+
+		When("the list id is not found", func() {
+			It("responds with status InternalServerError and an error response payload", func() {
+				listsCtrl.GetById(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+
+				var payload errorModel.Response
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the caller may not view the list", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(model.GetListResponse{}, errors.ErrForbidden)
+
+				listsCtrl.GetById(recorder, withListId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the list is found", func() {
+			It("responds with status OK and the list in the payload", func() {
+				list := model.GetListResponse{Id: 1, Name: "Work", TaskCount: 2}
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(list, nil)
+
+				listsCtrl.GetById(recorder, withListId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetListResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(list))
+			})
+		})
+
+	})
+
+	Describe("GetAll", func() {
+		It("responds with status InternalServerError when the service fails", func() {
+			mockService.EXPECT().GetAll(gomock.Any()).Return(nil, fmt.Errorf("custom error"))
+
+			listsCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("Add", func() {
+		It("responds with status BadRequest when the name is missing", func() {
+			listsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"description":"Work stuff"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status TooManyRequests when the tenant quota is exhausted", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), model.UpsertListRequest{Name: "Work"}).Return(model.GetListResponse{}, errors.ErrQuotaExceeded)
+
+			listsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"Work"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("responds with status Created and the list in the payload", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), model.UpsertListRequest{Name: "Work"}).Return(model.GetListResponse{Id: 1, Name: "Work"}, nil)
+
+			listsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"Work"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/1"))
+		})
+	})
+
+	Describe("Update", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetListResponse{}, err)
+
+				listsCtrl.Update(recorder, withListId(httptest.NewRequest("", url, strings.NewReader(`{"id":1,"name":"Job"}`)), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("not modified", errors.ErrNotModified, http.StatusNotModified),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("RemoveById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(err)
+
+				listsCtrl.RemoveById(recorder, withListId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("removed", nil, http.StatusNoContent),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("default list", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("not empty", errors.ErrConflict, http.StatusConflict),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+		)
+	})
+
+})
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/metrics"
+)
+
+const repositoryName = "lists"
+
+type instrumentedRepository struct {
+	repository Repository
+	observer   metrics.RepositoryObserver
+}
+
+func Instrument(repository Repository, observer metrics.RepositoryObserver) Repository {
+	if observer == nil {
+		return repository
+	}
+
+	return &instrumentedRepository{
+		repository: repository,
+		observer:   observer,
+	}
+}
+
+func (repo *instrumentedRepository) GetById(ctx context.Context, id int) (entity.List, error) {
+	start := time.Now()
+	result, err := repo.repository.GetById(ctx, id)
+	repo.observer.ObserveRepository(repositoryName, "GetById", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) GetAll(ctx context.Context) ([]entity.List, error) {
+	start := time.Now()
+	result, err := repo.repository.GetAll(ctx)
+	repo.observer.ObserveRepository(repositoryName, "GetAll", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Insert(ctx context.Context, list entity.List) (entity.List, error) {
+	start := time.Now()
+	result, err := repo.repository.Insert(ctx, list)
+	repo.observer.ObserveRepository(repositoryName, "Insert", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Update(ctx context.Context, list entity.List) (entity.List, error) {
+	start := time.Now()
+	result, err := repo.repository.Update(ctx, list)
+	repo.observer.ObserveRepository(repositoryName, "Update", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) RemoveById(ctx context.Context, id int) (entity.List, error) {
+	start := time.Now()
+	result, err := repo.repository.RemoveById(ctx, id)
+	repo.observer.ObserveRepository(repositoryName, "RemoveById", time.Since(start), err)
+	return result, err
+}
@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	metricsMock "github.com/aeon-fruit/dalil.git/test/mocks/metrics"
+)
+
+var _ = Describe("Instrumented repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl     *gomock.Controller
+		mockObserver *metricsMock.MockRepositoryObserver
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockObserver = metricsMock.NewMockRepositoryObserver(mockCtrl)
+	})
+
+	Describe("Instrument", func() {
+		It("returns the repository as is without observer", func() {
+			repo := repository.New()
+
+			Expect(repository.Instrument(repo, nil)).To(BeIdenticalTo(repo))
+		})
+
+		It("observes the operations and their errors", func() {
+			repo := repository.Instrument(repository.New(), mockObserver)
+			gomock.InOrder(
+				mockObserver.EXPECT().ObserveRepository("lists", "Insert", gomock.Any(), nil),
+				mockObserver.EXPECT().ObserveRepository("lists", "GetById", gomock.Any(), nil),
+				mockObserver.EXPECT().ObserveRepository("lists", "GetById", gomock.Any(), errors.ErrNotFound),
+			)
+
+			inserted, err := repo.Insert(ctx, entity.List{Name: "Work"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetById(ctx, inserted.Id+1)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+})
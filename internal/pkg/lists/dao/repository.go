@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.List, error)
+	GetAll(ctx context.Context) ([]entity.List, error)
+	Insert(ctx context.Context, list entity.List) (entity.List, error)
+	Update(ctx context.Context, list entity.List) (entity.List, error)
+	RemoveById(ctx context.Context, id int) (entity.List, error)
+}
+
+type partition struct {
+	lists map[int]entity.List
+	seq   int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+	quotas     tenancy.Quotas
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithLists(lists map[int]entity.List) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && lists != nil {
+			repository.partitions.Of(tenancy.DefaultTenant).lists = lists
+		}
+	}
+}
+
+func WithQuotas(quotas tenancy.Quotas) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.quotas = quotas
+		}
+	}
+}
+
+func newPartition() *partition {
+	now := time.Now()
+	return &partition{
+		lists: map[int]entity.List{
+			entity.DefaultListId: {
+				Id:        entity.DefaultListId,
+				Name:      entity.DefaultListName,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		seq: entity.DefaultListId + 1,
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.List, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	list, found := partition.lists[id]
+	if !found {
+		return entity.List{}, errors.ErrNotFound
+	}
+	return list, nil
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.List, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var ids []int
+	var lists []entity.List
+	for _, list := range partition.lists {
+		ids = append(ids, list.Id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		lists = append(lists, partition.lists[id])
+	}
+
+	return lists, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, list entity.List) (entity.List, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+	if repo.quotas != nil {
+		if err := repo.quotas.Check(ctx, tenancy.ResourceLists, len(partition.lists)); err != nil {
+			return entity.List{}, err
+		}
+	}
+
+	list.Id, partition.seq = partition.seq, partition.seq+1
+	list.UpdatedAt = time.Now()
+	list.CreatedAt = list.UpdatedAt
+	partition.lists[list.Id] = list
+	return list, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, list entity.List) (entity.List, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldList, found := partition.lists[list.Id]
+	if !found {
+		return entity.List{}, errors.ErrNotFound
+	}
+
+	if oldList.Name == list.Name &&
+		oldList.Description == list.Description {
+		return entity.List{}, errors.ErrNotModified
+	}
+
+	list.UpdatedAt = time.Now()
+	list.CreatedAt = oldList.CreatedAt
+	partition.lists[list.Id] = list
+	return list, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.List, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	list, found := partition.lists[id]
+	if !found {
+		return entity.List{}, errors.ErrNotFound
+	}
+	delete(partition.lists, id)
+	return list, nil
+}
@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var repo repository.Repository
+
+	BeforeEach(func() {
+		repo = repository.New()
+	})
+
+	Describe("New", func() {
+		It("contains the default list", func() {
+			Expect(repo.GetById(ctx, entity.DefaultListId)).To(HaveField("Name", entity.DefaultListName))
+		})
+	})
+
+	Describe("Insert", func() {
+		It("assigns ids after the default list", func() {
+			Expect(repo.Insert(ctx, entity.List{Name: "Work"})).To(HaveField("Id", entity.DefaultListId+1))
+			Expect(repo.GetAll(ctx)).To(HaveLen(2))
+		})
+	})
+
+	Describe("Update", func() {
+		It("returns the updated list", func() {
+			list, _ := repo.Insert(ctx, entity.List{Name: "Work"})
+
+			Expect(repo.Update(ctx, entity.List{Id: list.Id, Name: "Job"})).To(HaveField("Name", "Job"))
+		})
+
+		It("returns ErrNotModified when nothing changes", func() {
+			list, _ := repo.Insert(ctx, entity.List{Name: "Work"})
+
+			Expect(repo.Update(ctx, list)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrNotFound for an unknown list", func() {
+			Expect(repo.Update(ctx, entity.List{Id: 42, Name: "Job"})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the list", func() {
+			list, _ := repo.Insert(ctx, entity.List{Name: "Work"})
+
+			Expect(repo.RemoveById(ctx, list.Id)).Error().NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, list.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("isolates the lists and ids of each tenant", func() {
+			Expect(repo.Insert(ctx, entity.List{Name: "Work"})).To(HaveField("Id", entity.DefaultListId+1))
+
+			Expect(repo.GetById(acme, entity.DefaultListId)).To(HaveField("Name", entity.DefaultListName))
+			Expect(repo.GetById(acme, entity.DefaultListId+1)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.Insert(acme, entity.List{Name: "Ops"})).To(HaveField("Id", entity.DefaultListId+1))
+			Expect(repo.GetAll(acme)).To(HaveExactElements(HaveField("Name", entity.DefaultListName), HaveField("Name", "Ops")))
+			Expect(repo.GetById(ctx, entity.DefaultListId+1)).To(HaveField("Name", "Work"))
+		})
+
+		It("enforces the quota of the tenant", func() {
+			repo = repository.New(repository.WithQuotas(tenancy.NewQuotas(
+				tenancy.WithOverrides(map[string]tenancy.Limits{"acme": {tenancy.ResourceLists: 2}}),
+			)))
+
+			Expect(repo.Insert(acme, entity.List{Name: "Ops"})).Error().NotTo(HaveOccurred())
+			Expect(repo.Insert(acme, entity.List{Name: "More"})).Error().To(Equal(errors.ErrQuotaExceeded))
+			Expect(repo.Insert(ctx, entity.List{Name: "Work"})).Error().NotTo(HaveOccurred())
+		})
+	})
+
+})
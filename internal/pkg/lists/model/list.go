@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+)
+
+type GetListResponse struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	TaskCount   int       `json:"taskCount"`
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
+}
+
+func EntityToGetListResponse(entity entity.List, taskCount int) GetListResponse {
+	return GetListResponse{
+		Id:          entity.Id,
+		Name:        entity.Name,
+		Description: entity.Description,
+		TaskCount:   taskCount,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+}
+
+type UpsertListRequest struct {
+	Id          *int   `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func (dto UpsertListRequest) IsValid(id *int) bool {
+	return dto.Name != "" &&
+		((id == nil && dto.Id == nil) ||
+			(id != nil && dto.Id != nil && *id == *dto.Id))
+}
+
+func (dto UpsertListRequest) ToEntity() entity.List {
+	var id int
+	if dto.Id != nil {
+		id = *dto.Id
+	}
+
+	return entity.List{
+		Id:          id,
+		Name:        dto.Name,
+		Description: dto.Description,
+	}
+}
@@ -0,0 +1,57 @@
+package model_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+)
+
+var _ = Describe("Model", func() {
+
+	const id = 10
+
+	Describe("EntityToGetListResponse", func() {
+		It("returns a ListResponse that contains the entity's values and the task count", func() {
+			now := time.Now()
+			e := entity.List{Id: id, Name: "Work", Description: "Work stuff", CreatedAt: now.Add(-time.Hour), UpdatedAt: now}
+
+			Expect(model.EntityToGetListResponse(e, 4)).To(Equal(model.GetListResponse{
+				Id:          id,
+				Name:        "Work",
+				Description: "Work stuff",
+				TaskCount:   4,
+				CreatedAt:   e.CreatedAt,
+				UpdatedAt:   e.UpdatedAt,
+			}))
+		})
+	})
+
+	Describe("UpsertListRequest", func() {
+
+		listId := func(id int) *int {
+			return &id
+		}
+
+		DescribeTable("IsValid",
+			func(request model.UpsertListRequest, id *int, expected bool) {
+				Expect(request.IsValid(id)).To(Equal(expected))
+			},
+			Entry("a new list", model.UpsertListRequest{Name: "Work"}, nil, true),
+			Entry("a new list without name", model.UpsertListRequest{Description: "Work stuff"}, nil, false),
+			Entry("a new list with an id", model.UpsertListRequest{Id: listId(id), Name: "Work"}, nil, false),
+			Entry("an existing list", model.UpsertListRequest{Id: listId(id), Name: "Work"}, listId(id), true),
+			Entry("an existing list with another id", model.UpsertListRequest{Id: listId(id + 1), Name: "Work"}, listId(id), false),
+		)
+
+		It("converts to an entity", func() {
+			Expect(model.UpsertListRequest{Id: listId(id), Name: "Work", Description: "Work stuff"}.ToEntity()).
+				To(Equal(entity.List{Id: id, Name: "Work", Description: "Work stuff"}))
+		})
+
+	})
+
+})
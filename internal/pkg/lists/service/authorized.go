@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetById(ctx context.Context, id int) (model.GetListResponse, error) {
+	if err := authorized.policy.AuthorizeList(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return model.GetListResponse{}, err
+	}
+	return authorized.service.GetById(ctx, id)
+}
+
+func (authorized *authorizedService) GetAll(ctx context.Context) ([]model.GetListResponse, error) {
+	entities, err := authorized.service.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetListResponse
+	for _, list := range entities {
+		if lists.Contains(list.Id) {
+			dto = append(dto, list)
+		}
+	}
+	return dto, nil
+}
+
+func (authorized *authorizedService) Upsert(ctx context.Context, request model.UpsertListRequest) (model.GetListResponse, error) {
+	if request.Id != nil {
+		if err := authorized.policy.AuthorizeList(ctx, *request.Id, rolesEntity.RoleEditor); err != nil {
+			return model.GetListResponse{}, err
+		}
+		return authorized.service.Upsert(ctx, request)
+	}
+
+	list, err := authorized.service.Upsert(ctx, request)
+	if err != nil {
+		return model.GetListResponse{}, err
+	}
+	if err = authorized.policy.Own(ctx, list.Id); err != nil {
+		return model.GetListResponse{}, err
+	}
+	return list, nil
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, id int) error {
+	if err := authorized.policy.AuthorizeList(ctx, id, rolesEntity.RoleOwner); err != nil {
+		return err
+	}
+	if err := authorized.service.RemoveById(ctx, id); err != nil {
+		return err
+	}
+	return authorized.policy.Forget(ctx, id)
+}
@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get a list", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetById(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("keeps the readable lists only", func() {
+		userId := 2
+		userCtx := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &userId})
+		grants := rolesDAO.New(rolesDAO.WithGrants(rolesEntity.Grant{ListId: 1, UserId: 1, Role: rolesEntity.RoleOwner}))
+		authorizedSvc = service.Authorize(mockService, policy.New(policy.WithGrants(grants)))
+		mockService.EXPECT().GetAll(userCtx).Return([]model.GetListResponse{{Id: 0}, {Id: 1}}, nil)
+
+		Expect(authorizedSvc.GetAll(userCtx)).To(HaveExactElements(HaveField("Id", 0)))
+	})
+
+	It("makes the creator the owner of a new list", func() {
+		request := model.UpsertListRequest{Name: "list"}
+		mockService.EXPECT().Upsert(ctx, request).Return(model.GetListResponse{Id: 3}, nil)
+		mockPolicy.EXPECT().Own(ctx, 3).Return(nil)
+
+		Expect(authorizedSvc.Upsert(ctx, request)).To(HaveField("Id", 3))
+	})
+
+	It("requires the editor role to update a list", func() {
+		id := 1
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Upsert(ctx, model.UpsertListRequest{Id: &id, Name: "list"})).Error().
+			To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the owner role to remove a list and forgets its grants", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleOwner).Return(nil)
+		mockService.EXPECT().RemoveById(ctx, 1).Return(nil)
+		mockPolicy.EXPECT().Forget(gomock.Any(), 1).Return(nil)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1)).To(Succeed())
+	})
+
+	It("keeps the grants when the removal fails", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleOwner).Return(nil)
+		mockService.EXPECT().RemoveById(ctx, 1).Return(errors.ErrConflict)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1)).To(Equal(errors.ErrConflict))
+	})
+
+})
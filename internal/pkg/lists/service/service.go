@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetListResponse, error)
+	GetAll(ctx context.Context) ([]model.GetListResponse, error)
+	Upsert(ctx context.Context, request model.UpsertListRequest) (model.GetListResponse, error)
+	RemoveById(ctx context.Context, id int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasksDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetListResponse, error) {
+	list, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetListResponse{}, err
+	}
+
+	counts, err := service.taskCounts(ctx)
+	if err != nil {
+		return model.GetListResponse{}, err
+	}
+	return model.EntityToGetListResponse(list, counts[list.Id]), nil
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context) ([]model.GetListResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := service.taskCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetListResponse
+	for _, list := range entities {
+		dto = append(dto, model.EntityToGetListResponse(list, counts[list.Id]))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Upsert(ctx context.Context, request model.UpsertListRequest) (model.GetListResponse, error) {
+	list := request.ToEntity()
+
+	var err error
+	if request.Id == nil {
+		list, err = service.repository.Insert(ctx, list)
+	} else {
+		list, err = service.repository.Update(ctx, list)
+	}
+
+	if err != nil {
+		return model.GetListResponse{}, err
+	}
+
+	counts, err := service.taskCounts(ctx)
+	if err != nil {
+		return model.GetListResponse{}, err
+	}
+	return model.EntityToGetListResponse(list, counts[list.Id]), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	if id == entity.DefaultListId {
+		return errors.ErrInvalidArgument
+	}
+
+	if _, err := service.repository.GetById(ctx, id); err != nil {
+		return err
+	}
+
+	counts, err := service.taskCounts(ctx)
+	if err != nil {
+		return err
+	}
+	if counts[id] > 0 {
+		return errors.ErrConflict
+	}
+
+	_, err = service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) taskCounts(ctx context.Context) (map[int]int, error) {
+	counts := map[int]int{}
+	if service.tasks == nil {
+		return counts, nil
+	}
+
+	tasks, err := service.tasks.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		counts[task.ListId]++
+	}
+	return counts, nil
+}
@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/lists/service"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/dao"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const id = 1
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockTasks      *tasksDaoMock.MockRepository
+		listsSvc       service.Service
+		work           entity.List
+		tasks          []tasksEntity.Task
+	)
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		listsSvc = service.New(service.WithRepository(mockRepository), service.WithTasks(mockTasks))
+
+		work = entity.List{Id: id, Name: "Work"}
+		tasks = []tasksEntity.Task{{Id: 0}, {Id: 1, ListId: id}, {Id: 2, ListId: id}}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.List{}, customErr)
+
+			Expect(listsSvc.GetById(ctx, id)).Error().To(Equal(customErr))
+		})
+
+		It("returns the list with its task count", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(work, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return(tasks, nil)
+
+			Expect(listsSvc.GetById(ctx, id)).To(Equal(model.EntityToGetListResponse(work, 2)))
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns the lists with their task counts", func() {
+			inbox := entity.List{Id: entity.DefaultListId, Name: entity.DefaultListName}
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.List{inbox, work}, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return(tasks, nil)
+
+			Expect(listsSvc.GetAll(ctx)).To(Equal([]model.GetListResponse{
+				model.EntityToGetListResponse(inbox, 1),
+				model.EntityToGetListResponse(work, 2),
+			}))
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("inserts a new list", func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.List{Name: "Work"}).Return(work, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+
+			Expect(listsSvc.Upsert(ctx, model.UpsertListRequest{Name: "Work"})).To(HaveField("Id", id))
+		})
+
+		It("updates an existing list", func() {
+			listId := id
+			mockRepository.EXPECT().Update(gomock.Any(), entity.List{Id: id, Name: "Job"}).Return(entity.List{}, errors.ErrNotModified)
+
+			Expect(listsSvc.Upsert(ctx, model.UpsertListRequest{Id: &listId, Name: "Job"})).Error().To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("refuses to remove the default list", func() {
+			Expect(listsSvc.RemoveById(ctx, entity.DefaultListId)).To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("returns ErrNotFound for an unknown list", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.List{}, errors.ErrNotFound)
+
+			Expect(listsSvc.RemoveById(ctx, id)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("refuses to remove a list that contains tasks", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(work, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return(tasks, nil)
+
+			Expect(listsSvc.RemoveById(ctx, id)).To(Equal(errors.ErrConflict))
+		})
+
+		It("removes an empty list", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(work, nil)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return(tasks[:1], nil)
+			mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(work, nil)
+
+			Expect(listsSvc.RemoveById(ctx, id)).To(Succeed())
+		})
+	})
+
+})
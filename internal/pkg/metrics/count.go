@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type countCollector struct {
+	desc  *prometheus.Desc
+	count func() (map[string]int, error)
+}
+
+func NewCountCollector(name string, help string, label string, count func() (map[string]int, error)) prometheus.Collector {
+	return &countCollector{
+		desc:  prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, []string{label}, nil),
+		count: count,
+	}
+}
+
+func (collector *countCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- collector.desc
+}
+
+func (collector *countCollector) Collect(metrics chan<- prometheus.Metric) {
+	counts, err := collector.count()
+	if err != nil {
+		metrics <- prometheus.NewInvalidMetric(collector.desc, err)
+		return
+	}
+
+	for value, count := range counts {
+		metrics <- prometheus.MustNewConstMetric(collector.desc, prometheus.GaugeValue, float64(count), value)
+	}
+}
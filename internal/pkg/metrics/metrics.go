@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	chiMiddleware "github.com/go-chi/chi/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace      = "dalil"
+	unmatchedRoute = "unmatched"
+)
+
+var errorKinds = map[error]string{
+	errors.ErrNotFound:        "not_found",
+	errors.ErrNotModified:     "not_modified",
+	errors.ErrInvalidArgument: "invalid_argument",
+	errors.ErrConflict:        "conflict",
+	errors.ErrForbidden:       "forbidden",
+	errors.ErrTooLarge:        "too_large",
+	errors.ErrQuotaExceeded:   "quota_exceeded",
+}
+
+type RepositoryObserver interface {
+	ObserveRepository(repository string, operation string, duration time.Duration, err error)
+}
+
+type Metrics interface {
+	RepositoryObserver
+	Handler() http.Handler
+	Middleware(next http.Handler) http.Handler
+	MustRegister(collectors ...prometheus.Collector)
+}
+
+type metricsImpl struct {
+	registry           *prometheus.Registry
+	requests           *prometheus.CounterVec
+	requestDurations   *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	repositoryErrors   *prometheus.CounterVec
+}
+
+type MetricsOption func(*metricsImpl)
+
+func New(options ...MetricsOption) Metrics {
+	instance := metricsImpl{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		requestDurations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the HTTP requests by method, route pattern and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_operation_duration_seconds",
+			Help:      "Latency of the repository operations.",
+			Buckets:   []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		}, []string{"repository", "operation"}),
+		repositoryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "repository_errors_total",
+			Help:      "Number of failed repository operations by kind of error.",
+		}, []string{"repository", "operation", "kind"}),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	instance.registry.MustRegister(
+		instance.requests,
+		instance.requestDurations,
+		instance.repositoryDuration,
+		instance.repositoryErrors,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return &instance
+}
+
+func WithRegistry(registry *prometheus.Registry) MetricsOption {
+	return func(metrics *metricsImpl) {
+		if metrics != nil && registry != nil {
+			metrics.registry = registry
+		}
+	}
+}
+
+func (metrics *metricsImpl) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{Registry: metrics.registry})
+}
+
+func (metrics *metricsImpl) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			route := unmatchedRoute
+			if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+				route = routeCtx.RoutePattern()
+			}
+
+			labels := prometheus.Labels{"method": r.Method, "route": route, "status": strconv.Itoa(status)}
+			metrics.requests.With(labels).Inc()
+			metrics.requestDurations.With(labels).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(ww, r)
+	})
+}
+
+func (metrics *metricsImpl) ObserveRepository(repository string, operation string, duration time.Duration, err error) {
+	metrics.repositoryDuration.WithLabelValues(repository, operation).Observe(duration.Seconds())
+	if err != nil {
+		kind, found := errorKinds[err]
+		if !found {
+			kind = "internal"
+		}
+		metrics.repositoryErrors.WithLabelValues(repository, operation, kind).Inc()
+	}
+}
+
+func (metrics *metricsImpl) MustRegister(collectors ...prometheus.Collector) {
+	metrics.registry.MustRegister(collectors...)
+}
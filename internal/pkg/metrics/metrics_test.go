@@ -0,0 +1,134 @@
+package metrics_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+
+	var instance metrics.Metrics
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		instance.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		content, err := io.ReadAll(recorder.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	BeforeEach(func() {
+		instance = metrics.New()
+	})
+
+	Describe("Handler", func() {
+		It("exposes the Go runtime and process metrics", func() {
+			content := scrape()
+
+			Expect(content).To(ContainSubstring("go_goroutines "))
+			Expect(content).To(ContainSubstring("go_memstats_alloc_bytes "))
+		})
+	})
+
+	Describe("Middleware", func() {
+		var router chi.Router
+
+		BeforeEach(func() {
+			router = chi.NewRouter()
+			router.Use(instance.Middleware)
+			router.Get("/tasks/{id}", func(w http.ResponseWriter, r *http.Request) {
+				if chi.URLParam(r, "id") == "0" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte("{}"))
+			})
+		})
+
+		serve := func(target string) {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+		}
+
+		It("counts the requests by method, route pattern and status code", func() {
+			serve("/tasks/1")
+			serve("/tasks/2")
+			serve("/tasks/0")
+
+			content := scrape()
+
+			Expect(content).To(ContainSubstring(`dalil_http_requests_total{method="GET",route="/tasks/{id}",status="200"} 2`))
+			Expect(content).To(ContainSubstring(`dalil_http_requests_total{method="GET",route="/tasks/{id}",status="404"} 1`))
+			Expect(content).To(ContainSubstring(`dalil_http_request_duration_seconds_count{method="GET",route="/tasks/{id}",status="200"} 2`))
+		})
+
+		It("groups the requests matching no route", func() {
+			serve("/unknown/1")
+			serve("/unknown/2")
+
+			Expect(scrape()).To(ContainSubstring(`dalil_http_requests_total{method="GET",route="unmatched",status="404"} 2`))
+		})
+	})
+
+	Describe("ObserveRepository", func() {
+		It("records the latency of the operations", func() {
+			instance.ObserveRepository("tasks", "GetAll", time.Millisecond, nil)
+			instance.ObserveRepository("tasks", "GetAll", time.Millisecond, nil)
+
+			content := scrape()
+
+			Expect(content).To(ContainSubstring(`dalil_repository_operation_duration_seconds_count{operation="GetAll",repository="tasks"} 2`))
+			Expect(content).NotTo(ContainSubstring("dalil_repository_errors_total{"))
+		})
+
+		DescribeTable("counts the errors by kind",
+			func(err error, kind string) {
+				instance.ObserveRepository("tasks", "Update", time.Millisecond, err)
+
+				Expect(scrape()).To(ContainSubstring(
+					fmt.Sprintf(`dalil_repository_errors_total{kind="%s",operation="Update",repository="tasks"} 1`, kind)))
+			},
+			Entry("not found", errors.ErrNotFound, "not_found"),
+			Entry("conflict", errors.ErrConflict, "conflict"),
+			Entry("unexpected", fmt.Errorf("disk full"), "internal"),
+		)
+	})
+
+	Describe("MustRegister", func() {
+		It("exposes the counts of the registered collectors", func() {
+			instance.MustRegister(metrics.NewCountCollector("tasks", "Number of tasks.", "status",
+				func() (map[string]int, error) {
+					return map[string]int{"Todo": 2, "Done": 0}, nil
+				}))
+
+			content := scrape()
+
+			Expect(content).To(ContainSubstring(`dalil_tasks{status="Todo"} 2`))
+			Expect(content).To(ContainSubstring(`dalil_tasks{status="Done"} 0`))
+		})
+
+		It("fails the scrape when a count cannot be computed", func() {
+			instance.MustRegister(metrics.NewCountCollector("tasks", "Number of tasks.", "status",
+				func() (map[string]int, error) {
+					return nil, fmt.Errorf("custom error")
+				}))
+
+			recorder := httptest.NewRecorder()
+			instance.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+})
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+)
+
+func AdminContext(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(constants.AdminTokenHeader)
+			admin := token != "" && subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1
+
+			next.ServeHTTP(w, r.WithContext(reqctx.SetAdmin(r.Context(), admin)))
+		})
+	}
+}
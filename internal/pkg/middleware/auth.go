@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	goErrors "errors"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/auth"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/go-logr/logr"
+)
+
+const (
+	missingCredentials = "The request has no credentials"
+	invalidCredentials = "The credentials of the request are invalid or expired"
+)
+
+func Authentication(authenticator auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			logger := logr.FromContextOrDiscard(ctx)
+
+			principal, err := authenticator.Authenticate(r)
+			if err != nil {
+				instance := errorModel.WithInstance(r.URL.Path)
+				switch {
+				case err == errors.ErrNotFound:
+					w.Header().Set("WWW-Authenticate", authenticator.Challenge())
+					_ = marshaller.SerializeProblem(w, errorModel.NewProblem(http.StatusUnauthorized, missingCredentials, instance))
+				case goErrors.Is(err, errors.ErrUnauthorized):
+					logger.V(1).Info("Authentication failed", "reason", err.Error())
+					w.Header().Set("WWW-Authenticate", authenticator.Challenge())
+					_ = marshaller.SerializeProblem(w, errorModel.NewProblem(http.StatusUnauthorized, invalidCredentials, instance))
+				default:
+					logger.Error(err, "Authentication failed")
+					_ = marshaller.SerializeProblem(w, errorModel.NewProblem(http.StatusInternalServerError, err.Error(), instance))
+				}
+				return
+			}
+
+			ctx = reqctx.SetPrincipal(ctx, principal)
+			ctx = reqctx.SetAdmin(ctx, principal.Admin || reqctx.IsAdmin(ctx))
+			if logger, err := logr.FromContext(ctx); err == nil {
+				ctx = logr.NewContext(ctx, logger.WithValues("principal", principal.Id, "auth_method", principal.Method))
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !reqctx.IsAdmin(r.Context()) {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, "The operation requires administrator rights"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/go-logr/logr"
+)
+
+func ClientContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		certificate := r.TLS.PeerCertificates[0]
+		client := reqctx.ClientIdentity{
+			CommonName:     certificate.Subject.CommonName,
+			Organization:   certificate.Subject.Organization,
+			DNSNames:       certificate.DNSNames,
+			EmailAddresses: certificate.EmailAddresses,
+			SerialNumber:   certificate.SerialNumber.String(),
+		}
+
+		ctx := reqctx.SetClient(r.Context(), client)
+		if logger, err := logr.FromContext(ctx); err == nil {
+			ctx = logr.NewContext(ctx, logger.WithValues("client", client.CommonName))
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -2,6 +2,11 @@ package middleware_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -13,12 +18,28 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/aeon-fruit/dalil.git/internal/pkg/auth"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
 	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/middleware"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
 	logMock "github.com/aeon-fruit/dalil.git/test/mocks/log"
 )
 
+type testAuthenticator struct {
+	principal reqctx.Principal
+	err       error
+}
+
+func (ta testAuthenticator) Authenticate(_ *http.Request) (reqctx.Principal, error) {
+	return ta.principal, ta.err
+}
+
+func (ta testAuthenticator) Challenge() string {
+	return "Bearer"
+}
+
 type testHandler struct {
 	request   *http.Request
 	callCount int
@@ -461,4 +482,235 @@ var _ = Describe("Middleware", func() {
 
 	})
 
+	Describe("AdminContext", func() {
+		const token = "s3cr3t"
+
+		var handler *testHandler
+
+		serve := func(mw func(http.Handler) http.Handler, header string) {
+			request := httptest.NewRequest("", "http://url", nil)
+			if header != "" {
+				request.Header.Set(constants.AdminTokenHeader, header)
+			}
+			mw(handler).ServeHTTP(httptest.NewRecorder(), request)
+		}
+
+		BeforeEach(func() {
+			handler = &testHandler{}
+		})
+
+		DescribeTable("flags the request as coming from an administrator",
+			func(configured string, header string, expected bool) {
+				serve(middleware.AdminContext(configured), header)
+
+				Expect(handler.callCount).To(Equal(1))
+				Expect(reqctx.IsAdmin(handler.request.Context())).To(Equal(expected))
+			},
+			Entry("with the configured token", token, token, true),
+			Entry("with another token", token, "guess", false),
+			Entry("without token", token, "", false),
+			Entry("when no token is configured", "", "", false),
+		)
+	})
+
+	Describe("ClientContext", func() {
+		var handler *testHandler
+
+		BeforeEach(func() {
+			handler = &testHandler{}
+		})
+
+		When("the request has no client certificate", func() {
+			It("forwards the request without a client identity", func() {
+				request := httptest.NewRequest("", "http://url", nil)
+				request.TLS = &tls.ConnectionState{}
+
+				middleware.ClientContext(handler).ServeHTTP(httptest.NewRecorder(), request)
+
+				Expect(handler.callCount).To(Equal(1))
+				Expect(reqctx.GetClient(handler.request.Context())).Error().To(HaveOccurred())
+			})
+		})
+
+		When("the request has a client certificate", func() {
+			It("augments the context with the identity of the client", func() {
+				request := httptest.NewRequest("", "https://url", nil)
+				request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+					Subject:        pkix.Name{CommonName: "worker", Organization: []string{"Dalil"}},
+					DNSNames:       []string{"worker.local"},
+					EmailAddresses: []string{"worker@dalil.local"},
+					SerialNumber:   big.NewInt(42),
+				}}}
+
+				middleware.ClientContext(handler).ServeHTTP(httptest.NewRecorder(), request)
+
+				Expect(handler.callCount).To(Equal(1))
+				Expect(reqctx.GetClient(handler.request.Context())).To(Equal(reqctx.ClientIdentity{
+					CommonName:     "worker",
+					Organization:   []string{"Dalil"},
+					DNSNames:       []string{"worker.local"},
+					EmailAddresses: []string{"worker@dalil.local"},
+					SerialNumber:   "42",
+				}))
+			})
+		})
+	})
+
+	Describe("Authentication", func() {
+		var (
+			handler  *testHandler
+			recorder *httptest.ResponseRecorder
+		)
+
+		serve := func(authenticator auth.Authenticator, ctx context.Context) {
+			request := httptest.NewRequest(http.MethodGet, "http://url/api/v1/tasks", nil).WithContext(ctx)
+			middleware.Authentication(authenticator)(handler).ServeHTTP(recorder, request)
+		}
+
+		BeforeEach(func() {
+			handler = &testHandler{}
+			recorder = httptest.NewRecorder()
+		})
+
+		When("the request is authenticated", func() {
+			principal := reqctx.Principal{Id: "3", Name: "ci", Method: reqctx.AuthMethodApiKey}
+
+			It("augments the context with the principal", func() {
+				serve(testAuthenticator{principal: principal}, context.Background())
+
+				Expect(handler.callCount).To(Equal(1))
+				Expect(reqctx.GetPrincipal(handler.request.Context())).To(Equal(principal))
+				Expect(reqctx.IsAdmin(handler.request.Context())).To(BeFalse())
+			})
+
+			It("flags the administrators", func() {
+				admin := principal
+				admin.Admin = true
+
+				serve(testAuthenticator{principal: admin}, context.Background())
+
+				Expect(reqctx.IsAdmin(handler.request.Context())).To(BeTrue())
+			})
+
+			It("keeps the administrator flag of the admin token", func() {
+				serve(testAuthenticator{principal: principal}, reqctx.SetAdmin(context.Background(), true))
+
+				Expect(reqctx.IsAdmin(handler.request.Context())).To(BeTrue())
+			})
+		})
+
+		DescribeTable("rejects the request with problem details",
+			func(err error, status int, detail string) {
+				serve(testAuthenticator{err: err}, context.Background())
+
+				Expect(handler.callCount).To(BeZero())
+				Expect(recorder.Code).To(Equal(status))
+				Expect(recorder.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+				Expect(recorder.Body.String()).To(And(
+					ContainSubstring(`"status":`+strconv.Itoa(status)),
+					ContainSubstring(`"detail":"`+detail+`"`),
+					ContainSubstring(`"instance":"/api/v1/tasks"`),
+				))
+			},
+			Entry("without credentials", errors.ErrNotFound, http.StatusUnauthorized, "The request has no credentials"),
+			Entry("with invalid credentials", fmt.Errorf("%w: token is expired", errors.ErrUnauthorized),
+				http.StatusUnauthorized, "The credentials of the request are invalid or expired"),
+			Entry("when the authentication fails", errors.ErrConflict, http.StatusInternalServerError, errors.ErrConflict.Error()),
+		)
+
+		It("challenges the client on 401", func() {
+			serve(testAuthenticator{err: errors.ErrNotFound}, context.Background())
+
+			Expect(recorder.Header().Get("WWW-Authenticate")).To(Equal("Bearer"))
+		})
+	})
+
+	Describe("RequireAdmin", func() {
+		It("forwards the requests of administrators", func() {
+			handler := &testHandler{}
+			request := httptest.NewRequest("", "http://url", nil)
+
+			middleware.RequireAdmin(handler).ServeHTTP(httptest.NewRecorder(), request.WithContext(reqctx.SetAdmin(request.Context(), true)))
+
+			Expect(handler.callCount).To(Equal(1))
+		})
+
+		It("forbids the other requests", func() {
+			handler := &testHandler{}
+			recorder := httptest.NewRecorder()
+
+			middleware.RequireAdmin(handler).ServeHTTP(recorder, httptest.NewRequest("", "http://url", nil))
+
+			Expect(handler.callCount).To(BeZero())
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("TenantContext", func() {
+		var (
+			handler  *testHandler
+			recorder *httptest.ResponseRecorder
+			registry tenancy.Registry
+		)
+
+		serve := func(ctx context.Context, tenant string) {
+			request := httptest.NewRequest(http.MethodGet, "http://url/api/v1/tasks", nil).WithContext(ctx)
+			if tenant != "" {
+				request.Header.Set(constants.TenantHeader, tenant)
+			}
+			middleware.TenantContext("", registry)(handler).ServeHTTP(recorder, request)
+		}
+
+		asPrincipal := func(tenant string) context.Context {
+			return reqctx.SetPrincipal(context.Background(), reqctx.Principal{Id: "3", Method: reqctx.AuthMethodApiKey, Tenant: tenant})
+		}
+
+		asAdminToken := func() context.Context {
+			return reqctx.SetAdmin(reqctx.SetPrincipal(context.Background(),
+				reqctx.Principal{Id: "admin", Method: reqctx.AuthMethodAdminToken, Admin: true}), true)
+		}
+
+		asAdminJwt := func(tenant string) context.Context {
+			return reqctx.SetAdmin(reqctx.SetPrincipal(context.Background(),
+				reqctx.Principal{Id: "alice", Method: reqctx.AuthMethodJwt, Admin: true, Tenant: tenant}), true)
+		}
+
+		BeforeEach(func() {
+			handler = &testHandler{}
+			recorder = httptest.NewRecorder()
+			registry = tenancy.NewRegistry()
+		})
+
+		DescribeTable("scopes the request to a tenant",
+			func(ctx context.Context, header string, expected string) {
+				serve(ctx, header)
+
+				Expect(handler.callCount).To(Equal(1))
+				Expect(reqctx.GetTenant(handler.request.Context())).To(Equal(expected))
+				Expect(registry.All()).To(ContainElement(expected))
+			},
+			Entry("defaulting without principal nor header", context.Background(), "", tenancy.DefaultTenant),
+			Entry("from the header without principal", context.Background(), "acme", "acme"),
+			Entry("from the principal", asPrincipal("acme"), "", "acme"),
+			Entry("from the principal matching the header", asPrincipal("acme"), "acme", "acme"),
+			Entry("defaulting for a principal without tenant", asPrincipal(""), "", tenancy.DefaultTenant),
+			Entry("from the header for the admin token", asAdminToken(), "globex", "globex"),
+			Entry("defaulting for the admin token without header", asAdminToken(), "", tenancy.DefaultTenant),
+			Entry("from the principal of administrators without header", reqctx.SetAdmin(asPrincipal("acme"), true), "", "acme"),
+		)
+
+		DescribeTable("rejects the request",
+			func(ctx context.Context, header string, status int) {
+				serve(ctx, header)
+
+				Expect(handler.callCount).To(BeZero())
+				Expect(recorder.Code).To(Equal(status))
+			},
+			Entry("for another tenant than the principal's", asPrincipal("acme"), "globex", http.StatusForbidden),
+			Entry("for another tenant than the default", asPrincipal(""), "globex", http.StatusForbidden),
+			Entry("for another tenant than the one of an administrator", asAdminJwt("acme"), "globex", http.StatusForbidden),
+			Entry("for an invalid tenant", context.Background(), "../etc", http.StatusBadRequest),
+		)
+	})
+
 })
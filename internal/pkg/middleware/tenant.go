@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/go-logr/logr"
+)
+
+const foreignTenant = "The credentials do not grant access to the tenant"
+
+func TenantContext(header string, registry tenancy.Registry) func(http.Handler) http.Handler {
+	if header == "" {
+		header = constants.TenantHeader
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			tenant := strings.TrimSpace(r.Header.Get(header))
+			principal, err := reqctx.GetPrincipal(ctx)
+			authenticated := err == nil
+			global := principal.Method == reqctx.AuthMethodAdminToken && principal.Tenant == ""
+			if authenticated && !global {
+				owned := principal.Tenant
+				if owned == "" {
+					owned = tenancy.DefaultTenant
+				}
+				if tenant != "" && tenant != owned {
+					_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, foreignTenant))
+					return
+				}
+				tenant = owned
+			}
+			if tenant == "" && authenticated {
+				tenant = principal.Tenant
+			}
+			if tenant == "" {
+				tenant = tenancy.DefaultTenant
+			}
+
+			if !tenancy.IsValid(tenant) {
+				_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, fmt.Sprintf("The tenant %q is invalid", tenant)))
+				return
+			}
+
+			if registry != nil {
+				registry.Add(tenant)
+			}
+			ctx = tenancy.With(ctx, tenant)
+			if logger, err := logr.FromContext(ctx); err == nil {
+				ctx = logr.NewContext(ctx, logger.WithValues("tenant", tenant))
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -85,5 +85,29 @@ var _ = Describe("Error", func() {
 				Expect(instance.Timestamp).To(Equal(timestamp))
 			})
 		})
+
+		When("WithDetails is specified", func() {
+			It("has the details", func() {
+				details := map[string]int{"count": 3}
+
+				instance := error.New(http.StatusConflict, "", error.WithDetails(details))
+
+				Expect(instance.Details).To(Equal(details))
+			})
+		})
+	})
+
+	Describe("NewProblem", func() {
+		It("has the title of the HTTP status and the trimmed detail", func() {
+			instance := error.NewProblem(http.StatusUnauthorized, " missing credentials ", error.WithInstance("/api/v1/tasks"))
+
+			Expect(instance).To(Equal(error.Problem{
+				Type:     "about:blank",
+				Title:    "Unauthorized",
+				Status:   http.StatusUnauthorized,
+				Detail:   "missing credentials",
+				Instance: "/api/v1/tasks",
+			}))
+		})
 	})
 })
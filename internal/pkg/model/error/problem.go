@@ -0,0 +1,43 @@
+package error
+
+import (
+	"net/http"
+	"strings"
+)
+
+const problemTypeBlank = "about:blank"
+
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+type ProblemOption func(*Problem)
+
+func NewProblem(httpStatusCode int, detail string, options ...ProblemOption) Problem {
+	instance := Problem{
+		Type:   problemTypeBlank,
+		Title:  http.StatusText(httpStatusCode),
+		Status: httpStatusCode,
+		Detail: strings.TrimSpace(detail),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return instance
+}
+
+func WithInstance(instance string) ProblemOption {
+	return func(problem *Problem) {
+		if problem != nil {
+			problem.Instance = instance
+		}
+	}
+}
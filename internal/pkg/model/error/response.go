@@ -12,6 +12,7 @@ type Response struct {
 	Code      int       `json:"code"`
 	Message   string    `json:"message"`
 	Timestamp time.Time `json:"timestamp"`
+	Details   any       `json:"details,omitempty"`
 }
 
 type ResponseOption func(*Response)
@@ -44,3 +45,11 @@ func UsingClock(clock stubs.Clock) ResponseOption {
 		}
 	}
 }
+
+func WithDetails(details any) ResponseOption {
+	return func(response *Response) {
+		if response != nil {
+			response.Details = details
+		}
+	}
+}
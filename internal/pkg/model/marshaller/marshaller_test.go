@@ -138,4 +138,34 @@ var _ = Describe("Marshaller", func() {
 			})
 		})
 	})
+
+	Describe("SerializeProblem", func() {
+		problem := errorModel.NewProblem(http.StatusUnauthorized, "missing credentials")
+
+		BeforeEach(func() {
+			recorder = httptest.NewRecorder()
+		})
+
+		When("writer argument is nil", func() {
+			It("returns an error", func() {
+				err := marshaller.SerializeProblem(nil, problem)
+
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Unwrap(err)).To(Equal(commonErrors.ErrInvalidArgument))
+			})
+		})
+
+		When("operation succeeds", func() {
+			It("writes the problem details to the writer with their media type", func() {
+				err := marshaller.SerializeProblem(recorder, problem)
+
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(recorder.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+				Expect(recorder.Result().StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(recorder.Body.String()).To(Equal(
+					"{\"type\":\"about:blank\",\"title\":\"Unauthorized\",\"status\":401,\"detail\":\"missing credentials\"}\n"))
+			})
+		})
+	})
 })
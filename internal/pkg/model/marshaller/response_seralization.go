@@ -37,3 +37,23 @@ func SerializeError(w http.ResponseWriter, errorResponse errorModel.Response) er
 	}
 	return err
 }
+
+func SerializeProblem(w http.ResponseWriter, problem errorModel.Problem) error {
+	if w == nil {
+		return fmt.Errorf("%w: 1st argument should be non-nil", errors.ErrInvalidArgument)
+	}
+
+	statusCode := problem.Status
+	if http.StatusText(statusCode) == "" {
+		statusCode = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(problem)
+	if err != nil {
+		httpStatus := http.StatusInternalServerError
+		http.Error(w, http.StatusText(httpStatus), httpStatus)
+	}
+	return err
+}
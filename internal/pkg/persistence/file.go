@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+type Flusher interface {
+	Flush() error
+}
+
+func ReadJSON(path string, value any) error {
+	content, err := os.ReadFile(path)
+	if goErrors.Is(err, fs.ErrNotExist) {
+		return errors.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, value)
+}
+
+func WriteJSON(path string, value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dir, fmt.Sprintf(".%s-*", filepath.Base(path)))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,73 @@
+package persistence_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+)
+
+var _ = Describe("File", func() {
+
+	type record struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "nested", "records.json")
+	})
+
+	Describe("ReadJSON", func() {
+		When("the file doesn't exist", func() {
+			It("returns ErrNotFound", func() {
+				var records []record
+
+				Expect(persistence.ReadJSON(path, &records)).To(Equal(errors.ErrNotFound))
+			})
+		})
+
+		When("the file content is not valid JSON", func() {
+			It("returns an error", func() {
+				Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+				Expect(os.WriteFile(path, []byte("{"), 0o644)).To(Succeed())
+
+				var records []record
+
+				Expect(persistence.ReadJSON(path, &records)).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("WriteJSON", func() {
+		It("writes a value that ReadJSON reads back", func() {
+			records := []record{{Id: 1, Name: "one"}, {Id: 2, Name: "two"}}
+
+			Expect(persistence.WriteJSON(path, records)).To(Succeed())
+
+			var read []record
+			Expect(persistence.ReadJSON(path, &read)).To(Succeed())
+			Expect(read).To(Equal(records))
+		})
+
+		It("replaces the previous content and leaves no temporary file behind", func() {
+			Expect(persistence.WriteJSON(path, []record{{Id: 1}})).To(Succeed())
+			Expect(persistence.WriteJSON(path, []record{{Id: 2}})).To(Succeed())
+
+			var read []record
+			Expect(persistence.ReadJSON(path, &read)).To(Succeed())
+			Expect(read).To(Equal([]record{{Id: 2}}))
+
+			entries, err := os.ReadDir(filepath.Dir(path))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+		})
+	})
+
+})
@@ -0,0 +1,155 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+)
+
+type Policy interface {
+	AuthorizeList(ctx context.Context, listId int, role entity.Role) error
+	AuthorizeTask(ctx context.Context, taskId int, role entity.Role) error
+	ReadableLists(ctx context.Context) (Lists, error)
+	Own(ctx context.Context, listId int) error
+	Forget(ctx context.Context, listId int) error
+}
+
+type Lists struct {
+	unrestricted bool
+	restricted   map[int]bool
+	granted      map[int]bool
+}
+
+func (lists Lists) Contains(listId int) bool {
+	return lists.unrestricted || !lists.restricted[listId] || lists.granted[listId]
+}
+
+type policyImpl struct {
+	grants rolesDAO.Repository
+	tasks  tasksDAO.Repository
+}
+
+type PolicyOption func(*policyImpl)
+
+func New(options ...PolicyOption) Policy {
+	instance := policyImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithGrants(grants rolesDAO.Repository) PolicyOption {
+	return func(policy *policyImpl) {
+		if policy != nil {
+			policy.grants = grants
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) PolicyOption {
+	return func(policy *policyImpl) {
+		if policy != nil {
+			policy.tasks = tasks
+		}
+	}
+}
+
+func (policy *policyImpl) AuthorizeList(ctx context.Context, listId int, role entity.Role) error {
+	userId, unrestricted := policy.subject(ctx)
+	if unrestricted {
+		return nil
+	}
+
+	grants, err := policy.grants.GetByList(ctx, listId)
+	if err != nil {
+		return err
+	}
+	if len(grants) == 0 {
+		return nil
+	}
+
+	for _, grant := range grants {
+		if userId != nil && grant.UserId == *userId && grant.Role.Includes(role) {
+			return nil
+		}
+	}
+	return errors.ErrForbidden
+}
+
+func (policy *policyImpl) AuthorizeTask(ctx context.Context, taskId int, role entity.Role) error {
+	if _, unrestricted := policy.subject(ctx); unrestricted || policy.tasks == nil {
+		return nil
+	}
+
+	task, err := policy.tasks.GetById(ctx, taskId)
+	if err != nil {
+		return err
+	}
+	return policy.AuthorizeList(ctx, task.ListId, role)
+}
+
+func (policy *policyImpl) ReadableLists(ctx context.Context) (Lists, error) {
+	userId, unrestricted := policy.subject(ctx)
+	if unrestricted {
+		return Lists{unrestricted: true}, nil
+	}
+
+	grants, err := policy.grants.GetAll(ctx)
+	if err != nil {
+		return Lists{}, err
+	}
+
+	lists := Lists{restricted: map[int]bool{}, granted: map[int]bool{}}
+	for _, grant := range grants {
+		lists.restricted[grant.ListId] = true
+		if userId != nil && grant.UserId == *userId {
+			lists.granted[grant.ListId] = true
+		}
+	}
+	return lists, nil
+}
+
+func (policy *policyImpl) Own(ctx context.Context, listId int) error {
+	if policy.grants == nil {
+		return nil
+	}
+
+	principal, err := reqctx.GetPrincipal(ctx)
+	if err != nil || principal.UserId == nil {
+		return nil
+	}
+
+	_, err = policy.grants.Upsert(ctx, entity.Grant{ListId: listId, UserId: *principal.UserId, Role: entity.RoleOwner})
+	if err == errors.ErrNotModified {
+		return nil
+	}
+	return err
+}
+
+func (policy *policyImpl) Forget(ctx context.Context, listId int) error {
+	if policy.grants == nil {
+		return nil
+	}
+	return policy.grants.RemoveList(ctx, listId)
+}
+
+func (policy *policyImpl) subject(ctx context.Context) (userId *int, unrestricted bool) {
+	if policy.grants == nil || reqctx.IsAdmin(ctx) {
+		return nil, true
+	}
+
+	principal, err := reqctx.GetPrincipal(ctx)
+	if err != nil {
+		return nil, true
+	}
+	return principal.UserId, false
+}
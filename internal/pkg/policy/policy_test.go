@@ -0,0 +1,154 @@
+package policy_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	tasksMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Policy", func() {
+
+	var (
+		mockCtrl  *gomock.Controller
+		mockTasks *tasksMock.MockRepository
+		grants    rolesDAO.Repository
+		appPolicy policy.Policy
+	)
+
+	asUser := func(userId int) context.Context {
+		return reqctx.SetPrincipal(context.Background(), reqctx.Principal{Id: "jane", UserId: &userId})
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTasks = tasksMock.NewMockRepository(mockCtrl)
+		grants = rolesDAO.New(rolesDAO.WithGrants(
+			entity.Grant{ListId: 1, UserId: 1, Role: entity.RoleOwner},
+			entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleEditor},
+			entity.Grant{ListId: 1, UserId: 3, Role: entity.RoleViewer},
+		))
+		appPolicy = policy.New(policy.WithGrants(grants), policy.WithTasks(mockTasks))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(policy.New()).NotTo(BeNil())
+		})
+
+		It("allows everything without grants repository", func() {
+			Expect(policy.New().AuthorizeList(asUser(3), 1, entity.RoleOwner)).To(Succeed())
+			Expect(policy.New().AuthorizeTask(asUser(3), 1, entity.RoleOwner)).To(Succeed())
+		})
+	})
+
+	Describe("AuthorizeList", func() {
+		DescribeTable("checks the role of the user on the list",
+			func(userId int, role entity.Role, allowed bool) {
+				err := appPolicy.AuthorizeList(asUser(userId), 1, role)
+				if allowed {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(Equal(errors.ErrForbidden))
+				}
+			},
+			Entry("owner may remove", 1, entity.RoleOwner, true),
+			Entry("editor may write", 2, entity.RoleEditor, true),
+			Entry("editor may not remove", 2, entity.RoleOwner, false),
+			Entry("viewer may read", 3, entity.RoleViewer, true),
+			Entry("viewer may not write", 3, entity.RoleEditor, false),
+			Entry("stranger may not read", 4, entity.RoleViewer, false),
+		)
+
+		It("allows everyone on a list without grants", func() {
+			Expect(appPolicy.AuthorizeList(asUser(4), 2, entity.RoleOwner)).To(Succeed())
+		})
+
+		It("allows requests without principal", func() {
+			Expect(appPolicy.AuthorizeList(context.Background(), 1, entity.RoleOwner)).To(Succeed())
+		})
+
+		It("allows administrators", func() {
+			Expect(appPolicy.AuthorizeList(reqctx.SetAdmin(asUser(4), true), 1, entity.RoleOwner)).To(Succeed())
+		})
+
+		It("forbids principals not bound to a user", func() {
+			ctx := reqctx.SetPrincipal(context.Background(), reqctx.Principal{Id: "ci"})
+
+			Expect(appPolicy.AuthorizeList(ctx, 1, entity.RoleViewer)).To(Equal(errors.ErrForbidden))
+		})
+	})
+
+	Describe("AuthorizeTask", func() {
+		It("checks the role on the list of the task", func() {
+			ctx := asUser(3)
+			mockTasks.EXPECT().GetById(ctx, 5).Return(tasksEntity.Task{Id: 5, ListId: 1}, nil).Times(2)
+
+			Expect(appPolicy.AuthorizeTask(ctx, 5, entity.RoleViewer)).To(Succeed())
+			Expect(appPolicy.AuthorizeTask(ctx, 5, entity.RoleEditor)).To(Equal(errors.ErrForbidden))
+		})
+
+		It("returns the error of the tasks repository", func() {
+			ctx := asUser(3)
+			mockTasks.EXPECT().GetById(ctx, 5).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+			Expect(appPolicy.AuthorizeTask(ctx, 5, entity.RoleViewer)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("does not look the task up for unrestricted requests", func() {
+			Expect(appPolicy.AuthorizeTask(context.Background(), 5, entity.RoleOwner)).To(Succeed())
+		})
+	})
+
+	Describe("ReadableLists", func() {
+		It("contains the granted and the open lists", func() {
+			lists, err := appPolicy.ReadableLists(asUser(4))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lists.Contains(1)).To(BeFalse())
+			Expect(lists.Contains(2)).To(BeTrue())
+
+			lists, err = appPolicy.ReadableLists(asUser(3))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lists.Contains(1)).To(BeTrue())
+		})
+
+		It("contains every list for unrestricted requests", func() {
+			lists, err := appPolicy.ReadableLists(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lists.Contains(1)).To(BeTrue())
+		})
+	})
+
+	Describe("Own", func() {
+		It("grants the owner role to the user", func() {
+			Expect(appPolicy.Own(asUser(4), 2)).To(Succeed())
+			Expect(grants.GetByList(context.Background(), 2)).To(HaveExactElements(And(HaveField("UserId", 4), HaveField("Role", entity.RoleOwner))))
+		})
+
+		It("ignores an existing ownership", func() {
+			Expect(appPolicy.Own(asUser(1), 1)).To(Succeed())
+		})
+
+		It("grants nothing without user", func() {
+			Expect(appPolicy.Own(context.Background(), 2)).To(Succeed())
+			Expect(grants.GetByList(context.Background(), 2)).To(BeEmpty())
+		})
+	})
+
+	Describe("Forget", func() {
+		It("removes the grants of the list", func() {
+			Expect(appPolicy.Forget(context.Background(), 1)).To(Succeed())
+			Expect(grants.GetByList(context.Background(), 1)).To(BeEmpty())
+		})
+	})
+
+})
@@ -0,0 +1,314 @@
+package quickadd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+)
+
+const (
+	tagPrefix      = "#"
+	contextPrefix  = "@"
+	priorityPrefix = "!"
+	defaultHour    = 9
+)
+
+var (
+	clockTime    = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	twentyFour   = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	hourOnly     = regexp.MustCompile(`^\d{1,2}$`)
+	priorityRule = regexp.MustCompile(`^p[0-4]$`)
+	prepositions = map[string]bool{"on": true, "at": true, "by": true, "due": true}
+	weekdays     = map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	}
+	units = map[string]time.Duration{
+		"minute": time.Minute, "minutes": time.Minute, "min": time.Minute, "mins": time.Minute,
+		"hour": time.Hour, "hours": time.Hour, "h": time.Hour,
+		"day": 24 * time.Hour, "days": 24 * time.Hour,
+		"week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+	}
+)
+
+type Task struct {
+	Name     string
+	DueAt    *time.Time
+	Tags     []string
+	Priority string
+	Context  string
+}
+
+type Parser interface {
+	Parse(text string) (Task, error)
+}
+
+type parserImpl struct {
+	clock stubs.Clock
+}
+
+type ParserOption func(*parserImpl)
+
+func New(options ...ParserOption) Parser {
+	instance := parserImpl{
+		clock: stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithClock(clock stubs.Clock) ParserOption {
+	return func(parser *parserImpl) {
+		if parser != nil && clock != nil {
+			parser.clock = clock
+		}
+	}
+}
+
+type due struct {
+	date  *time.Time
+	clock *time.Duration
+	exact *time.Time
+}
+
+func (parser *parserImpl) Parse(text string) (Task, error) {
+	now := parser.clock.Now()
+	tokens := strings.Fields(text)
+
+	var task Task
+	var when due
+	var words []string
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		lower := strings.ToLower(token)
+
+		switch {
+		case strings.HasPrefix(token, tagPrefix) && len(token) > len(tagPrefix):
+			task.Tags = appendUnique(task.Tags, token[len(tagPrefix):])
+			continue
+		case strings.HasPrefix(token, contextPrefix) && len(token) > len(contextPrefix):
+			task.Context = token[len(contextPrefix):]
+			continue
+		case strings.HasPrefix(lower, priorityPrefix) && priorityRule.MatchString(lower[len(priorityPrefix):]):
+			task.Priority = strings.ToUpper(lower[len(priorityPrefix):])
+			continue
+		}
+
+		start := i
+		if prepositions[lower] && i+1 < len(tokens) {
+			start = i + 1
+		}
+		if n := when.match(tokens[start:], now); n > 0 {
+			i = start + n - 1
+			continue
+		}
+
+		words = append(words, token)
+	}
+
+	task.Name = strings.Join(words, " ")
+	if task.Name == "" {
+		return Task{}, errors.ErrInvalidArgument
+	}
+	task.DueAt = when.resolve(now)
+	return task, nil
+}
+
+func (when *due) match(tokens []string, now time.Time) int {
+	if when.date == nil && when.exact == nil {
+		if date, n := matchDate(tokens, now); n > 0 {
+			when.date = &date
+			return n
+		}
+		if exact, n := matchRelative(tokens, now); n > 0 {
+			when.exact = &exact
+			return n
+		}
+	}
+	if when.clock == nil && when.exact == nil {
+		if clock, n := matchClock(tokens); n > 0 {
+			when.clock = &clock
+			return n
+		}
+	}
+	return 0
+}
+
+func (when *due) resolve(now time.Time) *time.Time {
+	if when.exact != nil {
+		return when.exact
+	}
+	if when.date == nil && when.clock == nil {
+		return nil
+	}
+
+	today := midnight(now)
+	if when.date == nil {
+		dueAt := today.Add(*when.clock)
+		if !dueAt.After(now) {
+			dueAt = today.AddDate(0, 0, 1).Add(*when.clock)
+		}
+		return &dueAt
+	}
+
+	clock := defaultHour * time.Hour
+	if when.clock != nil {
+		clock = *when.clock
+	}
+	dueAt := when.date.Add(clock)
+	return &dueAt
+}
+
+func matchDate(tokens []string, now time.Time) (time.Time, int) {
+	if len(tokens) == 0 {
+		return time.Time{}, 0
+	}
+
+	today := midnight(now)
+	first := strings.ToLower(tokens[0])
+	switch first {
+	case "today":
+		return today, 1
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), 1
+	case "next":
+		if len(tokens) > 1 {
+			second := strings.ToLower(tokens[1])
+			if second == "week" {
+				return today.AddDate(0, 0, 7), 2
+			}
+			if weekday, found := weekdays[second]; found {
+				return nextWeekday(today, weekday), 2
+			}
+		}
+		return time.Time{}, 0
+	}
+
+	if weekday, found := weekdays[first]; found {
+		return nextWeekday(today, weekday), 1
+	}
+	if date, err := time.ParseInLocation("2006-01-02", first, now.Location()); err == nil {
+		return date, 1
+	}
+	if first == "in" && len(tokens) > 2 {
+		if count, err := strconv.Atoi(tokens[1]); err == nil && count > 0 {
+			switch unit := units[strings.ToLower(tokens[2])]; unit {
+			case 24 * time.Hour:
+				return today.AddDate(0, 0, count), 3
+			case 7 * 24 * time.Hour:
+				return today.AddDate(0, 0, 7*count), 3
+			}
+		}
+	}
+	return time.Time{}, 0
+}
+
+func matchRelative(tokens []string, now time.Time) (time.Time, int) {
+	if len(tokens) < 3 || strings.ToLower(tokens[0]) != "in" {
+		return time.Time{}, 0
+	}
+
+	count, err := strconv.Atoi(tokens[1])
+	if err != nil || count <= 0 {
+		return time.Time{}, 0
+	}
+
+	switch unit := units[strings.ToLower(tokens[2])]; unit {
+	case time.Minute, time.Hour:
+		return now.Add(time.Duration(count) * unit).Truncate(time.Minute), 3
+	}
+	return time.Time{}, 0
+}
+
+func matchClock(tokens []string) (time.Duration, int) {
+	if len(tokens) == 0 {
+		return 0, 0
+	}
+
+	first := strings.ToLower(tokens[0])
+	switch first {
+	case "noon":
+		return 12 * time.Hour, 1
+	case "midnight":
+		return 0, 1
+	}
+
+	if match := clockTime.FindStringSubmatch(first); match != nil {
+		if clock, ok := toClock(match[1], match[2], match[3]); ok {
+			return clock, 1
+		}
+	}
+	if match := twentyFour.FindStringSubmatch(first); match != nil {
+		if clock, ok := toClock(match[1], match[2], ""); ok {
+			return clock, 1
+		}
+	}
+	if hourOnly.MatchString(first) && len(tokens) > 1 {
+		if meridiem := strings.ToLower(tokens[1]); meridiem == "am" || meridiem == "pm" {
+			if clock, ok := toClock(first, "", meridiem); ok {
+				return clock, 2
+			}
+		}
+	}
+	return 0, 0
+}
+
+func toClock(hours string, minutes string, meridiem string) (time.Duration, bool) {
+	hour, _ := strconv.Atoi(hours)
+	var minute int
+	if minutes != "" {
+		minute, _ = strconv.Atoi(minutes)
+	}
+
+	if meridiem != "" {
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, false
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, true
+}
+
+func nextWeekday(today time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+func midnight(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, other := range values {
+		if strings.EqualFold(other, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
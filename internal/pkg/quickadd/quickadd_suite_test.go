@@ -0,0 +1,13 @@
+package quickadd_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestQuickAdd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Quick Add Suite")
+}
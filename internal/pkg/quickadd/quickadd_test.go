@@ -0,0 +1,83 @@
+package quickadd_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/quickadd"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+)
+
+var _ = Describe("Parser", func() {
+
+	// Friday, 17 March 2023 at 10:15
+	now := time.Date(2023, time.March, 17, 10, 15, 0, 0, time.UTC)
+	parser := quickadd.New(quickadd.WithClock(stubs.NewFixed(now)))
+
+	at := func(day int, hour int, minute int) *time.Time {
+		dueAt := time.Date(2023, time.March, day, hour, minute, 0, 0, time.UTC)
+		return &dueAt
+	}
+
+	It("parses the name, the due date, the tags, the priority and the context", func() {
+		Expect(parser.Parse("Pay rent tomorrow 9am #finance !p1 @home")).To(Equal(quickadd.Task{
+			Name:     "Pay rent",
+			DueAt:    at(18, 9, 0),
+			Tags:     []string{"finance"},
+			Priority: "P1",
+			Context:  "home",
+		}))
+	})
+
+	It("keeps a plain line as the name", func() {
+		Expect(parser.Parse("  Water the   plants ")).To(Equal(quickadd.Task{Name: "Water the plants"}))
+	})
+
+	It("returns ErrInvalidArgument when nothing is left for the name", func() {
+		Expect(parser.Parse("tomorrow #finance")).Error().To(Equal(errors.ErrInvalidArgument))
+	})
+
+	It("ignores the repeated tags", func() {
+		Expect(parser.Parse("Pay rent #finance #home #Finance")).To(HaveField("Tags", Equal([]string{"finance", "home"})))
+	})
+
+	It("keeps the unknown priorities in the name", func() {
+		Expect(parser.Parse("Shout !p7")).To(Equal(quickadd.Task{Name: "Shout !p7"}))
+	})
+
+	It("keeps the prepositions that are not followed by a date", func() {
+		Expect(parser.Parse("Meet at home at noon")).To(Equal(quickadd.Task{Name: "Meet at home", DueAt: at(17, 12, 0)}))
+	})
+
+	It("keeps the second date in the name", func() {
+		Expect(parser.Parse("Move the meeting from today to tomorrow")).
+			To(Equal(quickadd.Task{Name: "Move the meeting from to tomorrow", DueAt: at(17, 9, 0)}))
+	})
+
+	DescribeTable("parses the due date",
+		func(text string, expected *time.Time) {
+			Expect(parser.Parse("Task " + text)).To(HaveField("DueAt", Equal(expected)))
+		},
+		Entry("today", "today", at(17, 9, 0)),
+		Entry("tomorrow at a time", "tomorrow at 6:30pm", at(18, 18, 30)),
+		Entry("a time alone later today", "at 21:00", at(17, 21, 0)),
+		Entry("a time alone already passed", "8 am", at(18, 8, 0)),
+		Entry("noon", "by noon", at(17, 12, 0)),
+		Entry("a weekday", "on monday", at(20, 9, 0)),
+		Entry("the same weekday", "fri 5pm", at(24, 17, 0)),
+		Entry("next weekday", "next tuesday", at(21, 9, 0)),
+		Entry("next week", "next week", at(24, 9, 0)),
+		Entry("a number of days", "in 3 days", at(20, 9, 0)),
+		Entry("a number of weeks at a time", "in 2 weeks at 11am", at(31, 11, 0)),
+		Entry("a number of hours", "in 2 hours", at(17, 12, 15)),
+		Entry("a number of minutes", "in 30 min", at(17, 10, 45)),
+		Entry("an ISO date", "due 2023-03-29", at(29, 9, 0)),
+		Entry("a time before the date", "at 7am tomorrow", at(18, 7, 0)),
+		Entry("no date", "in the garden", nil),
+		Entry("an invalid time", "13pm", nil),
+	)
+
+})
@@ -0,0 +1,115 @@
+package rank
+
+import (
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+const (
+	digits    = "0123456789abcdefghijklmnopqrstuvwxyz"
+	minDigit  = '0'
+	maxDigit  = 'z'
+	nextWidth = 4
+)
+
+func First() string {
+	key, _ := Between("", "")
+	return key
+}
+
+func Before(key string) (string, error) {
+	return Between("", key)
+}
+
+func After(key string) (string, error) {
+	return Between(key, "")
+}
+
+func Next(key string) (string, error) {
+	if key == "" {
+		return First(), nil
+	}
+	if !isValid(key) {
+		return "", errors.ErrInvalidArgument
+	}
+
+	next := []byte(key)
+	for len(next) < nextWidth {
+		next = append(next, minDigit)
+	}
+
+	index := len(next) - 1
+	for index >= 0 && next[index] == maxDigit {
+		index--
+	}
+	if index < 0 {
+		return After(key)
+	}
+
+	next[index] = digits[strings.IndexByte(digits, next[index])+1]
+	return string(next[:index+1]), nil
+}
+
+func Between(lower string, upper string) (string, error) {
+	if !isValid(lower) || !isValid(upper) || (upper != "" && lower >= upper) {
+		return "", errors.ErrInvalidArgument
+	}
+	return midpoint(lower, upper), nil
+}
+
+func isValid(key string) bool {
+	if key == "" {
+		return true
+	}
+
+	for _, char := range key {
+		if !strings.ContainsRune(digits, char) {
+			return false
+		}
+	}
+	return key[len(key)-1] != minDigit
+}
+
+func midpoint(lower string, upper string) string {
+	if upper != "" {
+		n := 0
+		for n < len(upper) && digitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + midpoint(suffix(lower, n), upper[n:])
+		}
+	}
+
+	lowerDigit := 0
+	if lower != "" {
+		lowerDigit = strings.IndexByte(digits, lower[0])
+	}
+	upperDigit := len(digits)
+	if upper != "" {
+		upperDigit = strings.IndexByte(digits, upper[0])
+	}
+
+	if upperDigit-lowerDigit > 1 {
+		return string(digits[(lowerDigit+upperDigit+1)/2])
+	}
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(digits[lowerDigit]) + midpoint(suffix(lower, 1), "")
+}
+
+func digitAt(key string, index int) byte {
+	if index < len(key) {
+		return key[index]
+	}
+	return minDigit
+}
+
+func suffix(key string, start int) string {
+	if start < len(key) {
+		return key[start:]
+	}
+	return ""
+}
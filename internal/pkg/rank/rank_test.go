@@ -0,0 +1,106 @@
+package rank_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/rank"
+)
+
+var _ = Describe("Rank", func() {
+
+	Describe("First", func() {
+		It("returns the middle of the key space", func() {
+			Expect(rank.First()).To(Equal("i"))
+		})
+	})
+
+	Describe("Between", func() {
+		DescribeTable("returns a key strictly between the bounds",
+			func(lower string, upper string, expected string) {
+				key, err := rank.Between(lower, upper)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key).To(Equal(expected))
+				Expect(key > lower).To(BeTrue())
+				if upper != "" {
+					Expect(key < upper).To(BeTrue())
+				}
+			},
+			Entry("unbounded", "", "", "i"),
+			Entry("after a key", "i", "", "r"),
+			Entry("before a key", "", "i", "9"),
+			Entry("between distant keys", "a", "z", "n"),
+			Entry("between consecutive digits", "a", "b", "ai"),
+			Entry("between a key and its extension", "a", "a5", "a3"),
+			Entry("between keys sharing a prefix", "abc", "abd", "abci"),
+			Entry("before a key starting with the smallest digit", "", "01", "00i"),
+			Entry("after the largest digit", "z", "", "zi"),
+		)
+
+		DescribeTable("rejects invalid bounds",
+			func(lower string, upper string) {
+				Expect(rank.Between(lower, upper)).Error().To(Equal(errors.ErrInvalidArgument))
+			},
+			Entry("equal bounds", "a", "a"),
+			Entry("reversed bounds", "b", "a"),
+			Entry("unknown digits", "A", ""),
+			Entry("trailing smallest digit", "a0", ""),
+		)
+
+		It("keeps producing ordered keys when inserting repeatedly at the same position", func() {
+			lower, upper := "a", "b"
+			for i := 0; i < 100; i++ {
+				key, err := rank.Between(lower, upper)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key > lower && key < upper).To(BeTrue())
+				upper = key
+			}
+		})
+	})
+
+	Describe("Next", func() {
+		DescribeTable("returns the following key",
+			func(key string, expected string) {
+				Expect(rank.Next(key)).To(Equal(expected))
+			},
+			Entry("without key", "", "i"),
+			Entry("padding a short key", "i", "i001"),
+			Entry("incrementing the last digit", "i001", "i002"),
+			Entry("carrying", "i00z", "i01"),
+			Entry("carrying several digits", "izzz", "j"),
+			Entry("beyond the key space", "zzzz", "zzzzi"),
+		)
+
+		It("rejects an invalid key", func() {
+			Expect(rank.Next("a0")).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("keeps the keys ordered and bounded over many appends", func() {
+			key := rank.First()
+			for i := 0; i < 10000; i++ {
+				next, err := rank.Next(key)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next > key).To(BeTrue())
+				Expect(len(next)).To(BeNumerically("<=", 4))
+				key = next
+			}
+		})
+	})
+
+	Describe("Before and After", func() {
+		It("return keys on the expected side", func() {
+			before, err := rank.Before("m")
+			Expect(err).NotTo(HaveOccurred())
+			after, err := rank.After("m")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(before < "m").To(BeTrue())
+			Expect(after > "m").To(BeTrue())
+		})
+	})
+
+})
@@ -0,0 +1,113 @@
+package recurrence
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	"github.com/teambition/rrule-go"
+)
+
+const rrulePrefix = "RRULE:"
+
+type Recurrence interface {
+	Validate(rule string) error
+	Next(rule string, dtstart time.Time) (time.Time, string, error)
+	Preview(rule string, dtstart time.Time, count int) ([]time.Time, error)
+}
+
+type recurrenceImpl struct {
+	clock stubs.Clock
+}
+
+type RecurrenceOption func(*recurrenceImpl)
+
+func New(options ...RecurrenceOption) Recurrence {
+	instance := recurrenceImpl{
+		clock: stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithClock(clock stubs.Clock) RecurrenceOption {
+	return func(recurrence *recurrenceImpl) {
+		if recurrence != nil && clock != nil {
+			recurrence.clock = clock
+		}
+	}
+}
+
+func (recurrence *recurrenceImpl) Validate(rule string) error {
+	_, err := parse(rule)
+	return err
+}
+
+func (recurrence *recurrenceImpl) Next(rule string, dtstart time.Time) (time.Time, string, error) {
+	option, err := parse(rule)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	option.Dtstart = dtstart
+	rRule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return time.Time{}, "", errors.ErrInvalidArgument
+	}
+
+	next := rRule.After(dtstart, false)
+	if next.IsZero() {
+		return time.Time{}, "", errors.ErrNotFound
+	}
+
+	if option.Count > 0 {
+		option.Count--
+		rule = rrulePrefix + option.RRuleString()
+	}
+	return next, rule, nil
+}
+
+func (recurrence *recurrenceImpl) Preview(rule string, dtstart time.Time, count int) ([]time.Time, error) {
+	if count <= 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	option, err := parse(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	option.Dtstart = dtstart
+	rRule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	var occurrences []time.Time
+	next := rRule.After(recurrence.clock.Now(), true)
+	for !next.IsZero() && len(occurrences) < count {
+		occurrences = append(occurrences, next)
+		next = rRule.After(next, false)
+	}
+	return occurrences, nil
+}
+
+func parse(rule string) (*rrule.ROption, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || strings.ContainsAny(rule, "\r\n") {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	option, err := rrule.StrToROption(rule)
+	if err != nil || !option.Dtstart.IsZero() {
+		return nil, errors.ErrInvalidArgument
+	}
+	return option, nil
+}
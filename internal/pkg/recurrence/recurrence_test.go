@@ -0,0 +1,125 @@
+package recurrence_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/recurrence"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+)
+
+var _ = Describe("Recurrence", func() {
+
+	const day = 24 * time.Hour
+
+	var (
+		dtstart time.Time
+		rec     recurrence.Recurrence
+	)
+
+	BeforeEach(func() {
+		dtstart = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		rec = recurrence.New(recurrence.WithClock(stubs.NewFixed(dtstart.Add(3 * day))))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(recurrence.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Validate", func() {
+		DescribeTable("accepts valid rules",
+			func(rule string) {
+				Expect(rec.Validate(rule)).To(Succeed())
+			},
+			Entry("without prefix", "FREQ=WEEKLY;BYDAY=MO,TH"),
+			Entry("with prefix", "RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=4"),
+		)
+
+		DescribeTable("rejects invalid rules",
+			func(rule string) {
+				Expect(rec.Validate(rule)).To(Equal(errors.ErrInvalidArgument))
+			},
+			Entry("empty", "  "),
+			Entry("without frequency", "INTERVAL=2"),
+			Entry("with an unknown frequency", "FREQ=SOMETIMES"),
+			Entry("with an unknown property", "FREQ=DAILY;SOMETHING=1"),
+			Entry("with a start date", "DTSTART:20230306T090000Z\nRRULE:FREQ=DAILY"),
+		)
+	})
+
+	Describe("Next", func() {
+		When("the rule is invalid", func() {
+			It("returns ErrInvalidArgument", func() {
+				_, _, err := rec.Next("FREQ=SOMETIMES", dtstart)
+
+				Expect(err).To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		When("the rule is unbounded", func() {
+			It("returns the following occurrence and the same rule", func() {
+				next, rule, err := rec.Next("FREQ=WEEKLY;BYDAY=MO,TH", dtstart)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(Equal(dtstart.Add(3 * day)))
+				Expect(rule).To(Equal("FREQ=WEEKLY;BYDAY=MO,TH"))
+			})
+		})
+
+		When("the rule has a count", func() {
+			It("returns the following occurrence and a rule with one less occurrence", func() {
+				next, rule, err := rec.Next("FREQ=DAILY;INTERVAL=2;COUNT=3", dtstart)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(Equal(dtstart.Add(2 * day)))
+				Expect(rule).To(Equal("RRULE:FREQ=DAILY;INTERVAL=2;COUNT=2"))
+			})
+		})
+
+		When("the series is exhausted", func() {
+			It("returns ErrNotFound", func() {
+				_, _, err := rec.Next("FREQ=DAILY;COUNT=1", dtstart)
+
+				Expect(err).To(Equal(errors.ErrNotFound))
+			})
+		})
+	})
+
+	Describe("Preview", func() {
+		When("the count is not positive", func() {
+			It("returns ErrInvalidArgument", func() {
+				Expect(rec.Preview("FREQ=DAILY", dtstart, 0)).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		When("the rule is invalid", func() {
+			It("returns ErrInvalidArgument", func() {
+				Expect(rec.Preview("FREQ=SOMETIMES", dtstart, 2)).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		When("the series is unbounded", func() {
+			It("returns the requested number of occurrences starting from now", func() {
+				Expect(rec.Preview("FREQ=DAILY", dtstart, 2)).To(Equal([]time.Time{
+					dtstart.Add(3 * day),
+					dtstart.Add(4 * day),
+				}))
+			})
+		})
+
+		When("the series ends before the requested number of occurrences", func() {
+			It("returns the remaining occurrences", func() {
+				Expect(rec.Preview("FREQ=DAILY;COUNT=5", dtstart, 10)).To(Equal([]time.Time{
+					dtstart.Add(3 * day),
+					dtstart.Add(4 * day),
+				}))
+			})
+		})
+	})
+
+})
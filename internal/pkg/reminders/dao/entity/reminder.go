@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type Reminder struct {
+	Id         int                  `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	TaskId     int                  `json:"taskId" gorm:"column:task_id;type:int"`
+	TaskName   string               `json:"taskName" gorm:"column:task_name;type:varchar;size:255"`
+	DueAt      time.Time            `json:"dueAt" gorm:"column:due_at;type:timestamp"`
+	Offset     time.Duration        `json:"offset" gorm:"column:offset;type:bigint"`
+	FireAt     time.Time            `json:"fireAt" gorm:"column:fire_at;type:timestamp"`
+	Attempts   int                  `json:"attempts,omitempty" gorm:"column:attempts;type:int"`
+	Deliveries map[string]time.Time `json:"deliveries,omitempty" gorm:"column:deliveries;serializer:json"`
+	SentAt     *time.Time           `json:"sentAt,omitempty" gorm:"column:sent_at;type:timestamp"`
+	Tenant     string               `json:"tenant,omitempty" gorm:"column:tenant;type:varchar;size:63;index"`
+}
+
+func (reminder Reminder) IsSent() bool {
+	return reminder.SentAt != nil
+}
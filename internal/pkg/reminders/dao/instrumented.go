@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/metrics"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+)
+
+const repositoryName = "reminders"
+
+type instrumentedRepository struct {
+	repository Repository
+	observer   metrics.RepositoryObserver
+}
+
+func Instrument(repository Repository, observer metrics.RepositoryObserver) Repository {
+	if observer == nil {
+		return repository
+	}
+
+	return &instrumentedRepository{
+		repository: repository,
+		observer:   observer,
+	}
+}
+
+func (repo *instrumentedRepository) GetAll(ctx context.Context) ([]entity.Reminder, error) {
+	start := time.Now()
+	result, err := repo.repository.GetAll(ctx)
+	repo.observer.ObserveRepository(repositoryName, "GetAll", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Insert(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error) {
+	start := time.Now()
+	result, err := repo.repository.Insert(ctx, reminder)
+	repo.observer.ObserveRepository(repositoryName, "Insert", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Update(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error) {
+	start := time.Now()
+	result, err := repo.repository.Update(ctx, reminder)
+	repo.observer.ObserveRepository(repositoryName, "Update", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) RemoveById(ctx context.Context, id int) (entity.Reminder, error) {
+	start := time.Now()
+	result, err := repo.repository.RemoveById(ctx, id)
+	repo.observer.ObserveRepository(repositoryName, "RemoveById", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Flush() error {
+	start := time.Now()
+	err := repo.repository.Flush()
+	repo.observer.ObserveRepository(repositoryName, "Flush", time.Since(start), err)
+	return err
+}
@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao"
+	metricsMock "github.com/aeon-fruit/dalil.git/test/mocks/metrics"
+)
+
+var _ = Describe("Instrumented repository", func() {
+
+	var (
+		mockCtrl     *gomock.Controller
+		mockObserver *metricsMock.MockRepositoryObserver
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockObserver = metricsMock.NewMockRepositoryObserver(mockCtrl)
+	})
+
+	Describe("Instrument", func() {
+		It("returns the repository as is without observer", func() {
+			repo := repository.New()
+
+			Expect(repository.Instrument(repo, nil)).To(BeIdenticalTo(repo))
+		})
+
+		It("observes the operations and their errors", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "reminders.json")
+			Expect(os.WriteFile(path, []byte("not json"), 0o644)).To(Succeed())
+			repo := repository.Instrument(repository.New(repository.WithFile(path)), mockObserver)
+
+			mockObserver.EXPECT().ObserveRepository("reminders", "Flush", gomock.Any(), gomock.Not(gomock.Nil()))
+
+			Expect(repo.Flush()).NotTo(Succeed())
+		})
+	})
+
+})
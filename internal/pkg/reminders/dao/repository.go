@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]entity.Reminder, error)
+	Insert(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error)
+	Update(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error)
+	RemoveById(ctx context.Context, id int) (entity.Reminder, error)
+	Flush() error
+}
+
+type snapshot struct {
+	Seq       int               `json:"seq"`
+	Reminders []entity.Reminder `json:"reminders"`
+}
+
+type memoryRepository struct {
+	mutex     sync.RWMutex
+	reminders map[int]entity.Reminder
+	seq       int
+	path      string
+	err       error
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		reminders: map[int]entity.Reminder{},
+		seq:       0,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	if instance.path != "" {
+		instance.err = instance.load()
+	}
+
+	return &instance
+}
+
+func WithFile(path string) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.path = path
+		}
+	}
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Reminder, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return nil, repo.err
+	}
+
+	tenant := tenancy.Of(ctx)
+	return repo.sorted(func(reminder entity.Reminder) bool { return tenancy.Owns(tenant, reminder.Tenant) }), nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.Reminder{}, repo.err
+	}
+
+	reminder.Tenant = tenancy.Of(ctx)
+	reminder.Id, repo.seq = repo.seq, repo.seq+1
+	repo.reminders[reminder.Id] = reminder
+	if err := repo.save(); err != nil {
+		delete(repo.reminders, reminder.Id)
+		repo.seq--
+		return entity.Reminder{}, err
+	}
+	return reminder, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, reminder entity.Reminder) (entity.Reminder, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.Reminder{}, repo.err
+	}
+
+	oldReminder, found := repo.reminders[reminder.Id]
+	if !found || !tenancy.Owns(tenancy.Of(ctx), oldReminder.Tenant) {
+		return entity.Reminder{}, errors.ErrNotFound
+	}
+
+	reminder.Tenant = oldReminder.Tenant
+
+	repo.reminders[reminder.Id] = reminder
+	if err := repo.save(); err != nil {
+		repo.reminders[reminder.Id] = oldReminder
+		return entity.Reminder{}, err
+	}
+	return reminder, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Reminder, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.Reminder{}, repo.err
+	}
+
+	reminder, found := repo.reminders[id]
+	if !found || !tenancy.Owns(tenancy.Of(ctx), reminder.Tenant) {
+		return entity.Reminder{}, errors.ErrNotFound
+	}
+
+	delete(repo.reminders, id)
+	if err := repo.save(); err != nil {
+		repo.reminders[id] = reminder
+		return entity.Reminder{}, err
+	}
+	return reminder, nil
+}
+
+func (repo *memoryRepository) Flush() error {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return repo.err
+	}
+
+	return repo.save()
+}
+
+func (repo *memoryRepository) sorted(keep func(entity.Reminder) bool) []entity.Reminder {
+	var reminders []entity.Reminder
+	for _, reminder := range repo.reminders {
+		if keep(reminder) {
+			reminders = append(reminders, reminder)
+		}
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].Id < reminders[j].Id
+	})
+	return reminders
+}
+
+func (repo *memoryRepository) load() error {
+	state := snapshot{}
+	err := persistence.ReadJSON(repo.path, &state)
+	if err == errors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, reminder := range state.Reminders {
+		repo.reminders[reminder.Id] = reminder
+	}
+	repo.seq = state.Seq
+	return nil
+}
+
+func (repo *memoryRepository) save() error {
+	if repo.path == "" {
+		return nil
+	}
+
+	return persistence.WriteJSON(repo.path, snapshot{
+		Seq:       repo.seq,
+		Reminders: repo.sorted(func(entity.Reminder) bool { return true }),
+	})
+}
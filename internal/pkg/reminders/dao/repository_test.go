@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"context"
+
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var reminder entity.Reminder
+
+	BeforeEach(func() {
+		dueAt := time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		reminder = entity.Reminder{
+			TaskId:   3,
+			TaskName: "A task",
+			DueAt:    dueAt,
+			Offset:   time.Hour,
+			FireAt:   dueAt.Add(-time.Hour),
+		}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(repository.New()).NotTo(BeNil())
+		})
+	})
+
+	Context("in memory", func() {
+		var repo repository.Repository
+
+		BeforeEach(func() {
+			repo = repository.New()
+		})
+
+		It("assigns sequential ids on insert", func() {
+			first, err := repo.Insert(ctx, reminder)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, reminder)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first.Id).To(Equal(0))
+			Expect(second.Id).To(Equal(1))
+			Expect(repo.GetAll(ctx)).To(Equal([]entity.Reminder{first, second}))
+		})
+
+		It("updates existing reminders only", func() {
+			inserted, _ := repo.Insert(ctx, reminder)
+			inserted.Attempts = 2
+
+			Expect(repo.Update(ctx, inserted)).To(Equal(inserted))
+			Expect(repo.GetAll(ctx)).To(Equal([]entity.Reminder{inserted}))
+
+			inserted.Id = 10
+			Expect(repo.Update(ctx, inserted)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("removes existing reminders only", func() {
+			inserted, _ := repo.Insert(ctx, reminder)
+
+			Expect(repo.RemoveById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+			Expect(repo.RemoveById(ctx, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("flushes nothing", func() {
+			Expect(repo.Flush()).To(Succeed())
+		})
+	})
+
+	Context("backed by a file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "reminders.json")
+		})
+
+		It("survives a restart", func() {
+			repo := repository.New(repository.WithFile(path))
+			first, err := repo.Insert(ctx, reminder)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, reminder)
+			Expect(err).NotTo(HaveOccurred())
+			sentAt := reminder.FireAt
+			second.SentAt = &sentAt
+			second.Deliveries = map[string]time.Time{"log": sentAt}
+			_, err = repo.Update(ctx, second)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = repo.RemoveById(ctx, first.Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			restarted := repository.New(repository.WithFile(path))
+
+			Expect(restarted.GetAll(ctx)).To(Equal([]entity.Reminder{second}))
+			Expect(restarted.Insert(ctx, reminder)).To(HaveField("Id", 2))
+		})
+
+		It("writes the reminders to the file on flush", func() {
+			repo := repository.New(repository.WithFile(path))
+			inserted, err := repo.Insert(ctx, reminder)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Remove(path)).To(Succeed())
+
+			Expect(repo.Flush()).To(Succeed())
+
+			Expect(repository.New(repository.WithFile(path)).GetAll(ctx)).To(Equal([]entity.Reminder{inserted}))
+		})
+
+		When("the file cannot be loaded", func() {
+			It("fails every operation", func() {
+				Expect(os.WriteFile(path, []byte("not json"), 0o644)).To(Succeed())
+
+				repo := repository.New(repository.WithFile(path))
+
+				Expect(repo.GetAll(ctx)).Error().To(HaveOccurred())
+				Expect(repo.Insert(ctx, reminder)).Error().To(HaveOccurred())
+				Expect(repo.Update(ctx, reminder)).Error().To(HaveOccurred())
+				Expect(repo.RemoveById(ctx, 0)).Error().To(HaveOccurred())
+				Expect(repo.Flush()).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the reminders of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+			repo := repository.New()
+			inserted, _ := repo.Insert(acme, reminder)
+
+			Expect(inserted.Tenant).To(Equal("acme"))
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+			Expect(repo.Update(ctx, inserted)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.RemoveById(ctx, inserted.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(acme)).To(HaveExactElements(inserted))
+		})
+
+		It("assigns the reminders without tenant to the default tenant", func() {
+			repo := repository.New()
+			inserted, _ := repo.Insert(ctx, reminder)
+			inserted.Tenant = ""
+
+			Expect(repo.Update(tenancy.With(ctx, tenancy.DefaultTenant), inserted)).To(HaveField("Tenant", tenancy.DefaultTenant))
+		})
+	})
+
+})
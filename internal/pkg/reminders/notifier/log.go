@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/go-logr/logr"
+)
+
+type logNotifier struct {
+	logger logr.Logger
+}
+
+func NewLog(logger logr.Logger) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+func (notifier *logNotifier) Name() string {
+	return "log"
+}
+
+func (notifier *logNotifier) Notify(_ context.Context, reminder entity.Reminder) error {
+	msg := newMessage(reminder)
+	notifier.logger.Info(msg.subject(),
+		"taskId", msg.TaskId,
+		"dueAt", msg.DueAt,
+		"offset", msg.Offset)
+	return nil
+}
@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+)
+
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, reminder entity.Reminder) error
+}
+
+type message struct {
+	TaskId   int       `json:"taskId"`
+	TaskName string    `json:"taskName"`
+	DueAt    time.Time `json:"dueAt"`
+	Offset   string    `json:"offset"`
+}
+
+func newMessage(reminder entity.Reminder) message {
+	return message{
+		TaskId:   reminder.TaskId,
+		TaskName: reminder.TaskName,
+		DueAt:    reminder.DueAt,
+		Offset:   reminder.Offset.String(),
+	}
+}
+
+func (msg message) subject() string {
+	return fmt.Sprintf("Reminder: %s", msg.TaskName)
+}
+
+func (msg message) text() string {
+	return fmt.Sprintf("Task #%d %q is due at %s (in %s).",
+		msg.TaskId, msg.TaskName, msg.DueAt.Format(time.RFC3339), msg.Offset)
+}
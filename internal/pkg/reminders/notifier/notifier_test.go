@@ -0,0 +1,191 @@
+package notifier_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/notifier"
+)
+
+type smtpServer struct {
+	listener net.Listener
+	messages chan string
+}
+
+func newSmtpServer() *smtpServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	server := &smtpServer{listener: listener, messages: make(chan string, 1)}
+	go server.serve()
+	return server
+}
+
+func (server *smtpServer) serve() {
+	conn, err := server.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP")
+	var data strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL"), strings.HasPrefix(command, "RCPT"):
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 Go ahead")
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			server.messages <- data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Not implemented")
+		}
+	}
+}
+
+var _ = Describe("Notifier", func() {
+
+	var (
+		ctx      context.Context
+		reminder entity.Reminder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dueAt := time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		reminder = entity.Reminder{
+			Id:       1,
+			TaskId:   7,
+			TaskName: "Water the plants",
+			DueAt:    dueAt,
+			Offset:   time.Hour,
+			FireAt:   dueAt.Add(-time.Hour),
+		}
+	})
+
+	Describe("Webhook", func() {
+		It("has a name", func() {
+			Expect(notifier.NewWebhook("http://url").Name()).To(Equal("webhook"))
+		})
+
+		When("the endpoint accepts the notification", func() {
+			It("posts the reminder as JSON", func() {
+				var payload map[string]any
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.Method).To(Equal(http.MethodPost))
+					Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+					Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+					w.WriteHeader(http.StatusAccepted)
+				}))
+				defer server.Close()
+
+				err := notifier.NewWebhook(server.URL, notifier.WithHttpClient(server.Client())).Notify(ctx, reminder)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(payload).To(Equal(map[string]any{
+					"taskId":   7.,
+					"taskName": "Water the plants",
+					"dueAt":    "2023-03-06T09:00:00Z",
+					"offset":   "1h0m0s",
+				}))
+			})
+		})
+
+		When("the endpoint rejects the notification", func() {
+			It("returns an error", func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadGateway)
+				}))
+				defer server.Close()
+
+				Expect(notifier.NewWebhook(server.URL).Notify(ctx, reminder)).To(MatchError(ContainSubstring("502")))
+			})
+		})
+	})
+
+	Describe("Smtp", func() {
+		It("has a name", func() {
+			Expect(notifier.NewSmtp("localhost:25", "dalil@localhost", nil).Name()).To(Equal("smtp"))
+		})
+
+		When("the server accepts the mail", func() {
+			It("sends the reminder to the recipients", func() {
+				server := newSmtpServer()
+				defer server.listener.Close()
+
+				smtp := notifier.NewSmtp(server.listener.Addr().String(), "dalil@localhost", []string{"me@localhost"})
+
+				Expect(smtp.Notify(ctx, reminder)).To(Succeed())
+
+				var message string
+				Eventually(server.messages).Should(Receive(&message))
+				Expect(message).To(ContainSubstring("To: me@localhost"))
+				Expect(message).To(ContainSubstring("Subject: Reminder: Water the plants"))
+				Expect(message).To(ContainSubstring("2023-03-06T09:00:00Z"))
+			})
+		})
+
+		When("the server is unreachable", func() {
+			It("returns an error", func() {
+				server := newSmtpServer()
+				addr := server.listener.Addr().String()
+				server.listener.Close()
+
+				Expect(notifier.NewSmtp(addr, "dalil@localhost", []string{"me@localhost"}).Notify(ctx, reminder)).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("Log", func() {
+		It("logs the reminder", func() {
+			var lines []string
+			logger := funcr.New(func(prefix, args string) {
+				lines = append(lines, args)
+			}, funcr.Options{})
+
+			log := notifier.NewLog(logger)
+
+			Expect(log.Name()).To(Equal("log"))
+			Expect(log.Notify(ctx, reminder)).To(Succeed())
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0]).To(ContainSubstring(`"msg"="Reminder: Water the plants"`))
+			Expect(lines[0]).To(ContainSubstring(`"taskId"=7`))
+		})
+	})
+
+})
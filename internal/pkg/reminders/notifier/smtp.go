@@ -0,0 +1,68 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+)
+
+type smtpNotifier struct {
+	addr string
+	from string
+	to   []string
+	auth smtp.Auth
+}
+
+type SmtpOption func(*smtpNotifier)
+
+func NewSmtp(addr string, from string, to []string, options ...SmtpOption) Notifier {
+	instance := smtpNotifier{
+		addr: addr,
+		from: from,
+		to:   to,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithCredentials(username string, password string) SmtpOption {
+	return func(notifier *smtpNotifier) {
+		if notifier != nil && username != "" {
+			host, _, _ := net.SplitHostPort(notifier.addr)
+			notifier.auth = smtp.PlainAuth("", username, password, host)
+		}
+	}
+}
+
+func (notifier *smtpNotifier) Name() string {
+	return "smtp"
+}
+
+func (notifier *smtpNotifier) Notify(ctx context.Context, reminder entity.Reminder) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg := newMessage(reminder)
+	content := strings.Join([]string{
+		fmt.Sprintf("From: %s", notifier.from),
+		fmt.Sprintf("To: %s", strings.Join(notifier.to, ", ")),
+		fmt.Sprintf("Subject: %s", msg.subject()),
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		msg.text(),
+		"",
+	}, "\r\n")
+
+	return smtp.SendMail(notifier.addr, notifier.auth, notifier.from, notifier.to, []byte(content))
+}
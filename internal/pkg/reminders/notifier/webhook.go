@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+)
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+type WebhookOption func(*webhookNotifier)
+
+func NewWebhook(url string, options ...WebhookOption) Notifier {
+	instance := webhookNotifier{
+		url:    url,
+		client: http.DefaultClient,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithHttpClient(client *http.Client) WebhookOption {
+	return func(notifier *webhookNotifier) {
+		if notifier != nil && client != nil {
+			notifier.client = client
+		}
+	}
+}
+
+func (notifier *webhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (notifier *webhookNotifier) Notify(ctx context.Context, reminder entity.Reminder) error {
+	body, err := json.Marshal(newMessage(reminder))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notifier.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
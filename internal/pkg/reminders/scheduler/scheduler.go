@@ -0,0 +1,290 @@
+package scheduler
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"sync"
+	"time"
+
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/notifier"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	taskEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	tasksService "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultInterval    = time.Minute
+	defaultMaxAttempts = 5
+)
+
+type Scheduler interface {
+	Start(ctx context.Context)
+	Tick(ctx context.Context) error
+	Check(ctx context.Context) error
+}
+
+type schedulerImpl struct {
+	tasks       tasksService.Service
+	repository  dao.Repository
+	notifiers   []notifier.Notifier
+	offsets     []time.Duration
+	interval    time.Duration
+	maxAttempts int
+	tenants     tenancy.Registry
+	clock       stubs.Clock
+	mutex       sync.RWMutex
+	lastErr     error
+}
+
+type SchedulerOption func(*schedulerImpl)
+
+type reminderKey struct {
+	taskId int
+	dueAt  int64
+	offset time.Duration
+}
+
+func New(options ...SchedulerOption) Scheduler {
+	instance := schedulerImpl{
+		interval:    defaultInterval,
+		maxAttempts: defaultMaxAttempts,
+		clock:       stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTasks(tasks tasksService.Service) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil {
+			scheduler.tasks = tasks
+		}
+	}
+}
+
+func WithRepository(repository dao.Repository) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil {
+			scheduler.repository = repository
+		}
+	}
+}
+
+func WithNotifiers(notifiers ...notifier.Notifier) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil {
+			scheduler.notifiers = append(scheduler.notifiers, notifiers...)
+		}
+	}
+}
+
+func WithOffsets(offsets ...time.Duration) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil {
+			scheduler.offsets = offsets
+		}
+	}
+}
+
+func WithInterval(interval time.Duration) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil && interval > 0 {
+			scheduler.interval = interval
+		}
+	}
+}
+
+func WithMaxAttempts(maxAttempts int) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil && maxAttempts > 0 {
+			scheduler.maxAttempts = maxAttempts
+		}
+	}
+}
+
+func WithTenants(tenants tenancy.Registry) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil {
+			scheduler.tenants = tenants
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) SchedulerOption {
+	return func(scheduler *schedulerImpl) {
+		if scheduler != nil && clock != nil {
+			scheduler.clock = clock
+		}
+	}
+}
+
+func (scheduler *schedulerImpl) Start(ctx context.Context) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	go func() {
+		ticker := time.NewTicker(scheduler.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := scheduler.Tick(ctx); err != nil {
+				logger.Error(err, "Reminders tick failed")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (scheduler *schedulerImpl) Tick(ctx context.Context) error {
+	err := tenancy.Each(ctx, scheduler.tenants, scheduler.tick)
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	scheduler.lastErr = err
+	return err
+}
+
+func (scheduler *schedulerImpl) Check(context.Context) error {
+	scheduler.mutex.RLock()
+	defer scheduler.mutex.RUnlock()
+
+	return scheduler.lastErr
+}
+
+func (scheduler *schedulerImpl) tick(ctx context.Context) error {
+	now := scheduler.clock.Now()
+
+	tasks, err := scheduler.tasks.GetAll(ctx, model.GetTasksRequest{})
+	if err != nil {
+		return err
+	}
+
+	if err = scheduler.schedule(ctx, tasks, now); err != nil {
+		return err
+	}
+
+	reminders, err := scheduler.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := logr.FromContextOrDiscard(ctx)
+	for _, reminder := range reminders {
+		if reminder.IsSent() || reminder.FireAt.After(now) {
+			continue
+		}
+
+		if err = scheduler.fire(ctx, reminder, now); err != nil {
+			logger.Error(err, "Reminder dispatch failed", "reminderId", reminder.Id, "taskId", reminder.TaskId)
+		}
+	}
+	return nil
+}
+
+func (scheduler *schedulerImpl) schedule(ctx context.Context, tasks []model.GetTaskResponse, now time.Time) error {
+	live := map[reminderKey]model.GetTaskResponse{}
+	for _, task := range tasks {
+		if task.DueAt == nil || taskEntity.IsDone(task.StatusId) {
+			continue
+		}
+		for _, offset := range scheduler.offsets {
+			live[reminderKey{taskId: task.Id, dueAt: task.DueAt.UnixNano(), offset: offset}] = task
+		}
+	}
+
+	reminders, err := scheduler.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, reminder := range reminders {
+		key := reminderKey{taskId: reminder.TaskId, dueAt: reminder.DueAt.UnixNano(), offset: reminder.Offset}
+		if _, found := live[key]; found {
+			delete(live, key)
+			continue
+		}
+		if _, err = scheduler.repository.RemoveById(ctx, reminder.Id); err != nil {
+			return err
+		}
+	}
+
+	for key, task := range live {
+		if !task.DueAt.After(now) || scheduler.isSuperseded(key.offset, task.DueAt.Sub(now)) {
+			continue
+		}
+
+		_, err = scheduler.repository.Insert(ctx, entity.Reminder{
+			TaskId:   task.Id,
+			TaskName: task.Name,
+			DueAt:    *task.DueAt,
+			Offset:   key.offset,
+			FireAt:   task.DueAt.Add(-key.offset),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (scheduler *schedulerImpl) isSuperseded(offset time.Duration, remaining time.Duration) bool {
+	if offset < remaining {
+		return false
+	}
+
+	for _, other := range scheduler.offsets {
+		if other >= remaining && other < offset {
+			return true
+		}
+	}
+	return false
+}
+
+func (scheduler *schedulerImpl) fire(ctx context.Context, reminder entity.Reminder, now time.Time) error {
+	deliveries := map[string]time.Time{}
+	for name, deliveredAt := range reminder.Deliveries {
+		deliveries[name] = deliveredAt
+	}
+
+	var errs []error
+	for _, n := range scheduler.notifiers {
+		if _, delivered := deliveries[n.Name()]; delivered {
+			continue
+		}
+
+		if err := n.Notify(ctx, reminder); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+			continue
+		}
+		deliveries[n.Name()] = now
+	}
+
+	reminder.Attempts++
+	reminder.Deliveries = deliveries
+	if len(errs) == 0 || reminder.Attempts >= scheduler.maxAttempts {
+		sentAt := now
+		reminder.SentAt = &sentAt
+	}
+
+	if _, err := scheduler.repository.Update(ctx, reminder); err != nil {
+		errs = append(errs, err)
+	}
+	return goErrors.Join(errs...)
+}
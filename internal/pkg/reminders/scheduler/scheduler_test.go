@@ -0,0 +1,282 @@
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/reminders/scheduler"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	taskEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+)
+
+type recordingNotifier struct {
+	name     string
+	failures int
+	notified []entity.Reminder
+}
+
+func (n *recordingNotifier) Name() string {
+	return n.name
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, reminder entity.Reminder) error {
+	if n.failures > 0 {
+		n.failures--
+		return fmt.Errorf("%s is down", n.name)
+	}
+	n.notified = append(n.notified, reminder)
+	return nil
+}
+
+var _ = Describe("Scheduler", func() {
+
+	const taskId = 4
+
+	var (
+		ctx         context.Context
+		dueAt       time.Time
+		clock       *stubs.FixedClock
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		repo        repository.Repository
+		webhook     *recordingNotifier
+		log         *recordingNotifier
+		sched       scheduler.Scheduler
+	)
+
+	task := func(statusId int, dueAt time.Time) model.GetTaskResponse {
+		return model.GetTaskResponse{Id: taskId, Name: "Pay rent", StatusId: statusId, DueAt: &dueAt}
+	}
+
+	newScheduler := func() scheduler.Scheduler {
+		return scheduler.New(
+			scheduler.WithTasks(mockService),
+			scheduler.WithRepository(repo),
+			scheduler.WithNotifiers(log, webhook),
+			scheduler.WithOffsets(24*time.Hour, time.Hour),
+			scheduler.WithMaxAttempts(2),
+			scheduler.WithClock(clock),
+		)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dueAt = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		clock = stubs.NewFixed(dueAt.Add(-48 * time.Hour))
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		repo = repository.New()
+		webhook = &recordingNotifier{name: "webhook"}
+		log = &recordingNotifier{name: "log"}
+		sched = newScheduler()
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(scheduler.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Tick", func() {
+		When("the tasks cannot be retrieved", func() {
+			It("returns the error", func() {
+				customErr := fmt.Errorf("custom error")
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, customErr)
+
+				Expect(sched.Tick(ctx)).To(Equal(customErr))
+			})
+		})
+
+		When("a task has a due date", func() {
+			BeforeEach(func() {
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil).AnyTimes()
+			})
+
+			It("schedules a reminder per offset without firing them early", func() {
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				reminders, err := repo.GetAll(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reminders).To(HaveLen(2))
+				Expect([]time.Time{reminders[0].FireAt, reminders[1].FireAt}).To(ConsistOf(
+					dueAt.Add(-24*time.Hour), dueAt.Add(-time.Hour)))
+				Expect(log.notified).To(BeEmpty())
+			})
+
+			It("fires each reminder once when its time comes", func() {
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				clock.Set(dueAt.Add(-23 * time.Hour))
+				Expect(sched.Tick(ctx)).To(Succeed())
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(log.notified).To(HaveLen(1))
+				Expect(log.notified[0].Offset).To(Equal(24 * time.Hour))
+				Expect(webhook.notified).To(HaveLen(1))
+
+				clock.Set(dueAt.Add(-30 * time.Minute))
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(log.notified).To(HaveLen(2))
+				Expect(log.notified[1].Offset).To(Equal(time.Hour))
+			})
+
+			It("retries failed notifiers only, until the maximum number of attempts", func() {
+				webhook.failures = 5
+				clock.Set(dueAt.Add(-30 * time.Minute))
+
+				Expect(sched.Tick(ctx)).To(Succeed())
+				Expect(sched.Tick(ctx)).To(Succeed())
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(log.notified).To(HaveLen(1))
+				Expect(webhook.notified).To(BeEmpty())
+				Expect(webhook.failures).To(Equal(3))
+
+				reminders, _ := repo.GetAll(ctx)
+				Expect(reminders).To(HaveLen(1))
+				Expect(reminders[0].Attempts).To(Equal(2))
+				Expect(reminders[0].IsSent()).To(BeTrue())
+			})
+
+			It("resumes pending reminders after a restart", func() {
+				repo = repository.New(repository.WithFile(filepath.Join(GinkgoT().TempDir(), "reminders.json")))
+				Expect(newScheduler().Tick(ctx)).To(Succeed())
+
+				clock.Set(dueAt.Add(-30 * time.Minute))
+				Expect(newScheduler().Tick(ctx)).To(Succeed())
+
+				Expect(log.notified).To(HaveLen(2))
+			})
+		})
+
+		When("a task is done or has a new due date", func() {
+			It("drops its stale reminders", func() {
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil)
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				newDueAt := dueAt.Add(72 * time.Hour)
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, newDueAt)}, nil)
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				reminders, _ := repo.GetAll(ctx)
+				Expect(reminders).To(HaveLen(2))
+				for _, reminder := range reminders {
+					Expect(reminder.DueAt).To(Equal(newDueAt))
+				}
+
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdDone, newDueAt)}, nil)
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(repo.GetAll(ctx)).To(BeEmpty())
+			})
+		})
+
+		When("a task is scheduled after some of its reminders are due", func() {
+			It("only schedules the closest reminder", func() {
+				clock.Set(dueAt.Add(-30 * time.Minute))
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil)
+
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(log.notified).To(HaveLen(1))
+				Expect(log.notified[0].Offset).To(Equal(time.Hour))
+			})
+		})
+
+		When("a task is already overdue", func() {
+			It("doesn't schedule reminders", func() {
+				clock.Set(dueAt.Add(time.Minute))
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil)
+
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				Expect(repo.GetAll(ctx)).To(BeEmpty())
+				Expect(log.notified).To(BeEmpty())
+			})
+		})
+
+		When("there are several tenants", func() {
+			It("schedules the reminders of each tenant in its own scope", func() {
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, _ model.GetTasksRequest) ([]model.GetTaskResponse, error) {
+						if tenancy.Of(ctx) == "acme" {
+							return []model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil
+						}
+						return nil, nil
+					}).Times(2)
+
+				sched = scheduler.New(
+					scheduler.WithTasks(mockService),
+					scheduler.WithRepository(repo),
+					scheduler.WithOffsets(time.Hour),
+					scheduler.WithTenants(tenancy.NewRegistry("acme")),
+					scheduler.WithClock(clock),
+				)
+
+				Expect(sched.Tick(ctx)).To(Succeed())
+
+				reminders, err := repo.GetAll(tenancy.With(ctx, "acme"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reminders).To(HaveLen(1))
+				Expect(reminders[0].Tenant).To(Equal("acme"))
+				Expect(repo.GetAll(ctx)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Check", func() {
+		It("succeeds before the first tick", func() {
+			Expect(sched.Check(ctx)).To(Succeed())
+		})
+
+		It("returns the error of the last tick until a tick succeeds", func() {
+			customErr := fmt.Errorf("custom error")
+			gomock.InOrder(
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, customErr),
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil),
+			)
+
+			Expect(sched.Tick(ctx)).To(Equal(customErr))
+			Expect(sched.Check(ctx)).To(Equal(customErr))
+
+			Expect(sched.Tick(ctx)).To(Succeed())
+			Expect(sched.Check(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("Start", func() {
+		It("ticks until the context is cancelled", func() {
+			clock.Set(dueAt.Add(-30 * time.Minute))
+			mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]model.GetTaskResponse{task(taskEntity.StatusIdTodo, dueAt)}, nil).MinTimes(1)
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			scheduler.New(
+				scheduler.WithTasks(mockService),
+				scheduler.WithRepository(repo),
+				scheduler.WithOffsets(time.Hour),
+				scheduler.WithInterval(time.Millisecond),
+				scheduler.WithClock(clock),
+			).Start(ctx)
+
+			Eventually(func() bool {
+				reminders, _ := repo.GetAll(ctx)
+				return len(reminders) == 1 && reminders[0].IsSent()
+			}).Should(BeTrue())
+		})
+	})
+
+})
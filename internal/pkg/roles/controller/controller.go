@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/roles/service"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByListFailed   = "GetByList failed"
+	getByListResponse = "GetByList response"
+	grantFailed       = "Grant failed"
+	grantResponse     = "Grant response"
+	revokeFailed      = "Revoke failed"
+
+	viewerRequired = "The operation requires the viewer role on the list"
+	ownerRequired  = "The operation requires the owner role on the list"
+	invalidUser    = "The user does not exist or is inactive"
+	lastOwner      = "The list must keep at least one owner"
+)
+
+type Controller interface {
+	GetByList(w http.ResponseWriter, r *http.Request)
+	Grant(w http.ResponseWriter, r *http.Request)
+	Revoke(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetByList(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	listId, stop := common.GetPathParamOrStop(w, r, constants.ListId)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.GetByList(r.Context(), listId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByListFailed, constants.ListId, listId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(entity) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getByListResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Grant(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	listId, stop := common.GetPathParamOrStop(w, r, constants.ListId)
+	if stop {
+		return
+	}
+
+	userId, stop := common.GetPathParamOrStop(w, r, constants.UserId)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetRequestOrStop[model.GrantRoleRequest](w, r, grantFailed)
+	if stop {
+		return
+	}
+
+	if !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, grantFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	entity, err := ctrl.service.Grant(r.Context(), listId, userId, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrNotModified {
+			w.WriteHeader(http.StatusNotModified)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, invalidUser))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, ownerRequired))
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, lastOwner))
+		} else {
+			logger.Error(err, grantFailed, constants.ListId, listId, constants.UserId, userId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(grantResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Revoke(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	listId, stop := common.GetPathParamOrStop(w, r, constants.ListId)
+	if stop {
+		return
+	}
+
+	userId, stop := common.GetPathParamOrStop(w, r, constants.UserId)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.Revoke(r.Context(), listId, userId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, ownerRequired))
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, lastOwner))
+		} else {
+			logger.Error(err, revokeFailed, constants.ListId, listId, constants.UserId, userId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
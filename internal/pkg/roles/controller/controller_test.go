@@ -0,0 +1,140 @@
+package controller_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/roles/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		rolesCtrl   controller.Controller
+	)
+
+	withIds := func(request *http.Request, listId string, userId string) *http.Request {
+		ctx := reqctx.SetPathParam(request.Context(), constants.ListId, listId)
+		if userId != "" {
+			ctx = reqctx.SetPathParam(ctx, constants.UserId, userId)
+		}
+		return request.WithContext(ctx)
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		rolesCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByList", func() {
+		It("responds with the grants of the list", func() {
+			mockService.EXPECT().GetByList(gomock.Any(), 1).
+				Return([]model.GetGrantResponse{{ListId: 1, UserId: 2, Role: "editor"}}, nil)
+
+			rolesCtrl.GetByList(recorder, withIds(httptest.NewRequest("", url, nil), "1", ""))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"role":"editor"`))
+		})
+
+		DescribeTable("maps the service errors",
+			func(grants []model.GetGrantResponse, err error, code int) {
+				mockService.EXPECT().GetByList(gomock.Any(), 1).Return(grants, err)
+
+				rolesCtrl.GetByList(recorder, withIds(httptest.NewRequest("", url, nil), "1", ""))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("no grants", nil, nil, http.StatusNoContent),
+			Entry("not found", nil, errors.ErrNotFound, http.StatusNotFound),
+			Entry("forbidden", nil, errors.ErrForbidden, http.StatusForbidden),
+			Entry("unexpected", nil, fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status InternalServerError without list id", func() {
+			rolesCtrl.GetByList(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("Grant", func() {
+		It("responds with status BadRequest for an unknown role", func() {
+			rolesCtrl.Grant(recorder, withIds(httptest.NewRequest("", url, strings.NewReader(`{"role":"admin"}`)), "1", "2"))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status BadRequest for a malformed body", func() {
+			rolesCtrl.Grant(recorder, withIds(httptest.NewRequest("", url, strings.NewReader(`{`)), "1", "2"))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status InternalServerError without user id", func() {
+			rolesCtrl.Grant(recorder, withIds(httptest.NewRequest("", url, strings.NewReader(`{"role":"viewer"}`)), "1", ""))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Grant(gomock.Any(), 1, 2, model.GrantRoleRequest{Role: "editor"}).
+					Return(model.GetGrantResponse{ListId: 1, UserId: 2, Role: "editor"}, err)
+
+				rolesCtrl.Grant(recorder, withIds(httptest.NewRequest("", url, strings.NewReader(`{"role":"editor"}`)), "1", "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("granted", nil, http.StatusOK),
+			Entry("same role", errors.ErrNotModified, http.StatusNotModified),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("invalid user", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("last owner", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("Revoke", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Revoke(gomock.Any(), 1, 2).Return(err)
+
+				rolesCtrl.Revoke(recorder, withIds(httptest.NewRequest("", url, nil), "1", "2"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("revoked", nil, http.StatusNoContent),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("last owner", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+})
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+type Grant struct {
+	ListId    int       `json:"listId" gorm:"column:list_id;type:int;primaryKey"`
+	UserId    int       `json:"userId" gorm:"column:user_id;type:int;primaryKey"`
+	Role      Role      `json:"role" gorm:"column:role;type:varchar;size:16"`
+	CreatedAt time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+}
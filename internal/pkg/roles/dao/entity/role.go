@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+type Role string
+
+const (
+	RoleViewer = Role("viewer")
+	RoleEditor = Role("editor")
+	RoleOwner  = Role("owner")
+)
+
+func ParseRole(value string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(value)))
+	if role.level() == 0 {
+		return "", errors.ErrInvalidArgument
+	}
+	return role, nil
+}
+
+func (role Role) Includes(other Role) bool {
+	return role.level() > 0 && role.level() >= other.level()
+}
+
+func (role Role) level() int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleOwner:
+		return 3
+	}
+	return 0
+}
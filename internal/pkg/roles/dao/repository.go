@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]entity.Grant, error)
+	GetByList(ctx context.Context, listId int) ([]entity.Grant, error)
+	Upsert(ctx context.Context, grant entity.Grant) (entity.Grant, error)
+	Remove(ctx context.Context, listId int, userId int) (entity.Grant, error)
+	RemoveList(ctx context.Context, listId int) error
+}
+
+type member struct {
+	listId int
+	userId int
+}
+
+type partition struct {
+	grants map[member]entity.Grant
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithGrants(grants ...entity.Grant) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			for _, grant := range grants {
+				partition.grants[member{grant.ListId, grant.UserId}] = grant
+			}
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		grants: map[member]entity.Grant{},
+	}
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Grant, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	return partition.sorted(func(entity.Grant) bool { return true }), nil
+}
+
+func (repo *memoryRepository) GetByList(ctx context.Context, listId int) ([]entity.Grant, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	return partition.sorted(func(grant entity.Grant) bool { return grant.ListId == listId }), nil
+}
+
+func (repo *memoryRepository) Upsert(ctx context.Context, grant entity.Grant) (entity.Grant, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	key := member{grant.ListId, grant.UserId}
+	grant.UpdatedAt = time.Now()
+	grant.CreatedAt = grant.UpdatedAt
+	if oldGrant, found := partition.grants[key]; found {
+		if oldGrant.Role == grant.Role {
+			return entity.Grant{}, errors.ErrNotModified
+		}
+		grant.CreatedAt = oldGrant.CreatedAt
+	}
+
+	partition.grants[key] = grant
+	return grant, nil
+}
+
+func (repo *memoryRepository) Remove(ctx context.Context, listId int, userId int) (entity.Grant, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	key := member{listId, userId}
+	grant, found := partition.grants[key]
+	if !found {
+		return entity.Grant{}, errors.ErrNotFound
+	}
+	delete(partition.grants, key)
+	return grant, nil
+}
+
+func (repo *memoryRepository) RemoveList(ctx context.Context, listId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for key := range partition.grants {
+		if key.listId == listId {
+			delete(partition.grants, key)
+		}
+	}
+	return nil
+}
+
+func (partition *partition) sorted(keep func(entity.Grant) bool) []entity.Grant {
+	var grants []entity.Grant
+	for _, grant := range partition.grants {
+		if keep(grant) {
+			grants = append(grants, grant)
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].ListId != grants[j].ListId {
+			return grants[i].ListId < grants[j].ListId
+		}
+		return grants[i].UserId < grants[j].UserId
+	})
+	return grants
+}
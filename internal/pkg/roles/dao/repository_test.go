@@ -0,0 +1,145 @@
+package repository_test
+
+import (
+	"context"
+
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		createdAt time.Time
+		repo      repository.Repository
+	)
+
+	BeforeEach(func() {
+		createdAt = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		repo = repository.New(repository.WithGrants(
+			entity.Grant{ListId: 2, UserId: 1, Role: entity.RoleOwner, CreatedAt: createdAt},
+			entity.Grant{ListId: 1, UserId: 3, Role: entity.RoleViewer, CreatedAt: createdAt},
+			entity.Grant{ListId: 1, UserId: 1, Role: entity.RoleOwner, CreatedAt: createdAt},
+		))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(repository.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns the grants sorted by list then user", func() {
+			grants, err := repo.GetAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grants).To(HaveExactElements(
+				And(HaveField("ListId", 1), HaveField("UserId", 1)),
+				And(HaveField("ListId", 1), HaveField("UserId", 3)),
+				And(HaveField("ListId", 2), HaveField("UserId", 1)),
+			))
+		})
+	})
+
+	Describe("GetByList", func() {
+		It("returns the grants of the list", func() {
+			Expect(repo.GetByList(ctx, 1)).To(HaveExactElements(HaveField("UserId", 1), HaveField("UserId", 3)))
+		})
+
+		It("returns nothing for a list without grants", func() {
+			Expect(repo.GetByList(ctx, 3)).To(BeEmpty())
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("adds a new grant", func() {
+			grant, err := repo.Upsert(ctx, entity.Grant{ListId: 2, UserId: 3, Role: entity.RoleEditor})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grant.CreatedAt).NotTo(BeZero())
+			Expect(grant.UpdatedAt).To(Equal(grant.CreatedAt))
+			Expect(repo.GetByList(ctx, 2)).To(HaveLen(2))
+		})
+
+		It("changes the role of an existing grant and keeps its creation date", func() {
+			grant, err := repo.Upsert(ctx, entity.Grant{ListId: 1, UserId: 3, Role: entity.RoleEditor})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grant.Role).To(Equal(entity.RoleEditor))
+			Expect(grant.CreatedAt).To(Equal(createdAt))
+			Expect(grant.UpdatedAt).To(BeTemporally(">", createdAt))
+		})
+
+		It("returns ErrNotModified for the same role", func() {
+			Expect(repo.Upsert(ctx, entity.Grant{ListId: 1, UserId: 3, Role: entity.RoleViewer})).Error().
+				To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("Remove", func() {
+		It("removes an existing grant", func() {
+			Expect(repo.Remove(ctx, 1, 3)).To(HaveField("Role", entity.RoleViewer))
+			Expect(repo.GetByList(ctx, 1)).To(HaveExactElements(HaveField("UserId", 1)))
+		})
+
+		It("returns ErrNotFound for an unknown grant", func() {
+			Expect(repo.Remove(ctx, 2, 3)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveList", func() {
+		It("removes the grants of the list only", func() {
+			Expect(repo.RemoveList(ctx, 1)).To(Succeed())
+			Expect(repo.GetAll(ctx)).To(HaveExactElements(HaveField("ListId", 2)))
+		})
+	})
+
+	Describe("Role", func() {
+		DescribeTable("ParseRole",
+			func(value string, expected entity.Role, valid bool) {
+				role, err := entity.ParseRole(value)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(role).To(Equal(expected))
+				} else {
+					Expect(err).To(Equal(errors.ErrInvalidArgument))
+				}
+			},
+			Entry("viewer", "viewer", entity.RoleViewer, true),
+			Entry("a padded upper case editor", " Editor ", entity.RoleEditor, true),
+			Entry("owner", "owner", entity.RoleOwner, true),
+			Entry("an unknown role", "admin", entity.Role(""), false),
+			Entry("an empty role", "", entity.Role(""), false),
+		)
+
+		DescribeTable("Includes",
+			func(role entity.Role, other entity.Role, expected bool) {
+				Expect(role.Includes(other)).To(Equal(expected))
+			},
+			Entry("owner includes editor", entity.RoleOwner, entity.RoleEditor, true),
+			Entry("editor includes editor", entity.RoleEditor, entity.RoleEditor, true),
+			Entry("editor includes viewer", entity.RoleEditor, entity.RoleViewer, true),
+			Entry("viewer excludes editor", entity.RoleViewer, entity.RoleEditor, false),
+			Entry("editor excludes owner", entity.RoleEditor, entity.RoleOwner, false),
+			Entry("an unknown role includes nothing", entity.Role("admin"), entity.Role("other"), false),
+		)
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the grants of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+
+			Expect(repo.GetByList(acme, 1)).To(BeEmpty())
+			Expect(repo.Remove(acme, 1, 3)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByList(ctx, 1)).To(HaveLen(2))
+		})
+	})
+
+})
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+)
+
+type GetGrantResponse struct {
+	ListId    int       `json:"listId"`
+	UserId    int       `json:"userId"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+func EntityToGetGrantResponse(entity entity.Grant) GetGrantResponse {
+	return GetGrantResponse{
+		ListId:    entity.ListId,
+		UserId:    entity.UserId,
+		Role:      string(entity.Role),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+type GrantRoleRequest struct {
+	Role string `json:"role"`
+}
+
+func (dto GrantRoleRequest) IsValid() bool {
+	_, err := entity.ParseRole(dto.Role)
+	return err == nil
+}
+
+func (dto GrantRoleRequest) ToEntity(listId int, userId int) entity.Grant {
+	role, _ := entity.ParseRole(dto.Role)
+	return entity.Grant{
+		ListId: listId,
+		UserId: userId,
+		Role:   role,
+	}
+}
@@ -0,0 +1,42 @@
+package model_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+)
+
+var _ = Describe("Model", func() {
+
+	DescribeTable("GrantRoleRequest.IsValid",
+		func(request model.GrantRoleRequest, expected bool) {
+			Expect(request.IsValid()).To(Equal(expected))
+		},
+		Entry("a viewer", model.GrantRoleRequest{Role: "viewer"}, true),
+		Entry("an upper case owner", model.GrantRoleRequest{Role: "OWNER"}, true),
+		Entry("an unknown role", model.GrantRoleRequest{Role: "admin"}, false),
+		Entry("no role", model.GrantRoleRequest{}, false),
+	)
+
+	Describe("GrantRoleRequest.ToEntity", func() {
+		It("normalizes the role", func() {
+			Expect(model.GrantRoleRequest{Role: " Editor "}.ToEntity(1, 2)).
+				To(Equal(entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleEditor}))
+		})
+	})
+
+	Describe("EntityToGetGrantResponse", func() {
+		It("copies the fields", func() {
+			createdAt := time.Now()
+
+			Expect(model.EntityToGetGrantResponse(entity.Grant{
+				ListId: 1, UserId: 2, Role: entity.RoleOwner, CreatedAt: createdAt, UpdatedAt: createdAt,
+			})).To(Equal(model.GetGrantResponse{ListId: 1, UserId: 2, Role: "owner", CreatedAt: createdAt, UpdatedAt: createdAt}))
+		})
+	})
+
+})
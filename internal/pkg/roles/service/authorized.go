@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetByList(ctx context.Context, listId int) ([]model.GetGrantResponse, error) {
+	if err := authorized.policy.AuthorizeList(ctx, listId, entity.RoleViewer); err != nil {
+		return nil, err
+	}
+	return authorized.service.GetByList(ctx, listId)
+}
+
+func (authorized *authorizedService) Grant(ctx context.Context, listId int, userId int, request model.GrantRoleRequest) (model.GetGrantResponse, error) {
+	if err := authorized.policy.AuthorizeList(ctx, listId, entity.RoleOwner); err != nil {
+		return model.GetGrantResponse{}, err
+	}
+	return authorized.service.Grant(ctx, listId, userId, request)
+}
+
+func (authorized *authorizedService) Revoke(ctx context.Context, listId int, userId int) error {
+	if err := authorized.policy.AuthorizeList(ctx, listId, entity.RoleOwner); err != nil {
+		return err
+	}
+	return authorized.service.Revoke(ctx, listId, userId)
+}
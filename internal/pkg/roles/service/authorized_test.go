@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/roles/service"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to list the grants", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, entity.RoleViewer).Return(nil)
+		mockService.EXPECT().GetByList(ctx, 1).Return([]model.GetGrantResponse{{ListId: 1}}, nil)
+
+		Expect(authorizedSvc.GetByList(ctx, 1)).To(HaveLen(1))
+	})
+
+	It("requires the owner role to grant a role", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, entity.RoleOwner).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "editor"})).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the owner role to revoke a role", func() {
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, entity.RoleOwner).Return(nil)
+		mockService.EXPECT().Revoke(ctx, 1, 2).Return(nil)
+
+		Expect(authorizedSvc.Revoke(ctx, 1, 2)).To(Succeed())
+	})
+
+})
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+	usersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+)
+
+type Service interface {
+	GetByList(ctx context.Context, listId int) ([]model.GetGrantResponse, error)
+	Grant(ctx context.Context, listId int, userId int, request model.GrantRoleRequest) (model.GetGrantResponse, error)
+	Revoke(ctx context.Context, listId int, userId int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	lists      listsDAO.Repository
+	users      usersDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithLists(lists listsDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.lists = lists
+		}
+	}
+}
+
+func WithUsers(users usersDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.users = users
+		}
+	}
+}
+
+func (service *serviceImpl) GetByList(ctx context.Context, listId int) ([]model.GetGrantResponse, error) {
+	if err := service.checkList(ctx, listId); err != nil {
+		return nil, err
+	}
+
+	grants, err := service.repository.GetByList(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetGrantResponse
+	for _, grant := range grants {
+		dto = append(dto, model.EntityToGetGrantResponse(grant))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Grant(ctx context.Context, listId int, userId int, request model.GrantRoleRequest) (model.GetGrantResponse, error) {
+	if err := service.checkList(ctx, listId); err != nil {
+		return model.GetGrantResponse{}, err
+	}
+	if err := service.checkUser(ctx, userId); err != nil {
+		return model.GetGrantResponse{}, err
+	}
+
+	grant := request.ToEntity(listId, userId)
+	if grant.Role != entity.RoleOwner {
+		if err := service.checkLastOwner(ctx, listId, userId); err != nil {
+			return model.GetGrantResponse{}, err
+		}
+	}
+
+	grant, err := service.repository.Upsert(ctx, grant)
+	if err != nil {
+		return model.GetGrantResponse{}, err
+	}
+	return model.EntityToGetGrantResponse(grant), nil
+}
+
+func (service *serviceImpl) Revoke(ctx context.Context, listId int, userId int) error {
+	if err := service.checkList(ctx, listId); err != nil {
+		return err
+	}
+	if err := service.checkLastOwner(ctx, listId, userId); err != nil {
+		return err
+	}
+
+	_, err := service.repository.Remove(ctx, listId, userId)
+	return err
+}
+
+func (service *serviceImpl) checkList(ctx context.Context, listId int) error {
+	if service.lists == nil {
+		return nil
+	}
+
+	_, err := service.lists.GetById(ctx, listId)
+	return err
+}
+
+func (service *serviceImpl) checkUser(ctx context.Context, userId int) error {
+	if service.users == nil {
+		return nil
+	}
+
+	user, err := service.users.GetById(ctx, userId)
+	if err == errors.ErrNotFound || (err == nil && !user.IsActive()) {
+		return errors.ErrInvalidArgument
+	}
+	return err
+}
+
+func (service *serviceImpl) checkLastOwner(ctx context.Context, listId int, userId int) error {
+	grants, err := service.repository.GetByList(ctx, listId)
+	if err != nil {
+		return err
+	}
+
+	var owners int
+	var isOwner bool
+	for _, grant := range grants {
+		if grant.Role == entity.RoleOwner {
+			owners++
+			isOwner = isOwner || grant.UserId == userId
+		}
+	}
+
+	if isOwner && owners == 1 {
+		return errors.ErrConflict
+	}
+	return nil
+}
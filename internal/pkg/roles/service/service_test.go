@@ -0,0 +1,186 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/roles/service"
+	usersEntity "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	listsMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/dao"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/roles/dao"
+	usersMock "github.com/aeon-fruit/dalil.git/test/mocks/users/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockLists      *listsMock.MockRepository
+		mockUsers      *usersMock.MockRepository
+		rolesSvc       service.Service
+	)
+
+	owner := entity.Grant{ListId: 1, UserId: 1, Role: entity.RoleOwner}
+	viewer := entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleViewer}
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockLists = listsMock.NewMockRepository(mockCtrl)
+		mockUsers = usersMock.NewMockRepository(mockCtrl)
+		rolesSvc = service.New(
+			service.WithRepository(mockRepository),
+			service.WithLists(mockLists),
+			service.WithUsers(mockUsers),
+		)
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByList", func() {
+		It("returns the grants of the list", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+
+			Expect(rolesSvc.GetByList(ctx, 1)).To(Equal([]model.GetGrantResponse{
+				{ListId: 1, UserId: 1, Role: "owner"},
+				{ListId: 1, UserId: 2, Role: "viewer"},
+			}))
+		})
+
+		It("returns ErrNotFound for an unknown list", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(rolesSvc.GetByList(ctx, 1)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns the error of the repository", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return(nil, customErr)
+
+			Expect(rolesSvc.GetByList(ctx, 1)).Error().To(Equal(customErr))
+		})
+	})
+
+	Describe("Grant", func() {
+		It("grants the role to an active user", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 2).Return(usersEntity.User{Id: 2}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+			mockRepository.EXPECT().Upsert(gomock.Any(), entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleEditor}).
+				Return(entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleEditor}, nil)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "editor"})).
+				To(Equal(model.GetGrantResponse{ListId: 1, UserId: 2, Role: "editor"}))
+		})
+
+		It("grants the owner role without checking the other owners", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 2).Return(usersEntity.User{Id: 2}, nil)
+			mockRepository.EXPECT().Upsert(gomock.Any(), entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleOwner}).
+				Return(entity.Grant{ListId: 1, UserId: 2, Role: entity.RoleOwner}, nil)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "owner"})).
+				To(HaveField("Role", "owner"))
+		})
+
+		It("returns ErrNotFound for an unknown list", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "editor"})).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrInvalidArgument for an unknown user", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 2).Return(usersEntity.User{}, errors.ErrNotFound)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "editor"})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("returns ErrInvalidArgument for an inactive user", func() {
+			deactivatedAt := time.Now()
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 2).Return(usersEntity.User{Id: 2, DeactivatedAt: &deactivatedAt}, nil)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "editor"})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("returns ErrConflict when downgrading the last owner", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 1).Return(usersEntity.User{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+
+			Expect(rolesSvc.Grant(ctx, 1, 1, model.GrantRoleRequest{Role: "editor"})).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("downgrades an owner when another owner remains", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 1).Return(usersEntity.User{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).
+				Return([]entity.Grant{owner, {ListId: 1, UserId: 2, Role: entity.RoleOwner}}, nil)
+			mockRepository.EXPECT().Upsert(gomock.Any(), entity.Grant{ListId: 1, UserId: 1, Role: entity.RoleViewer}).
+				Return(entity.Grant{ListId: 1, UserId: 1, Role: entity.RoleViewer}, nil)
+
+			Expect(rolesSvc.Grant(ctx, 1, 1, model.GrantRoleRequest{Role: "viewer"})).To(HaveField("Role", "viewer"))
+		})
+
+		It("returns the error of the repository", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockUsers.EXPECT().GetById(gomock.Any(), 2).Return(usersEntity.User{Id: 2}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+			mockRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(entity.Grant{}, errors.ErrNotModified)
+
+			Expect(rolesSvc.Grant(ctx, 1, 2, model.GrantRoleRequest{Role: "viewer"})).Error().To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("Revoke", func() {
+		It("revokes the role of the user", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+			mockRepository.EXPECT().Remove(gomock.Any(), 1, 2).Return(viewer, nil)
+
+			Expect(rolesSvc.Revoke(ctx, 1, 2)).To(Succeed())
+		})
+
+		It("returns ErrNotFound for an unknown list", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(rolesSvc.Revoke(ctx, 1, 2)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrConflict for the last owner", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner, viewer}, nil)
+
+			Expect(rolesSvc.Revoke(ctx, 1, 1)).To(Equal(errors.ErrConflict))
+		})
+
+		It("returns the error of the repository", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+			mockRepository.EXPECT().GetByList(gomock.Any(), 1).Return([]entity.Grant{owner}, nil)
+			mockRepository.EXPECT().Remove(gomock.Any(), 1, 3).Return(entity.Grant{}, errors.ErrNotFound)
+
+			Expect(rolesSvc.Revoke(ctx, 1, 3)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+})
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	goErrors "errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/config"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+	"github.com/go-logr/logr"
+)
+
+type Server interface {
+	Run(ctx context.Context) error
+	Serve(ctx context.Context, listener net.Listener) error
+}
+
+type serverImpl struct {
+	httpServer    *http.Server
+	health        health.Health
+	flushers      []persistence.Flusher
+	shutdownDelay time.Duration
+	gracePeriod   time.Duration
+}
+
+type ServerOption func(*serverImpl)
+
+func New(options ...ServerOption) Server {
+	instance := serverImpl{
+		httpServer: &http.Server{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithAddr(addr string) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.httpServer.Addr = addr
+		}
+	}
+}
+
+func WithHandler(handler http.Handler) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.httpServer.Handler = handler
+		}
+	}
+}
+
+func WithConfig(serverConfig config.ServerConfig) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.httpServer.ReadTimeout = serverConfig.ReadTimeout
+			server.httpServer.ReadHeaderTimeout = serverConfig.ReadHeaderTimeout
+			server.httpServer.WriteTimeout = serverConfig.WriteTimeout
+			server.httpServer.IdleTimeout = serverConfig.IdleTimeout
+			server.httpServer.MaxHeaderBytes = serverConfig.MaxHeaderBytes
+			server.shutdownDelay = serverConfig.ShutdownDelay
+			server.gracePeriod = serverConfig.ShutdownGracePeriod
+		}
+	}
+}
+
+func WithTLS(tlsConfig *tls.Config) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.httpServer.TLSConfig = tlsConfig
+		}
+	}
+}
+
+func WithHealth(health health.Health) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.health = health
+		}
+	}
+}
+
+func WithFlushers(flushers ...persistence.Flusher) ServerOption {
+	return func(server *serverImpl) {
+		if server != nil {
+			server.flushers = append(server.flushers, flushers...)
+		}
+	}
+}
+
+func (server *serverImpl) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", server.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	return server.Serve(ctx, listener)
+}
+
+func (server *serverImpl) Serve(ctx context.Context, listener net.Listener) error {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	tlsEnabled := server.httpServer.TLSConfig != nil
+	served := make(chan error, 1)
+	go func() {
+		if tlsEnabled {
+			served <- server.httpServer.ServeTLS(listener, "", "")
+			return
+		}
+		served <- server.httpServer.Serve(listener)
+	}()
+
+	logger.Info("Server started", "addr", listener.Addr().String(), "tls", tlsEnabled)
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Info("Server shutting down", "delay", server.shutdownDelay, "gracePeriod", server.gracePeriod)
+	if server.health != nil {
+		server.health.SetReady(false)
+	}
+	time.Sleep(server.shutdownDelay)
+
+	shutdownCtx := context.Background()
+	if server.gracePeriod > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, server.gracePeriod)
+		defer cancel()
+	}
+
+	err := server.httpServer.Shutdown(shutdownCtx)
+	if err != nil {
+		logger.Error(err, "Failed to drain the connections")
+		err = goErrors.Join(err, server.httpServer.Close())
+	}
+	if servedErr := <-served; servedErr != http.ErrServerClosed {
+		err = goErrors.Join(err, servedErr)
+	}
+
+	for _, flusher := range server.flushers {
+		if flushErr := flusher.Flush(); flushErr != nil {
+			logger.Error(flushErr, "Failed to flush a repository")
+			err = goErrors.Join(err, flushErr)
+		}
+	}
+
+	logger.Info("Server stopped")
+	return err
+}
@@ -0,0 +1,199 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/config"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/health"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/server"
+)
+
+type countingFlusher struct {
+	flushes atomic.Int32
+	err     error
+}
+
+func (flusher *countingFlusher) Flush() error {
+	flusher.flushes.Add(1)
+	return flusher.err
+}
+
+var _ = Describe("Server", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		listener net.Listener
+		url      string
+		started  chan struct{}
+		release  chan struct{}
+		handler  http.Handler
+		flusher  *countingFlusher
+		probes   health.Health
+	)
+
+	serve := func(options ...server.ServerOption) chan error {
+		served := make(chan error, 1)
+		instance := server.New(append([]server.ServerOption{server.WithHandler(handler)}, options...)...)
+		go func() {
+			served <- instance.Serve(ctx, listener)
+		}()
+		return served
+	}
+
+	get := func() chan *http.Response {
+		responses := make(chan *http.Response, 1)
+		go func() {
+			defer GinkgoRecover()
+			response, err := http.Get(url)
+			Expect(err).NotTo(HaveOccurred())
+			responses <- response
+		}()
+		return responses
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		url = fmt.Sprintf("http://%s/", listener.Addr().String())
+
+		started = make(chan struct{}, 1)
+		release = make(chan struct{})
+		handlerStarted, handlerReleased := started, release
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerStarted <- struct{}{}
+			<-handlerReleased
+			w.WriteHeader(http.StatusOK)
+		})
+		flusher = &countingFlusher{}
+		probes = health.New()
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(server.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Run", func() {
+		It("returns the error when the address cannot be listened on", func() {
+			Expect(server.New(server.WithAddr(listener.Addr().String())).Run(ctx)).NotTo(Succeed())
+		})
+	})
+
+	Describe("Serve", func() {
+		It("returns the error when the listener fails", func() {
+			Expect(listener.Close()).To(Succeed())
+
+			Expect(server.New().Serve(ctx, listener)).NotTo(Succeed())
+		})
+
+		It("logs a server without TLS configuration as plain", func() {
+			lines := make(chan string, 10)
+			ctx = logr.NewContext(ctx, funcr.New(func(_, args string) {
+				lines <- args
+			}, funcr.Options{}))
+			served := serve()
+
+			Eventually(lines).Should(Receive(And(ContainSubstring(`"Server started"`), ContainSubstring(`"tls"=false`))))
+
+			cancel()
+			Eventually(served).Should(Receive(BeNil()))
+		})
+
+		It("rejects the headers larger than the configured maximum size", func() {
+			close(release)
+			served := serve(server.WithConfig(config.ServerConfig{MaxHeaderBytes: 1}))
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("X-Padding", strings.Repeat("x", 8192))
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusRequestHeaderFieldsTooLarge))
+
+			cancel()
+			Eventually(served).Should(Receive(BeNil()))
+		})
+
+		When("the context is cancelled", func() {
+			It("stops being ready, drains the in-flight requests and flushes the repositories", func() {
+				served := serve(server.WithHealth(probes), server.WithFlushers(flusher),
+					server.WithConfig(config.ServerConfig{ShutdownGracePeriod: time.Minute}))
+				responses := get()
+				Eventually(started).Should(Receive())
+
+				cancel()
+
+				Eventually(func() health.Status {
+					return probes.Readiness(context.Background()).Status
+				}).Should(Equal(health.StatusDown))
+				Consistently(served).ShouldNot(Receive())
+				Expect(flusher.flushes.Load()).To(BeZero())
+
+				close(release)
+
+				Eventually(responses).Should(Receive(HaveField("StatusCode", http.StatusOK)))
+				Eventually(served).Should(Receive(BeNil()))
+				Expect(flusher.flushes.Load()).To(Equal(int32(1)))
+			})
+
+			It("waits for the shutdown delay before draining", func() {
+				close(release)
+				served := serve(server.WithConfig(config.ServerConfig{ShutdownDelay: 200 * time.Millisecond}))
+
+				cancel()
+
+				Consistently(served, 100*time.Millisecond).ShouldNot(Receive())
+				Eventually(served).Should(Receive(BeNil()))
+			})
+
+			It("gives up the draining after the grace period and still flushes the repositories", func() {
+				DeferCleanup(func() {
+					close(release)
+				})
+				served := serve(server.WithFlushers(flusher),
+					server.WithConfig(config.ServerConfig{ShutdownGracePeriod: 50 * time.Millisecond}))
+				go func() {
+					_, _ = http.Get(url)
+				}()
+				Eventually(started).Should(Receive())
+
+				cancel()
+
+				var err error
+				Eventually(served).Should(Receive(&err))
+				Expect(err).To(MatchError(context.DeadlineExceeded))
+				Expect(flusher.flushes.Load()).To(Equal(int32(1)))
+			})
+
+			It("returns the errors of the flushes", func() {
+				close(release)
+				flusher.err = fmt.Errorf("custom error")
+				served := serve(server.WithFlushers(flusher))
+
+				cancel()
+
+				var err error
+				Eventually(served).Should(Receive(&err))
+				Expect(err).To(MatchError(flusher.err))
+			})
+		})
+	})
+
+})
@@ -1,6 +1,9 @@
 package time
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 type Clock interface {
 	Now() time.Time
@@ -15,3 +18,26 @@ func New() Clock {
 func (stdClock) Now() time.Time {
 	return time.Now()
 }
+
+type FixedClock struct {
+	mutex sync.RWMutex
+	now   time.Time
+}
+
+func NewFixed(now time.Time) *FixedClock {
+	return &FixedClock{now: now}
+}
+
+func (clock *FixedClock) Now() time.Time {
+	clock.mutex.RLock()
+	defer clock.mutex.RUnlock()
+
+	return clock.now
+}
+
+func (clock *FixedClock) Set(now time.Time) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.now = now
+}
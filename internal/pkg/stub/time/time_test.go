@@ -1,6 +1,8 @@
 package time_test
 
 import (
+	stdTime "time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -15,4 +17,17 @@ var _ = Describe("Time", func() {
 		})
 	})
 
+	Describe("FixedClock", func() {
+		It("returns the time it was given until it is set", func() {
+			now := stdTime.Date(2023, stdTime.March, 17, 9, 30, 0, 0, stdTime.UTC)
+			clock := time.NewFixed(now)
+
+			Expect(clock.Now()).To(Equal(now))
+			Expect(clock.Now()).To(Equal(now))
+
+			clock.Set(now.Add(stdTime.Hour))
+			Expect(clock.Now()).To(Equal(now.Add(stdTime.Hour)))
+		})
+	})
+
 })
@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/tags/service"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByIdFailed       = "GetById failed"
+	getByIdResponse     = "GetById response"
+	getAllFailed        = "GetAll failed"
+	getAllResponse      = "GetAll response"
+	addFailed           = "Add failed"
+	addResponse         = "Add response"
+	updateFailed        = "Update failed"
+	updateResponse      = "Update response"
+	removeByIdFailed    = "RemoveById failed"
+	getByTaskIdFailed   = "GetByTaskId failed"
+	getByTaskIdResponse = "GetByTaskId response"
+	attachFailed        = "Attach failed"
+	detachFailed        = "Detach failed"
+)
+
+const (
+	nameConflict   = "A tag with the same name already exists"
+	quotaExceeded  = "The tenant has reached its quota"
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetById(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	Update(w http.ResponseWriter, r *http.Request)
+	RemoveById(w http.ResponseWriter, r *http.Request)
+	GetByTaskId(w http.ResponseWriter, r *http.Request)
+	Attach(w http.ResponseWriter, r *http.Request)
+	Detach(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.GetById(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			logger.Error(err, getByIdFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(getByIdResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	entity, err := ctrl.service.GetAll(r.Context())
+	if err != nil {
+		logger.Error(err, getAllFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	if len(entity) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getAllResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	request, stop := common.GetRequestOrStop[model.UpsertTagRequest](w, r, addFailed)
+	if stop {
+		return
+	}
+
+	if !request.IsValid(nil) {
+		logger.Error(errors.ErrInvalidArgument, addFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	entity, err := ctrl.service.Upsert(r.Context(), request)
+	if err != nil {
+		if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, nameConflict))
+		} else if err == errors.ErrQuotaExceeded {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusTooManyRequests, quotaExceeded))
+		} else {
+			logger.Error(err, addFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, entity.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(addResponse, constants.Payload, entity)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Update(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetRequestOrStop[model.UpsertTagRequest](w, r, updateFailed)
+	if stop {
+		return
+	}
+
+	if !request.IsValid(&id) {
+		logger.Error(errors.ErrInvalidArgument, updateFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	entity, err := ctrl.service.Upsert(r.Context(), request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrNotModified {
+			w.WriteHeader(http.StatusNotModified)
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, nameConflict))
+		} else {
+			logger.Error(err, updateFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(updateResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.RemoveById(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			logger.Error(err, removeByIdFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *controllerImpl) GetByTaskId(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.GetByTaskId(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByTaskIdFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(entity) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getByTaskIdResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Attach(w http.ResponseWriter, r *http.Request) {
+	ctrl.link(w, r, ctrl.service.Attach, attachFailed)
+}
+
+func (ctrl *controllerImpl) Detach(w http.ResponseWriter, r *http.Request) {
+	ctrl.link(w, r, ctrl.service.Detach, detachFailed)
+}
+
+func (ctrl *controllerImpl) link(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, taskId int, tagId int) error, failed string) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	tagId, stop := common.GetPathParamOrStop(w, r, constants.TagId)
+	if stop {
+		return
+	}
+
+	err := action(r.Context(), taskId, tagId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, failed, constants.Id, taskId, constants.TagId, tagId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
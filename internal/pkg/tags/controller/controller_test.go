@@ -0,0 +1,318 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tags/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		tagsCtrl    controller.Controller
+	)
+
+	withPathParam := func(request *http.Request, key string, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), key, value))
+	}
+
+	decodeError := func() errorModel.Response {
+		var payload errorModel.Response
+		Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+		return payload
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		tagsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+
+		When("the id is not found", func() {
+			It("responds with status InternalServerError and an error response payload", func() {
+				tagsCtrl.GetById(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+				Expect(decodeError().Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(model.GetTagResponse{}, errors.ErrNotFound)
+
+				tagsCtrl.GetById(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "1"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+		When("the entity is found", func() {
+			It("responds with status OK and the entity in the payload", func() {
+				entity := model.GetTagResponse{Id: 1, Name: "bug", UsageCount: 2}
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(entity, nil)
+
+				tagsCtrl.GetById(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "1"))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetTagResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(entity))
+			})
+		})
+
+	})
+
+	Describe("GetAll", func() {
+
+		When("an error happens while retrieving the list of entities", func() {
+			It("responds with status InternalServerError and an error response payload", func() {
+				customErr := fmt.Errorf("custom error")
+				mockService.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
+
+				tagsCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+				Expect(decodeError().Message).To(Equal(customErr.Error()))
+			})
+		})
+
+		When("the list of entities is empty", func() {
+			It("responds with status NoContent and no payload", func() {
+				mockService.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+
+				tagsCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+	})
+
+	Describe("Add", func() {
+
+		When("the request payload format is wrong", func() {
+			It("responds with status BadRequest", func() {
+				tagsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader("{")))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the name is invalid", func() {
+			It("responds with status BadRequest", func() {
+				tagsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"two words"}`)))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the name is already used", func() {
+			It("responds with status Conflict", func() {
+				mockService.EXPECT().Upsert(gomock.Any(), model.UpsertTagRequest{Name: "bug"}).Return(model.GetTagResponse{}, errors.ErrConflict)
+
+				tagsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"bug"}`)))
+
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+				Expect(decodeError().Code).To(Equal(http.StatusConflict))
+			})
+		})
+
+		When("the tenant quota is exhausted", func() {
+			It("responds with status TooManyRequests", func() {
+				mockService.EXPECT().Upsert(gomock.Any(), model.UpsertTagRequest{Name: "bug"}).Return(model.GetTagResponse{}, errors.ErrQuotaExceeded)
+
+				tagsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"bug"}`)))
+
+				Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(decodeError().Code).To(Equal(http.StatusTooManyRequests))
+			})
+		})
+
+		When("the entity is added", func() {
+			It("responds with status Created and the entity in the payload", func() {
+				entity := model.GetTagResponse{Id: 3, Name: "bug"}
+				mockService.EXPECT().Upsert(gomock.Any(), model.UpsertTagRequest{Name: "bug"}).Return(entity, nil)
+
+				tagsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"bug"}`)))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(HaveSuffix("/3"))
+			})
+		})
+
+	})
+
+	Describe("Update", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = withPathParam(httptest.NewRequest("", url, strings.NewReader(`{"id":1,"name":"defect"}`)), constants.Id, "1")
+		})
+
+		When("the ids do not match", func() {
+			It("responds with status BadRequest", func() {
+				request = withPathParam(httptest.NewRequest("", url, strings.NewReader(`{"id":2,"name":"defect"}`)), constants.Id, "1")
+
+				tagsCtrl.Update(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTagResponse{}, err)
+
+				tagsCtrl.Update(recorder, request)
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("not modified", errors.ErrNotModified, http.StatusNotModified),
+			Entry("conflict", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		When("the entity is renamed", func() {
+			It("responds with status OK and the entity in the payload", func() {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTagResponse{Id: 1, Name: "defect"}, nil)
+
+				tagsCtrl.Update(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"defect"`))
+			})
+		})
+
+	})
+
+	Describe("RemoveById", func() {
+		It("responds with status NoContent", func() {
+			mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(nil)
+
+			tagsCtrl.RemoveById(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "1"))
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+
+		When("the task is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 7).Return(nil, errors.ErrNotFound)
+
+				tagsCtrl.GetByTaskId(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "7"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the caller cannot read the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 7).Return(nil, errors.ErrForbidden)
+
+				tagsCtrl.GetByTaskId(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "7"))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the task has tags", func() {
+			It("responds with status OK and the tags in the payload", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 7).Return([]model.GetTagResponse{{Id: 1, Name: "bug"}}, nil)
+
+				tagsCtrl.GetByTaskId(recorder, withPathParam(httptest.NewRequest("", url, nil), constants.Id, "7"))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"bug"`))
+			})
+		})
+
+	})
+
+	Describe("Attach and Detach", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = withPathParam(httptest.NewRequest("", url, nil), constants.Id, "7")
+		})
+
+		When("the tag id is not found", func() {
+			It("responds with status InternalServerError", func() {
+				tagsCtrl.Attach(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the tag id is found", func() {
+
+			BeforeEach(func() {
+				request = withPathParam(request, constants.TagId, "1")
+			})
+
+			It("attaches the tag", func() {
+				mockService.EXPECT().Attach(gomock.Any(), 7, 1).Return(nil)
+
+				tagsCtrl.Attach(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+
+			It("responds with status Forbidden when the caller cannot edit the task", func() {
+				mockService.EXPECT().Attach(gomock.Any(), 7, 1).Return(errors.ErrForbidden)
+
+				tagsCtrl.Attach(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+
+			It("responds with status NotFound when the tag is not attached", func() {
+				mockService.EXPECT().Detach(gomock.Any(), 7, 1).Return(errors.ErrNotFound)
+
+				tagsCtrl.Detach(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+
+		})
+
+	})
+
+})
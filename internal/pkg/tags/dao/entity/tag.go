@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type Tag struct {
+	Id        int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	Name      string    `json:"name" gorm:"column:name;type:varchar;size:64;uniqueIndex"`
+	CreatedAt time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+}
+
+type TaskTag struct {
+	TaskId int `json:"taskId" gorm:"column:task_id;type:int;primaryKey"`
+	TagId  int `json:"tagId" gorm:"column:tag_id;type:int;primaryKey;index"`
+}
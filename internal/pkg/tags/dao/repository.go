@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.Tag, error)
+	GetByName(ctx context.Context, name string) (entity.Tag, error)
+	GetAll(ctx context.Context) ([]entity.Tag, error)
+	Insert(ctx context.Context, tag entity.Tag) (entity.Tag, error)
+	Update(ctx context.Context, tag entity.Tag) (entity.Tag, error)
+	RemoveById(ctx context.Context, id int) (entity.Tag, error)
+	GetTagIds(ctx context.Context, taskId int) ([]int, error)
+	GetTaskIds(ctx context.Context, tagId int) ([]int, error)
+	Attach(ctx context.Context, taskId int, tagId int) error
+	Detach(ctx context.Context, taskId int, tagId int) error
+	DetachAll(ctx context.Context, taskId int) error
+}
+
+type partition struct {
+	tags  map[int]entity.Tag
+	links map[entity.TaskTag]bool
+	seq   int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+	quotas     tenancy.Quotas
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTags(tags map[int]entity.Tag) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && tags != nil {
+			repository.partitions.Of(tenancy.DefaultTenant).tags = tags
+		}
+	}
+}
+
+func WithQuotas(quotas tenancy.Quotas) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.quotas = quotas
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		tags:  map[int]entity.Tag{},
+		links: map[entity.TaskTag]bool{},
+		seq:   0,
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.Tag, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	tag, found := partition.tags[id]
+	if !found {
+		return entity.Tag{}, errors.ErrNotFound
+	}
+	return tag, nil
+}
+
+func (repo *memoryRepository) GetByName(ctx context.Context, name string) (entity.Tag, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	tag, found := partition.findByName(name)
+	if !found {
+		return entity.Tag{}, errors.ErrNotFound
+	}
+	return tag, nil
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Tag, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var tags []entity.Tag
+	for _, tag := range partition.tags {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+	if repo.quotas != nil {
+		if err := repo.quotas.Check(ctx, tenancy.ResourceTags, len(partition.tags)); err != nil {
+			return entity.Tag{}, err
+		}
+	}
+
+	if _, found := partition.findByName(tag.Name); found {
+		return entity.Tag{}, errors.ErrConflict
+	}
+
+	tag.Id, partition.seq = partition.seq, partition.seq+1
+	tag.UpdatedAt = time.Now()
+	tag.CreatedAt = tag.UpdatedAt
+	partition.tags[tag.Id] = tag
+	return tag, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldTag, found := partition.tags[tag.Id]
+	if !found {
+		return entity.Tag{}, errors.ErrNotFound
+	}
+
+	if oldTag.Name == tag.Name {
+		return entity.Tag{}, errors.ErrNotModified
+	}
+
+	if other, found := partition.findByName(tag.Name); found && other.Id != tag.Id {
+		return entity.Tag{}, errors.ErrConflict
+	}
+
+	tag.UpdatedAt = time.Now()
+	tag.CreatedAt = oldTag.CreatedAt
+	partition.tags[tag.Id] = tag
+	return tag, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Tag, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	tag, found := partition.tags[id]
+	if !found {
+		return entity.Tag{}, errors.ErrNotFound
+	}
+	delete(partition.tags, id)
+	for link := range partition.links {
+		if link.TagId == id {
+			delete(partition.links, link)
+		}
+	}
+	return tag, nil
+}
+
+func (repo *memoryRepository) GetTagIds(ctx context.Context, taskId int) ([]int, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var ids []int
+	for link := range partition.links {
+		if link.TaskId == taskId {
+			ids = append(ids, link.TagId)
+		}
+	}
+	sort.Ints(ids)
+
+	return ids, nil
+}
+
+func (repo *memoryRepository) GetTaskIds(ctx context.Context, tagId int) ([]int, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var ids []int
+	for link := range partition.links {
+		if link.TagId == tagId {
+			ids = append(ids, link.TaskId)
+		}
+	}
+	sort.Ints(ids)
+
+	return ids, nil
+}
+
+func (repo *memoryRepository) Attach(ctx context.Context, taskId int, tagId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	if _, found := partition.tags[tagId]; !found {
+		return errors.ErrNotFound
+	}
+	partition.links[entity.TaskTag{TaskId: taskId, TagId: tagId}] = true
+	return nil
+}
+
+func (repo *memoryRepository) Detach(ctx context.Context, taskId int, tagId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	link := entity.TaskTag{TaskId: taskId, TagId: tagId}
+	if !partition.links[link] {
+		return errors.ErrNotFound
+	}
+	delete(partition.links, link)
+	return nil
+}
+
+func (repo *memoryRepository) DetachAll(ctx context.Context, taskId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for link := range partition.links {
+		if link.TaskId == taskId {
+			delete(partition.links, link)
+		}
+	}
+	return nil
+}
+
+func (partition *partition) findByName(name string) (entity.Tag, bool) {
+	for _, tag := range partition.tags {
+		if tag.Name == name {
+			return tag, true
+		}
+	}
+	return entity.Tag{}, false
+}
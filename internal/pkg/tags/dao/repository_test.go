@@ -0,0 +1,133 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		repo repository.Repository
+		bug  entity.Tag
+	)
+
+	BeforeEach(func() {
+		repo = repository.New()
+		bug, _ = repo.Insert(ctx, entity.Tag{Name: "bug"})
+	})
+
+	Describe("Insert", func() {
+		It("rejects a duplicated name", func() {
+			Expect(repo.Insert(ctx, entity.Tag{Name: "bug"})).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("GetByName", func() {
+		It("finds a tag by its name", func() {
+			Expect(repo.GetByName(ctx, "bug")).To(Equal(bug))
+		})
+
+		It("returns ErrNotFound for an unknown name", func() {
+			Expect(repo.GetByName(ctx, "feature")).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("sorts the tags by name", func() {
+			_, err := repo.Insert(ctx, entity.Tag{Name: "api"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetAll(ctx)).To(HaveExactElements(HaveField("Name", "api"), HaveField("Name", "bug")))
+		})
+	})
+
+	Describe("Update", func() {
+		It("renames the tag", func() {
+			Expect(repo.Update(ctx, entity.Tag{Id: bug.Id, Name: "defect"})).To(HaveField("Name", "defect"))
+			Expect(repo.GetById(ctx, bug.Id)).To(HaveField("Name", "defect"))
+		})
+
+		It("returns ErrNotModified when the name is unchanged", func() {
+			Expect(repo.Update(ctx, bug)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrConflict when the name is taken", func() {
+			other, _ := repo.Insert(ctx, entity.Tag{Name: "feature"})
+
+			Expect(repo.Update(ctx, entity.Tag{Id: other.Id, Name: "bug"})).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("returns ErrNotFound for an unknown tag", func() {
+			Expect(repo.Update(ctx, entity.Tag{Id: 42, Name: "bug"})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("links", func() {
+		BeforeEach(func() {
+			Expect(repo.Attach(ctx, 1, bug.Id)).To(Succeed())
+			Expect(repo.Attach(ctx, 2, bug.Id)).To(Succeed())
+		})
+
+		It("is idempotent when attaching", func() {
+			Expect(repo.Attach(ctx, 1, bug.Id)).To(Succeed())
+			Expect(repo.GetTaskIds(ctx, bug.Id)).To(Equal([]int{1, 2}))
+		})
+
+		It("refuses to attach an unknown tag", func() {
+			Expect(repo.Attach(ctx, 1, 42)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("lists the tags of a task", func() {
+			Expect(repo.GetTagIds(ctx, 1)).To(Equal([]int{bug.Id}))
+		})
+
+		It("detaches a tag", func() {
+			Expect(repo.Detach(ctx, 1, bug.Id)).To(Succeed())
+			Expect(repo.Detach(ctx, 1, bug.Id)).To(Equal(errors.ErrNotFound))
+			Expect(repo.GetTaskIds(ctx, bug.Id)).To(Equal([]int{2}))
+		})
+
+		It("detaches all the tags of a task", func() {
+			Expect(repo.DetachAll(ctx, 2)).To(Succeed())
+			Expect(repo.GetTagIds(ctx, 2)).To(BeEmpty())
+		})
+
+		It("removes the links of a removed tag", func() {
+			Expect(repo.RemoveById(ctx, bug.Id)).Error().NotTo(HaveOccurred())
+			Expect(repo.GetTagIds(ctx, 1)).To(BeEmpty())
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("isolates the tags and links of each tenant", func() {
+			Expect(repo.Attach(ctx, 1, bug.Id)).To(Succeed())
+
+			Expect(repo.GetByName(acme, "bug")).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetTagIds(acme, 1)).To(BeEmpty())
+			Expect(repo.Attach(acme, 1, bug.Id)).To(Equal(errors.ErrNotFound))
+			Expect(repo.Insert(acme, entity.Tag{Name: "bug"})).To(HaveField("Id", bug.Id))
+		})
+
+		It("enforces the quota of the tenant", func() {
+			repo = repository.New(repository.WithQuotas(tenancy.NewQuotas(
+				tenancy.WithDefaults(tenancy.Limits{tenancy.ResourceTags: 1}),
+			)))
+
+			Expect(repo.Insert(acme, entity.Tag{Name: "bug"})).Error().NotTo(HaveOccurred())
+			Expect(repo.Insert(acme, entity.Tag{Name: "feature"})).Error().To(Equal(errors.ErrQuotaExceeded))
+		})
+	})
+
+})
@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+)
+
+var _ = Describe("Model", func() {
+
+	const id = 10
+
+	Describe("EntityToGetTagResponse", func() {
+		It("returns a TagResponse that contains the entity's values and the usage count", func() {
+			now := time.Now()
+			e := entity.Tag{Id: id, Name: "bug", CreatedAt: now.Add(-time.Hour), UpdatedAt: now}
+
+			Expect(model.EntityToGetTagResponse(e, 3)).To(Equal(model.GetTagResponse{
+				Id:         id,
+				Name:       "bug",
+				UsageCount: 3,
+				CreatedAt:  e.CreatedAt,
+				UpdatedAt:  e.UpdatedAt,
+			}))
+		})
+	})
+
+	Describe("UpsertTagRequest", func() {
+
+		Describe("IsValid", func() {
+			DescribeTable("checks the name",
+				func(name string, valid bool) {
+					Expect(model.UpsertTagRequest{Name: name}.IsValid(nil)).To(Equal(valid))
+				},
+				Entry("a simple name", "bug", true),
+				Entry("a padded name", "  Bug ", true),
+				Entry("an empty name", "", false),
+				Entry("a blank name", "   ", false),
+				Entry("a name with spaces", "good first issue", false),
+				Entry("a name with commas", "bug,urgent", false),
+				Entry("a too long name", strings.Repeat("a", 65), false),
+			)
+
+			It("checks the ids match", func() {
+				otherId := id + 1
+				ownId := id
+
+				Expect(model.UpsertTagRequest{Name: "bug", Id: &ownId}.IsValid(nil)).To(BeFalse())
+				Expect(model.UpsertTagRequest{Name: "bug"}.IsValid(&ownId)).To(BeFalse())
+				Expect(model.UpsertTagRequest{Name: "bug", Id: &otherId}.IsValid(&ownId)).To(BeFalse())
+				Expect(model.UpsertTagRequest{Name: "bug", Id: &ownId}.IsValid(&ownId)).To(BeTrue())
+			})
+		})
+
+		Describe("ToEntity", func() {
+			It("normalizes the name", func() {
+				ownId := id
+
+				Expect(model.UpsertTagRequest{Id: &ownId, Name: " Urgent "}.ToEntity()).
+					To(Equal(entity.Tag{Id: id, Name: "urgent"}))
+			})
+		})
+
+	})
+
+})
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+)
+
+const maxNameLength = 64
+
+type GetTagResponse struct {
+	Id         int       `json:"id"`
+	Name       string    `json:"name"`
+	UsageCount int       `json:"usageCount"`
+	CreatedAt  time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt,omitempty"`
+}
+
+func EntityToGetTagResponse(entity entity.Tag, usageCount int) GetTagResponse {
+	return GetTagResponse{
+		Id:         entity.Id,
+		Name:       entity.Name,
+		UsageCount: usageCount,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+	}
+}
+
+type UpsertTagRequest struct {
+	Id   *int   `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+func (dto UpsertTagRequest) IsValid(id *int) bool {
+	return IsValidName(dto.Name) &&
+		((id == nil && dto.Id == nil) ||
+			(id != nil && dto.Id != nil && *id == *dto.Id))
+}
+
+func (dto UpsertTagRequest) ToEntity() entity.Tag {
+	var id int
+	if dto.Id != nil {
+		id = *dto.Id
+	}
+
+	return entity.Tag{
+		Id:   id,
+		Name: NormalizeName(dto.Name),
+	}
+}
+
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func IsValidName(name string) bool {
+	name = NormalizeName(name)
+	return name != "" &&
+		len(name) <= maxNameLength &&
+		strings.IndexFunc(name, func(r rune) bool {
+			return unicode.IsSpace(r) || r == ','
+		}) < 0
+}
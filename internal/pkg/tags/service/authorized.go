@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetById(ctx context.Context, id int) (model.GetTagResponse, error) {
+	return authorized.service.GetById(ctx, id)
+}
+
+func (authorized *authorizedService) GetAll(ctx context.Context) ([]model.GetTagResponse, error) {
+	return authorized.service.GetAll(ctx)
+}
+
+func (authorized *authorizedService) Upsert(ctx context.Context, request model.UpsertTagRequest) (model.GetTagResponse, error) {
+	return authorized.service.Upsert(ctx, request)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, id int) error {
+	return authorized.service.RemoveById(ctx, id)
+}
+
+func (authorized *authorizedService) GetByTaskId(ctx context.Context, taskId int) ([]model.GetTagResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return nil, err
+	}
+	return authorized.service.GetByTaskId(ctx, taskId)
+}
+
+func (authorized *authorizedService) Attach(ctx context.Context, taskId int, tagId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.Attach(ctx, taskId, tagId)
+}
+
+func (authorized *authorizedService) Detach(ctx context.Context, taskId int, tagId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.Detach(ctx, taskId, tagId)
+}
+
+func (authorized *authorizedService) TagTask(ctx context.Context, taskId int, names []string) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.TagTask(ctx, taskId, names)
+}
+
+func (authorized *authorizedService) GetTaskIds(ctx context.Context, names []string, matchAll bool) (map[int]bool, error) {
+	return authorized.service.GetTaskIds(ctx, names, matchAll)
+}
+
+func (authorized *authorizedService) RemoveTask(ctx context.Context, taskId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveTask(ctx, taskId)
+}
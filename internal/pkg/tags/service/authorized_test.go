@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tags/service"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("does not restrict the tags themselves", func() {
+		mockService.EXPECT().GetAll(ctx).Return([]model.GetTagResponse{{Id: 1}}, nil)
+
+		Expect(authorizedSvc.GetAll(ctx)).To(HaveLen(1))
+	})
+
+	It("requires the viewer role to get the tags of a task", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 7, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetByTaskId(ctx, 7)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to attach a tag", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 7, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Attach(ctx, 7, 1)).To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to detach a tag", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 7, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().Detach(ctx, 7, 1).Return(nil)
+
+		Expect(authorizedSvc.Detach(ctx, 7, 1)).To(Succeed())
+	})
+
+})
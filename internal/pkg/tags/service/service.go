@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetTagResponse, error)
+	GetAll(ctx context.Context) ([]model.GetTagResponse, error)
+	Upsert(ctx context.Context, request model.UpsertTagRequest) (model.GetTagResponse, error)
+	RemoveById(ctx context.Context, id int) error
+	GetByTaskId(ctx context.Context, taskId int) ([]model.GetTagResponse, error)
+	Attach(ctx context.Context, taskId int, tagId int) error
+	Detach(ctx context.Context, taskId int, tagId int) error
+	TagTask(ctx context.Context, taskId int, names []string) error
+	GetTaskIds(ctx context.Context, names []string, matchAll bool) (map[int]bool, error)
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasksDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetTagResponse, error) {
+	tag, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetTagResponse{}, err
+	}
+
+	return service.toResponse(ctx, tag)
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context) ([]model.GetTagResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetTagResponse
+	for _, tag := range entities {
+		response, err := service.toResponse(ctx, tag)
+		if err != nil {
+			return nil, err
+		}
+		dto = append(dto, response)
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Upsert(ctx context.Context, request model.UpsertTagRequest) (model.GetTagResponse, error) {
+	tag := request.ToEntity()
+
+	var err error
+	if request.Id == nil {
+		tag, err = service.repository.Insert(ctx, tag)
+	} else {
+		tag, err = service.repository.Update(ctx, tag)
+	}
+
+	if err != nil {
+		return model.GetTagResponse{}, err
+	}
+	return service.toResponse(ctx, tag)
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	_, err := service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) GetByTaskId(ctx context.Context, taskId int) ([]model.GetTagResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return nil, err
+	}
+
+	ids, err := service.repository.GetTagIds(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetTagResponse
+	for _, id := range ids {
+		response, err := service.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		dto = append(dto, response)
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Attach(ctx context.Context, taskId int, tagId int) error {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return err
+	}
+
+	return service.repository.Attach(ctx, taskId, tagId)
+}
+
+func (service *serviceImpl) Detach(ctx context.Context, taskId int, tagId int) error {
+	return service.repository.Detach(ctx, taskId, tagId)
+}
+
+func (service *serviceImpl) TagTask(ctx context.Context, taskId int, names []string) error {
+	for _, name := range names {
+		if !model.IsValidName(name) {
+			return errors.ErrInvalidArgument
+		}
+	}
+
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		tag, err := service.repository.GetByName(ctx, model.NormalizeName(name))
+		if err == errors.ErrNotFound {
+			tag, err = service.repository.Insert(ctx, model.UpsertTagRequest{Name: name}.ToEntity())
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = service.repository.Attach(ctx, taskId, tag.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *serviceImpl) GetTaskIds(ctx context.Context, names []string, matchAll bool) (map[int]bool, error) {
+	var result map[int]bool
+	for _, name := range names {
+		tag, err := service.repository.GetByName(ctx, model.NormalizeName(name))
+		if err == errors.ErrNotFound {
+			if matchAll {
+				return map[int]bool{}, nil
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ids, err := service.repository.GetTaskIds(ctx, tag.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		tagged := map[int]bool{}
+		for _, id := range ids {
+			if result == nil || !matchAll || result[id] {
+				tagged[id] = true
+			}
+		}
+		if !matchAll {
+			for id := range result {
+				tagged[id] = true
+			}
+		}
+		result = tagged
+	}
+
+	if result == nil {
+		result = map[int]bool{}
+	}
+	return result, nil
+}
+
+func (service *serviceImpl) RemoveTask(ctx context.Context, taskId int) error {
+	return service.repository.DetachAll(ctx, taskId)
+}
+
+func (service *serviceImpl) checkTask(ctx context.Context, taskId int) error {
+	if service.tasks == nil {
+		return nil
+	}
+
+	_, err := service.tasks.GetById(ctx, taskId)
+	return err
+}
+
+func (service *serviceImpl) toResponse(ctx context.Context, tag entity.Tag) (model.GetTagResponse, error) {
+	ids, err := service.repository.GetTaskIds(ctx, tag.Id)
+	if err != nil {
+		return model.GetTagResponse{}, err
+	}
+
+	return model.EntityToGetTagResponse(tag, len(ids)), nil
+}
@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tags/service"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/tags/dao"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const (
+		id     = 1
+		taskId = 7
+	)
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockTasks      *tasksDaoMock.MockRepository
+		tagsSvc        service.Service
+		bug            entity.Tag
+	)
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		tagsSvc = service.New(service.WithRepository(mockRepository), service.WithTasks(mockTasks))
+
+		timestamp := time.UnixMilli(1679143523911)
+		bug = entity.Tag{Id: id, Name: "bug", CreatedAt: timestamp, UpdatedAt: timestamp}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+
+		When("an error happens while retrieving the dao", func() {
+			It("returns the error", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Tag{}, customErr)
+
+				Expect(tagsSvc.GetById(ctx, id)).Error().To(Equal(customErr))
+			})
+		})
+
+		When("retrieving the dao is successful", func() {
+			It("returns a dto with the usage count", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(bug, nil)
+				mockRepository.EXPECT().GetTaskIds(gomock.Any(), id).Return([]int{3, 4}, nil)
+
+				Expect(tagsSvc.GetById(ctx, id)).To(Equal(model.EntityToGetTagResponse(bug, 2)))
+			})
+		})
+
+	})
+
+	Describe("GetAll", func() {
+		It("returns the dto list with the usage counts", func() {
+			feature := entity.Tag{Id: 2, Name: "feature"}
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.Tag{bug, feature}, nil)
+			mockRepository.EXPECT().GetTaskIds(gomock.Any(), bug.Id).Return([]int{3}, nil)
+			mockRepository.EXPECT().GetTaskIds(gomock.Any(), feature.Id).Return(nil, nil)
+
+			Expect(tagsSvc.GetAll(ctx)).To(Equal([]model.GetTagResponse{
+				model.EntityToGetTagResponse(bug, 1),
+				model.EntityToGetTagResponse(feature, 0),
+			}))
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
+
+			Expect(tagsSvc.GetAll(ctx)).Error().To(Equal(customErr))
+		})
+	})
+
+	Describe("Upsert", func() {
+
+		When("the id is nil", func() {
+			It("inserts the normalized tag", func() {
+				mockRepository.EXPECT().Insert(gomock.Any(), entity.Tag{Name: "bug"}).Return(bug, nil)
+				mockRepository.EXPECT().GetTaskIds(gomock.Any(), id).Return(nil, nil)
+
+				Expect(tagsSvc.Upsert(ctx, model.UpsertTagRequest{Name: " Bug "})).To(HaveField("Id", id))
+			})
+
+			It("returns ErrConflict for a duplicated name", func() {
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.Tag{}, errors.ErrConflict)
+
+				Expect(tagsSvc.Upsert(ctx, model.UpsertTagRequest{Name: "bug"})).Error().To(Equal(errors.ErrConflict))
+			})
+		})
+
+		When("the id is not nil", func() {
+			It("renames the tag", func() {
+				tagId := id
+				renamed := bug
+				renamed.Name = "defect"
+				mockRepository.EXPECT().Update(gomock.Any(), entity.Tag{Id: id, Name: "defect"}).Return(renamed, nil)
+				mockRepository.EXPECT().GetTaskIds(gomock.Any(), id).Return([]int{3}, nil)
+
+				Expect(tagsSvc.Upsert(ctx, model.UpsertTagRequest{Id: &tagId, Name: "defect"})).
+					To(Equal(model.EntityToGetTagResponse(renamed, 1)))
+			})
+		})
+
+	})
+
+	Describe("RemoveById", func() {
+		It("forwards the error of the repository", func() {
+			mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Tag{}, errors.ErrNotFound)
+
+			Expect(tagsSvc.RemoveById(ctx, id)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+
+		When("the task is not found", func() {
+			It("returns ErrNotFound", func() {
+				mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+				Expect(tagsSvc.GetByTaskId(ctx, taskId)).Error().To(Equal(errors.ErrNotFound))
+			})
+		})
+
+		When("the task is found", func() {
+			It("returns its tags", func() {
+				mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil)
+				mockRepository.EXPECT().GetTagIds(gomock.Any(), taskId).Return([]int{id}, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(bug, nil)
+				mockRepository.EXPECT().GetTaskIds(gomock.Any(), id).Return([]int{taskId}, nil)
+
+				Expect(tagsSvc.GetByTaskId(ctx, taskId)).To(Equal([]model.GetTagResponse{model.EntityToGetTagResponse(bug, 1)}))
+			})
+		})
+
+	})
+
+	Describe("Attach", func() {
+
+		When("the task is not found", func() {
+			It("returns ErrNotFound", func() {
+				mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+				Expect(tagsSvc.Attach(ctx, taskId, id)).To(Equal(errors.ErrNotFound))
+			})
+		})
+
+		When("the task is found", func() {
+			It("links the tag to the task", func() {
+				mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil)
+				mockRepository.EXPECT().Attach(gomock.Any(), taskId, id).Return(nil)
+
+				Expect(tagsSvc.Attach(ctx, taskId, id)).To(Succeed())
+			})
+		})
+
+	})
+
+	Describe("TagTask", func() {
+
+		It("returns ErrInvalidArgument for an invalid name", func() {
+			Expect(tagsSvc.TagTask(ctx, taskId, []string{"bug", "bug,urgent"})).To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("returns ErrNotFound when the task is not found", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+			Expect(tagsSvc.TagTask(ctx, taskId, []string{"bug"})).To(Equal(errors.ErrNotFound))
+		})
+
+		It("attaches the existing tags and creates the missing ones", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil)
+			mockRepository.EXPECT().GetByName(gomock.Any(), "bug").Return(bug, nil)
+			mockRepository.EXPECT().GetByName(gomock.Any(), "urgent").Return(entity.Tag{}, errors.ErrNotFound)
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.Tag{Name: "urgent"}).Return(entity.Tag{Id: 2, Name: "urgent"}, nil)
+			mockRepository.EXPECT().Attach(gomock.Any(), taskId, id).Return(nil)
+			mockRepository.EXPECT().Attach(gomock.Any(), taskId, 2).Return(nil)
+
+			Expect(tagsSvc.TagTask(ctx, taskId, []string{"bug", "Urgent"})).To(Succeed())
+		})
+
+	})
+
+	Describe("GetTaskIds", func() {
+
+		BeforeEach(func() {
+			mockRepository.EXPECT().GetByName(gomock.Any(), "bug").Return(bug, nil).AnyTimes()
+			mockRepository.EXPECT().GetByName(gomock.Any(), "urgent").Return(entity.Tag{Id: 2, Name: "urgent"}, nil).AnyTimes()
+			mockRepository.EXPECT().GetByName(gomock.Any(), "unknown").Return(entity.Tag{}, errors.ErrNotFound).AnyTimes()
+			mockRepository.EXPECT().GetTaskIds(gomock.Any(), id).Return([]int{3, 4}, nil).AnyTimes()
+			mockRepository.EXPECT().GetTaskIds(gomock.Any(), 2).Return([]int{4, 5}, nil).AnyTimes()
+		})
+
+		It("intersects the tasks when matching all the tags", func() {
+			Expect(tagsSvc.GetTaskIds(ctx, []string{"Bug", "urgent"}, true)).To(Equal(map[int]bool{4: true}))
+		})
+
+		It("unites the tasks when matching any tag", func() {
+			Expect(tagsSvc.GetTaskIds(ctx, []string{"bug", "urgent"}, false)).To(Equal(map[int]bool{3: true, 4: true, 5: true}))
+		})
+
+		It("matches nothing when one of all the tags is unknown", func() {
+			Expect(tagsSvc.GetTaskIds(ctx, []string{"bug", "unknown"}, true)).To(BeEmpty())
+		})
+
+		It("ignores the unknown tags when matching any tag", func() {
+			Expect(tagsSvc.GetTaskIds(ctx, []string{"unknown", "bug"}, false)).To(Equal(map[int]bool{3: true, 4: true}))
+		})
+	})
+
+	Describe("RemoveTask", func() {
+		It("detaches all the tags of the task", func() {
+			mockRepository.EXPECT().DetachAll(gomock.Any(), taskId).Return(nil)
+
+			Expect(tagsSvc.RemoveTask(ctx, taskId)).To(Succeed())
+		})
+	})
+
+})
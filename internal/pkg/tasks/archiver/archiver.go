@@ -0,0 +1,138 @@
+package archiver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tasksService "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultAge      = 30 * 24 * time.Hour
+	defaultInterval = time.Hour
+)
+
+type Archiver interface {
+	Start(ctx context.Context)
+	Tick(ctx context.Context) error
+	Check(ctx context.Context) error
+}
+
+type archiverImpl struct {
+	tasks    tasksService.Service
+	age      time.Duration
+	interval time.Duration
+	tenants  tenancy.Registry
+	clock    stubs.Clock
+	mutex    sync.RWMutex
+	lastErr  error
+}
+
+type ArchiverOption func(*archiverImpl)
+
+func New(options ...ArchiverOption) Archiver {
+	instance := archiverImpl{
+		age:      defaultAge,
+		interval: defaultInterval,
+		clock:    stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTasks(tasks tasksService.Service) ArchiverOption {
+	return func(archiver *archiverImpl) {
+		if archiver != nil {
+			archiver.tasks = tasks
+		}
+	}
+}
+
+func WithAge(age time.Duration) ArchiverOption {
+	return func(archiver *archiverImpl) {
+		if archiver != nil && age > 0 {
+			archiver.age = age
+		}
+	}
+}
+
+func WithInterval(interval time.Duration) ArchiverOption {
+	return func(archiver *archiverImpl) {
+		if archiver != nil && interval > 0 {
+			archiver.interval = interval
+		}
+	}
+}
+
+func WithTenants(tenants tenancy.Registry) ArchiverOption {
+	return func(archiver *archiverImpl) {
+		if archiver != nil {
+			archiver.tenants = tenants
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) ArchiverOption {
+	return func(archiver *archiverImpl) {
+		if archiver != nil && clock != nil {
+			archiver.clock = clock
+		}
+	}
+}
+
+func (archiver *archiverImpl) Start(ctx context.Context) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	go func() {
+		ticker := time.NewTicker(archiver.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := archiver.Tick(ctx); err != nil {
+				logger.Error(err, "Archiving tick failed")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (archiver *archiverImpl) Tick(ctx context.Context) error {
+	err := tenancy.Each(ctx, archiver.tenants, archiver.tick)
+
+	archiver.mutex.Lock()
+	defer archiver.mutex.Unlock()
+
+	archiver.lastErr = err
+	return err
+}
+
+func (archiver *archiverImpl) Check(context.Context) error {
+	archiver.mutex.RLock()
+	defer archiver.mutex.RUnlock()
+
+	return archiver.lastErr
+}
+
+func (archiver *archiverImpl) tick(ctx context.Context) error {
+	count, err := archiver.tasks.ArchiveDone(ctx, archiver.clock.Now().Add(-archiver.age))
+	if count > 0 {
+		logr.FromContextOrDiscard(ctx).V(1).Info("Archived done tasks", constants.Count, count)
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+package archiver_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/archiver"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+)
+
+var _ = Describe("Archiver", func() {
+
+	var (
+		ctx         context.Context
+		now         time.Time
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2023, time.March, 17, 9, 30, 0, 0, time.UTC)
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(archiver.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Tick", func() {
+		It("archives the tasks done before the configured age", func() {
+			mockService.EXPECT().ArchiveDone(gomock.Any(), now.Add(-72*time.Hour)).Return(2, nil)
+
+			tasksArchiver := archiver.New(archiver.WithTasks(mockService), archiver.WithAge(72*time.Hour),
+				archiver.WithClock(stubs.NewFixed(now)))
+
+			Expect(tasksArchiver.Tick(ctx)).To(Succeed())
+		})
+
+		It("defaults to an age of 30 days", func() {
+			mockService.EXPECT().ArchiveDone(gomock.Any(), now.Add(-30*24*time.Hour)).Return(0, nil)
+
+			tasksArchiver := archiver.New(archiver.WithTasks(mockService), archiver.WithAge(0),
+				archiver.WithClock(stubs.NewFixed(now)))
+
+			Expect(tasksArchiver.Tick(ctx)).To(Succeed())
+		})
+
+		It("returns the error of the service", func() {
+			customErr := fmt.Errorf("custom error")
+			mockService.EXPECT().ArchiveDone(gomock.Any(), gomock.Any()).Return(0, customErr)
+
+			tasksArchiver := archiver.New(archiver.WithTasks(mockService), archiver.WithClock(stubs.NewFixed(now)))
+
+			Expect(tasksArchiver.Tick(ctx)).To(Equal(customErr))
+		})
+
+		It("archives the tasks of every tenant", func() {
+			var tenants []string
+			mockService.EXPECT().ArchiveDone(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ time.Time) (int, error) {
+				tenants = append(tenants, tenancy.Of(ctx))
+				return 0, nil
+			}).Times(2)
+
+			tasksArchiver := archiver.New(archiver.WithTasks(mockService), archiver.WithClock(stubs.NewFixed(now)),
+				archiver.WithTenants(tenancy.NewRegistry("acme")))
+
+			Expect(tasksArchiver.Tick(ctx)).To(Succeed())
+			Expect(tenants).To(Equal([]string{"acme", tenancy.DefaultTenant}))
+		})
+	})
+
+	Describe("Check", func() {
+		It("returns the error of the last tick until a tick succeeds", func() {
+			customErr := fmt.Errorf("custom error")
+			gomock.InOrder(
+				mockService.EXPECT().ArchiveDone(gomock.Any(), gomock.Any()).Return(0, customErr),
+				mockService.EXPECT().ArchiveDone(gomock.Any(), gomock.Any()).Return(0, nil),
+			)
+
+			tasksArchiver := archiver.New(archiver.WithTasks(mockService), archiver.WithClock(stubs.NewFixed(now)))
+			Expect(tasksArchiver.Check(ctx)).To(Succeed())
+
+			Expect(tasksArchiver.Tick(ctx)).To(Equal(customErr))
+			Expect(tasksArchiver.Check(ctx)).To(Equal(customErr))
+
+			Expect(tasksArchiver.Tick(ctx)).To(Succeed())
+			Expect(tasksArchiver.Check(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("Start", func() {
+		It("ticks until the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			ticked := make(chan struct{}, 1)
+			mockService.EXPECT().ArchiveDone(gomock.Any(), gomock.Any()).DoAndReturn(func(any, time.Time) (int, error) {
+				select {
+				case ticked <- struct{}{}:
+				default:
+				}
+				return 0, nil
+			}).MinTimes(1)
+
+			archiver.New(archiver.WithTasks(mockService), archiver.WithInterval(time.Millisecond)).Start(ctx)
+
+			Eventually(ticked).Should(Receive())
+		})
+	})
+
+})
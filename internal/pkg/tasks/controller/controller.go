@@ -1,10 +1,12 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
@@ -13,27 +15,74 @@ import (
 	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
 	model "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
 	service "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
 	"github.com/go-logr/logr"
 )
 
 const (
-	getByIdFailed    = "GetById failed"
-	getByIdResponse  = "GetById response"
-	getAllFailed     = "GetAll failed"
-	getAllResponse   = "GetAll response"
-	addFailed        = "Add failed"
-	addResponse      = "Add response"
-	updateFailed     = "Update failed"
-	updateResponse   = "Update response"
-	removeByIdFailed = "RemoveById failed"
+	getByIdFailed           = "GetById failed"
+	getByIdResponse         = "GetById response"
+	getAllFailed            = "GetAll failed"
+	getAllResponse          = "GetAll response"
+	getOccurrencesFailed    = "GetOccurrences failed"
+	getOccurrencesResponse  = "GetOccurrences response"
+	getChildrenFailed       = "GetChildren failed"
+	getChildrenResponse     = "GetChildren response"
+	getTreeFailed           = "GetTree failed"
+	getTreeResponse         = "GetTree response"
+	getDependenciesFailed   = "GetDependencies failed"
+	getDependenciesResponse = "GetDependencies response"
+	getNextFailed           = "GetNext failed"
+	getNextResponse         = "GetNext response"
+	addFailed               = "Add failed"
+	addResponse             = "Add response"
+	updateFailed            = "Update failed"
+	updateResponse          = "Update response"
+	moveFailed              = "Move failed"
+	moveResponse            = "Move response"
+	cloneFailed             = "Clone failed"
+	cloneResponse           = "Clone response"
+	archiveFailed           = "Archive failed"
+	archiveResponse         = "Archive response"
+	unarchiveFailed         = "Unarchive failed"
+	unarchiveResponse       = "Unarchive response"
+	quickAddFailed          = "QuickAdd failed"
+	quickAddResponse        = "QuickAdd response"
+	removeByIdFailed        = "RemoveById failed"
+	addDependencyFailed     = "AddDependency failed"
+	removeDependencyFailed  = "RemoveDependency failed"
+
+	viewerRequired = "The operation requires the viewer role on the list"
+	editorRequired = "The operation requires the editor role on the list"
+	quotaExceeded  = "The tenant has reached its quota"
+)
+
+const (
+	defaultOccurrencesCount = 5
+	maxOccurrencesCount     = 100
+
+	assigneeMe = "me"
 )
 
 type Controller interface {
 	GetById(w http.ResponseWriter, r *http.Request)
 	GetAll(w http.ResponseWriter, r *http.Request)
+	GetOccurrences(w http.ResponseWriter, r *http.Request)
+	GetChildren(w http.ResponseWriter, r *http.Request)
+	GetTree(w http.ResponseWriter, r *http.Request)
+	GetDependencies(w http.ResponseWriter, r *http.Request)
+	GetNext(w http.ResponseWriter, r *http.Request)
 	Add(w http.ResponseWriter, r *http.Request)
 	Update(w http.ResponseWriter, r *http.Request)
+	Move(w http.ResponseWriter, r *http.Request)
+	Clone(w http.ResponseWriter, r *http.Request)
+	QuickAdd(w http.ResponseWriter, r *http.Request)
+	Archive(w http.ResponseWriter, r *http.Request)
+	Unarchive(w http.ResponseWriter, r *http.Request)
 	RemoveById(w http.ResponseWriter, r *http.Request)
+	AddDependency(w http.ResponseWriter, r *http.Request)
+	RemoveDependency(w http.ResponseWriter, r *http.Request)
 }
 
 type controllerImpl struct {
@@ -70,10 +119,12 @@ func (ctrl *controllerImpl) GetById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	entity, err := ctrl.service.GetById(id)
+	entity, err := ctrl.service.GetById(r.Context(), id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
 		} else {
 			logger.Error(err, getAllFailed, constants.Id, id)
 			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
@@ -89,10 +140,37 @@ func (ctrl *controllerImpl) GetById(w http.ResponseWriter, r *http.Request) {
 func (ctrl *controllerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
 	logger := logr.FromContextOrDiscard(r.Context())
 
-	entity, err := ctrl.service.GetAll()
+	request := model.GetTasksRequest{
+		ListId:          getListId(r),
+		Sort:            urlparams.ParseQueryParam(r, constants.Sort),
+		Tags:            urlparams.ParseQueryParams(r, constants.Tag),
+		TagMatch:        urlparams.ParseQueryParam(r, constants.TagMatch),
+		IncludeArchived: urlparams.ParseQueryFlag(r, constants.IncludeArchived),
+	}
+
+	var stop bool
+	if request.AssigneeId, stop = getAssigneeOrStop(w, r); stop {
+		return
+	}
+
+	if !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, getAllFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+			fmt.Sprintf("%v should be one of %v, %v or %v and %v one of %v or %v", constants.Sort,
+				model.SortById, model.SortByRank, model.SortByPriority, constants.TagMatch, model.TagMatchAll, model.TagMatchAny)))
+		return
+	}
+
+	entity, err := ctrl.service.GetAll(r.Context(), request)
 	if err != nil {
-		logger.Error(err, getAllFailed)
-		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getAllFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
 		return
 	}
 
@@ -106,6 +184,152 @@ func (ctrl *controllerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
 	_ = marshaller.SerializeEntity(w, entity)
 }
 
+func (ctrl *controllerImpl) GetOccurrences(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	count := defaultOccurrencesCount
+	if value := urlparams.ParseQueryParam(r, constants.Count); value != "" {
+		var err error
+		count, err = strconv.Atoi(value)
+		if err != nil || count <= 0 || count > maxOccurrencesCount {
+			logger.Error(errors.ErrInvalidArgument, getOccurrencesFailed, constants.Field, constants.Count)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+				fmt.Sprintf("%v should be an integer between 1 and %v", constants.Count, maxOccurrencesCount)))
+			return
+		}
+	}
+
+	dto, err := ctrl.service.GetOccurrences(r.Context(), id, count)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getOccurrencesFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(dto.Occurrences) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getOccurrencesResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) GetChildren(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.GetChildren(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getChildrenFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(dto) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getChildrenResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) GetTree(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.GetTree(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getTreeFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(getTreeResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) GetDependencies(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.GetDependencies(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getDependenciesFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(getDependenciesResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) GetNext(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	dto, err := ctrl.service.GetNext(r.Context())
+	if err != nil {
+		logger.Error(err, getNextFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	if len(dto) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getNextResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
 func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
 	logger := logr.FromContextOrDiscard(r.Context())
 
@@ -131,11 +355,32 @@ func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
 	}
 
 	request.Id = nil
+	if listId := getListId(r); listId != nil {
+		request.ListId = listId
+	}
 
-	entity, err := ctrl.service.Upsert(request)
+	override, stop := getOverrideOrStop(w, r)
+	if stop {
+		return
+	}
+	request.OverrideWipLimit = override
+
+	entity, err := ctrl.service.Upsert(r.Context(), request)
 	if err != nil {
-		logger.Error(err, addFailed)
-		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		} else if exceeded, ok := err.(wipModel.ExceededError); ok {
+			_ = marshaller.SerializeError(w, limitExceeded(exceeded))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else if err == errors.ErrQuotaExceeded {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusTooManyRequests, quotaExceeded))
+		} else {
+			logger.Error(err, addFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
 		return
 	}
 
@@ -178,13 +423,25 @@ func (ctrl *controllerImpl) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	request.Id = &id
+	request.OverrideWipLimit, stop = getOverrideOrStop(w, r)
+	if stop {
+		return
+	}
 
-	entity, err := ctrl.service.Upsert(request)
+	entity, err := ctrl.service.Upsert(r.Context(), request)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			w.WriteHeader(http.StatusNotFound)
 		} else if err == errors.ErrNotModified {
 			w.WriteHeader(http.StatusNotModified)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		} else if exceeded, ok := err.(wipModel.ExceededError); ok {
+			_ = marshaller.SerializeError(w, limitExceeded(exceeded))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else if err == errors.ErrQuotaExceeded {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusTooManyRequests, quotaExceeded))
 		} else {
 			logger.Error(err, updateFailed)
 			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
@@ -197,6 +454,188 @@ func (ctrl *controllerImpl) Update(w http.ResponseWriter, r *http.Request) {
 	_ = marshaller.SerializeEntity(w, entity)
 }
 
+func (ctrl *controllerImpl) Move(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(err, moveFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	request := model.MoveTaskRequest{}
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		logger.Error(err, moveFailed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if !request.IsValid(id) {
+		logger.Error(errors.ErrInvalidArgument, moveFailed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	request.OverrideWipLimit, stop = getOverrideOrStop(w, r)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.Move(r.Context(), id, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid list or anchors"))
+		} else if exceeded, ok := err.(wipModel.ExceededError); ok {
+			_ = marshaller.SerializeError(w, limitExceeded(exceeded))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, moveFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(moveResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Clone(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.Clone(r.Context(), id, urlparams.ParseQueryFlag(r, constants.Deep))
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, cloneFailed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, entity.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(cloneResponse, constants.Payload, entity)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) QuickAdd(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(err, quickAddFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	request := model.QuickAddRequest{}
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		logger.Error(err, quickAddFailed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, quickAddFailed, constants.Body, string(body))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	var entity any
+	dryRun := urlparams.ParseQueryFlag(r, constants.DryRun)
+	if dryRun {
+		entity, err = ctrl.service.ParseQuick(r.Context(), request)
+	} else {
+		entity, err = ctrl.service.QuickAdd(r.Context(), request)
+	}
+	if err != nil {
+		if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+				"The text has no task name, an invalid tag or an unknown context"))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else if err == errors.ErrQuotaExceeded {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusTooManyRequests, quotaExceeded))
+		} else {
+			logger.Error(err, quickAddFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(quickAddResponse, constants.Payload, entity)
+
+	if !dryRun {
+		location := fmt.Sprintf("%s/%d", r.Host, entity.(model.GetTaskResponse).Id)
+		logger.V(1).Info("Added entity location", constants.Location, location)
+
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusCreated)
+	}
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Archive(w http.ResponseWriter, r *http.Request) {
+	ctrl.setArchived(w, r, ctrl.service.Archive, archiveFailed, archiveResponse)
+}
+
+func (ctrl *controllerImpl) Unarchive(w http.ResponseWriter, r *http.Request) {
+	ctrl.setArchived(w, r, ctrl.service.Unarchive, unarchiveFailed, unarchiveResponse)
+}
+
+func (ctrl *controllerImpl) setArchived(w http.ResponseWriter, r *http.Request,
+	update func(ctx context.Context, id int) (model.GetTaskResponse, error), failed string, response string) {
+
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	entity, err := update(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrNotModified {
+			w.WriteHeader(http.StatusNotModified)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, failed, constants.Id, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(response, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
 func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
 	logger := logr.FromContextOrDiscard(r.Context())
 
@@ -205,10 +644,14 @@ func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := ctrl.service.RemoveById(id)
+	err := ctrl.service.RemoveById(r.Context(), id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, "The task has subtasks"))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
 		} else {
 			logger.Error(err, removeByIdFailed, constants.Id, id)
 			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
@@ -219,6 +662,61 @@ func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (ctrl *controllerImpl) AddDependency(w http.ResponseWriter, r *http.Request) {
+	ctrl.dependency(w, r, ctrl.service.AddDependency, addDependencyFailed)
+}
+
+func (ctrl *controllerImpl) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	ctrl.dependency(w, r, ctrl.service.RemoveDependency, removeDependencyFailed)
+}
+
+func (ctrl *controllerImpl) dependency(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, id int, blockerId int) error, failed string) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := getIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	blockerId, stop := getBlockerIdOrStop(w, r)
+	if stop {
+		return
+	}
+
+	err := action(r.Context(), id, blockerId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrInvalidArgument {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "A task cannot block itself"))
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, "The dependency would create a cycle"))
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, failed, constants.Id, id, constants.BlockerId, blockerId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getOverrideOrStop(w http.ResponseWriter, r *http.Request) (override bool, stop bool) {
+	override = urlparams.ParseQueryFlag(r, constants.OverrideWipLimit)
+	if override && !reqctx.IsAdmin(r.Context()) {
+		logr.FromContextOrDiscard(r.Context()).Error(errors.ErrForbidden, "Cannot override the WIP limits")
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, "Only administrators can override the WIP limits"))
+		return false, true
+	}
+	return override, false
+}
+
+func limitExceeded(exceeded wipModel.ExceededError) errorModel.Response {
+	return errorModel.New(http.StatusConflict, "The WIP limit of the status is reached", errorModel.WithDetails(exceeded))
+}
+
 func getIdOrStop(w http.ResponseWriter, r *http.Request) (id int, stop bool) {
 	ctx := r.Context()
 	value, err := reqctx.GetPathParam(ctx, constants.Id)
@@ -236,3 +734,64 @@ func getIdOrStop(w http.ResponseWriter, r *http.Request) (id int, stop bool) {
 		"Unable to retrieve the Task Id"))
 	return 0, true
 }
+
+func getBlockerIdOrStop(w http.ResponseWriter, r *http.Request) (blockerId int, stop bool) {
+	ctx := r.Context()
+	value, err := reqctx.GetPathParam(ctx, constants.BlockerId)
+	if err == nil {
+		blockerId, err = value.Int()
+		if err == nil {
+			return blockerId, false
+		}
+	}
+
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Error(err, "Cannot retrieve field from context", constants.Field, constants.BlockerId)
+
+	_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError,
+		"Unable to retrieve the Blocker Id"))
+	return 0, true
+}
+
+func getAssigneeOrStop(w http.ResponseWriter, r *http.Request) (assigneeId *int, stop bool) {
+	value := urlparams.ParseQueryParam(r, constants.Assignee)
+	if value == "" {
+		return nil, false
+	}
+
+	ctx := r.Context()
+	logger := logr.FromContextOrDiscard(ctx)
+
+	if value == assigneeMe {
+		principal, err := reqctx.GetPrincipal(ctx)
+		if err != nil || principal.UserId == nil {
+			logger.Error(errors.ErrInvalidArgument, getAllFailed, constants.Field, constants.Assignee)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+				fmt.Sprintf("%v=%v requires credentials bound to a user", constants.Assignee, assigneeMe)))
+			return nil, true
+		}
+		return principal.UserId, false
+	}
+
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 0 {
+		logger.Error(errors.ErrInvalidArgument, getAllFailed, constants.Field, constants.Assignee)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest,
+			fmt.Sprintf("%v should be %v or a user id", constants.Assignee, assigneeMe)))
+		return nil, true
+	}
+	return &id, false
+}
+
+func getListId(r *http.Request) *int {
+	value, err := reqctx.GetPathParam(r.Context(), constants.ListId)
+	if err != nil {
+		return nil
+	}
+
+	listId, err := value.Int()
+	if err != nil {
+		return nil
+	}
+	return &listId
+}
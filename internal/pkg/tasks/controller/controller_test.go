@@ -20,6 +20,7 @@ import (
 	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/controller"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
 	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
 )
 
@@ -60,7 +61,7 @@ var _ = Describe("Controller", func() {
 	Describe("WithService", func() {
 		It("changes a non-nil instance", func() {
 			customErr := fmt.Errorf("some random error")
-			mockService.EXPECT().GetAll().Return(nil, customErr)
+			mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, customErr)
 
 			tasksCtrl.GetAll(recorder, &http.Request{})
 
@@ -100,7 +101,7 @@ var _ = Describe("Controller", func() {
 
 			When("the entity is not found", func() {
 				It("responds with status NotFound and no payload", func() {
-					mockService.EXPECT().GetById(gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotFound)
+					mockService.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotFound)
 
 					tasksCtrl.GetById(recorder, request)
 
@@ -109,10 +110,20 @@ var _ = Describe("Controller", func() {
 				})
 			})
 
+			When("the caller may not view the list of the entity", func() {
+				It("responds with status Forbidden", func() {
+					mockService.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrForbidden)
+
+					tasksCtrl.GetById(recorder, request)
+
+					Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				})
+			})
+
 			When("an error happens while retrieving the entity", func() {
 				It("responds with status InternalServerError and an error response payload", func() {
 					customErr := fmt.Errorf("custom error")
-					mockService.EXPECT().GetById(gomock.Any()).Return(model.GetTaskResponse{}, customErr)
+					mockService.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, customErr)
 
 					tasksCtrl.GetById(recorder, request)
 
@@ -139,7 +150,7 @@ var _ = Describe("Controller", func() {
 						CreatedAt:   timestamp,
 						UpdatedAt:   timestamp.Add(2 * time.Hour),
 					}
-					mockService.EXPECT().GetById(gomock.Any()).Return(entity, nil)
+					mockService.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(entity, nil)
 
 					tasksCtrl.GetById(recorder, request)
 
@@ -168,7 +179,7 @@ var _ = Describe("Controller", func() {
 		When("an error happens while retrieving the list of entities", func() {
 			It("responds with status InternalServerError and an error response payload", func() {
 				customErr := fmt.Errorf("custom error")
-				mockService.EXPECT().GetAll().Return(nil, customErr)
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, customErr)
 
 				tasksCtrl.GetAll(recorder, request)
 
@@ -184,9 +195,138 @@ var _ = Describe("Controller", func() {
 			})
 		})
 
+		When("the sort is unknown", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request = httptest.NewRequest("", url+"?sort=name", nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the sort is known", func() {
+			It("forwards it to the service", func() {
+				request = httptest.NewRequest("", url+"?sort=rank", nil)
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{Sort: model.SortByRank}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the tasks of a list are requested", func() {
+			It("forwards the list id to the service", func() {
+				request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.ListId, "3"))
+				listId := 3
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{ListId: &listId}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+
+			It("responds with status NotFound when the list is not found", func() {
+				request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.ListId, "3"))
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("tags are requested", func() {
+			It("forwards them to the service", func() {
+				request = httptest.NewRequest("", url+"?tag=bug&tag=urgent&tagMatch=any", nil)
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{
+					Tags:     []string{"bug", "urgent"},
+					TagMatch: model.TagMatchAny,
+				}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the archived tasks are requested", func() {
+			It("forwards the flag to the service", func() {
+				request = httptest.NewRequest("", url+"?includeArchived=true", nil)
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{IncludeArchived: true}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the tasks of a numeric assignee are requested", func() {
+			It("forwards the assignee to the service", func() {
+				assigneeId := 3
+				request = httptest.NewRequest("", url+"?assignee=3", nil)
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{AssigneeId: &assigneeId}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the tasks of the authenticated user are requested", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest("", url+"?assignee=me", nil)
+			})
+
+			It("forwards the user of the principal to the service", func() {
+				userId := 5
+				request = request.WithContext(reqctx.SetPrincipal(request.Context(), reqctx.Principal{Id: "jane", UserId: &userId}))
+				mockService.EXPECT().GetAll(gomock.Any(), model.GetTasksRequest{AssigneeId: &userId}).Return(nil, nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+
+			It("responds with status BadRequest when the principal has no user", func() {
+				request = request.WithContext(reqctx.SetPrincipal(request.Context(), reqctx.Principal{Id: "admin"}))
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("responds with status BadRequest without principal", func() {
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the assignee is neither me nor a user id", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request = httptest.NewRequest("", url+"?assignee=jane", nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the tag match is unknown", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request = httptest.NewRequest("", url+"?tag=bug&tagMatch=none", nil)
+
+				tasksCtrl.GetAll(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
 		When("the list of entities is empty", func() {
 			It("responds with status NoContent and no payload", func() {
-				mockService.EXPECT().GetAll().Return(nil, nil)
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 				tasksCtrl.GetAll(recorder, request)
 
@@ -216,7 +356,7 @@ var _ = Describe("Controller", func() {
 						UpdatedAt:   timestamp.Add(27 * time.Hour),
 					},
 				}
-				mockService.EXPECT().GetAll().Return(list, nil)
+				mockService.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(list, nil)
 
 				tasksCtrl.GetAll(recorder, request)
 
@@ -232,6 +372,167 @@ var _ = Describe("Controller", func() {
 
 	})
 
+	Describe("GetOccurrences", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			request = request.WithContext(ctx)
+		})
+
+		When("the count is not a valid integer", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request = httptest.NewRequest("", url+"?count=many", nil).WithContext(request.Context())
+
+				tasksCtrl.GetOccurrences(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the count is out of range", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				request = httptest.NewRequest("", url+"?count=101", nil).WithContext(request.Context())
+
+				tasksCtrl.GetOccurrences(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().GetOccurrences(gomock.Any(), 1, 5).Return(model.GetOccurrencesResponse{}, errors.ErrNotFound)
+
+				tasksCtrl.GetOccurrences(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+		When("the entity is not recurring", func() {
+			It("responds with status NoContent and no payload", func() {
+				mockService.EXPECT().GetOccurrences(gomock.Any(), 1, 5).Return(model.GetOccurrencesResponse{Id: 1}, nil)
+
+				tasksCtrl.GetOccurrences(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+		When("the entity is recurring", func() {
+			It("responds with status OK and the occurrences in the payload", func() {
+				timestamp := time.UnixMilli(1679143523000).UTC()
+				dto := model.GetOccurrencesResponse{
+					Id:          1,
+					Recurrence:  "FREQ=DAILY",
+					Occurrences: []time.Time{timestamp, timestamp.Add(24 * time.Hour)},
+				}
+				request = httptest.NewRequest("", url+"?count=2", nil).WithContext(request.Context())
+				mockService.EXPECT().GetOccurrences(gomock.Any(), 1, 2).Return(dto, nil)
+
+				tasksCtrl.GetOccurrences(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetOccurrencesResponse
+				err := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payload).To(Equal(dto))
+			})
+		})
+
+	})
+
+	Describe("GetChildren", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.Id, "1"))
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().GetChildren(gomock.Any(), 1).Return(nil, errors.ErrNotFound)
+
+				tasksCtrl.GetChildren(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the entity has no children", func() {
+			It("responds with status NoContent and no payload", func() {
+				mockService.EXPECT().GetChildren(gomock.Any(), 1).Return(nil, nil)
+
+				tasksCtrl.GetChildren(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the entity has children", func() {
+			It("responds with status OK and the children in the payload", func() {
+				children := []model.GetTaskTreeResponse{{GetTaskResponse: model.GetTaskResponse{Id: 2}, Completion: 100}}
+				mockService.EXPECT().GetChildren(gomock.Any(), 1).Return(children, nil)
+
+				tasksCtrl.GetChildren(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"completion":100`))
+			})
+		})
+
+	})
+
+	Describe("GetTree", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.Id, "1"))
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().GetTree(gomock.Any(), 1).Return(model.GetTaskTreeResponse{}, errors.ErrNotFound)
+
+				tasksCtrl.GetTree(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the entity is found", func() {
+			It("responds with status OK and the nested tree in the payload", func() {
+				tree := model.GetTaskTreeResponse{
+					GetTaskResponse: model.GetTaskResponse{Id: 1},
+					Completion:      50,
+					Children:        []model.GetTaskTreeResponse{{GetTaskResponse: model.GetTaskResponse{Id: 2}}},
+				}
+				mockService.EXPECT().GetTree(gomock.Any(), 1).Return(tree, nil)
+
+				tasksCtrl.GetTree(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetTaskTreeResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Completion).To(Equal(50))
+				Expect(payload.Children).To(HaveExactElements(HaveField("Id", 2)))
+			})
+		})
+
+	})
+
 	Describe("Add", func() {
 		var request *http.Request
 
@@ -338,10 +639,56 @@ var _ = Describe("Controller", func() {
 			})
 		})
 
+		When("the task is added to a list", func() {
+			It("takes the list id from the path", func() {
+				request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.ListId, "3"))
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
+					Expect(request.ListId).To(HaveValue(Equal(3)))
+					return model.GetTaskResponse{}, errors.ErrNotFound
+				})
+
+				tasksCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the service rejects the request content", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrInvalidArgument)
+
+				tasksCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+				var payload errorModel.Response
+				err := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payload.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the tenant quota is exhausted", func() {
+			It("responds with status TooManyRequests and an error response payload", func() {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrQuotaExceeded)
+
+				tasksCtrl.Add(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+
+				var payload errorModel.Response
+				err := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payload.Code).To(Equal(http.StatusTooManyRequests))
+			})
+		})
+
 		When("an error happens while adding the entity", func() {
 			It("responds with status InternalServerError and an error response payload", func() {
 				customErr := fmt.Errorf("custom error")
-				mockService.EXPECT().Upsert(gomock.Any()).Return(model.GetTaskResponse{}, customErr)
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, customErr)
 
 				tasksCtrl.Add(recorder, request)
 
@@ -368,7 +715,7 @@ var _ = Describe("Controller", func() {
 					CreatedAt:   timestamp,
 					UpdatedAt:   timestamp,
 				}
-				mockService.EXPECT().Upsert(gomock.Any()).Return(entity, nil)
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(entity, nil)
 
 				tasksCtrl.Add(recorder, request)
 
@@ -524,7 +871,7 @@ var _ = Describe("Controller", func() {
 
 			When("the entity is not found", func() {
 				It("responds with status NotFound and no payload", func() {
-					mockService.EXPECT().Upsert(gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotFound)
+					mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotFound)
 
 					tasksCtrl.Update(recorder, request)
 
@@ -535,7 +882,7 @@ var _ = Describe("Controller", func() {
 
 			When("the entity is found but not modified", func() {
 				It("responds with status NotModified and no payload", func() {
-					mockService.EXPECT().Upsert(gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotModified)
+					mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotModified)
 
 					tasksCtrl.Update(recorder, request)
 
@@ -544,10 +891,20 @@ var _ = Describe("Controller", func() {
 				})
 			})
 
+			When("the caller may not edit the list of the entity", func() {
+				It("responds with status Forbidden", func() {
+					mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrForbidden)
+
+					tasksCtrl.Update(recorder, request)
+
+					Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				})
+			})
+
 			When("an error happens while retrieving the entity", func() {
 				It("responds with status InternalServerError and an error response payload", func() {
 					customErr := fmt.Errorf("custom error")
-					mockService.EXPECT().Upsert(gomock.Any()).Return(model.GetTaskResponse{}, customErr)
+					mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, customErr)
 
 					tasksCtrl.Update(recorder, request)
 
@@ -574,7 +931,7 @@ var _ = Describe("Controller", func() {
 						CreatedAt:   timestamp,
 						UpdatedAt:   timestamp.Add(2 * time.Hour),
 					}
-					mockService.EXPECT().Upsert(gomock.Any()).Return(entity, nil)
+					mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(entity, nil)
 
 					tasksCtrl.Update(recorder, request)
 
@@ -592,6 +949,311 @@ var _ = Describe("Controller", func() {
 
 	})
 
+	Describe("Move", func() {
+
+		var request *http.Request
+
+		newRequest := func(body string) *http.Request {
+			request := httptest.NewRequest("", url, strings.NewReader(body))
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			return request.WithContext(ctx)
+		}
+
+		BeforeEach(func() {
+			request = newRequest(`{"after": 2}`)
+		})
+
+		When("the request payload format is wrong", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				tasksCtrl.Move(recorder, newRequest("{"))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the request payload has no anchor", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				tasksCtrl.Move(recorder, newRequest("{}"))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrNotFound)
+
+				tasksCtrl.Move(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+		When("the anchors are rejected by the service", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				mockService.EXPECT().Move(gomock.Any(), 1, gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrInvalidArgument)
+
+				tasksCtrl.Move(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the entity is moved", func() {
+			It("responds with status OK and the moved entity in the payload", func() {
+				after := 2
+				entity := model.GetTaskResponse{Id: 1, Name: "A task", Rank: "r"}
+				mockService.EXPECT().Move(gomock.Any(), 1, model.MoveTaskRequest{After: &after}).Return(entity, nil)
+
+				tasksCtrl.Move(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetTaskResponse
+				err := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payload).To(Equal(entity))
+			})
+		})
+
+	})
+
+	Describe("WIP limits", func() {
+
+		newRequest := func(body string, query string, admin bool) *http.Request {
+			request := httptest.NewRequest("", url+query, strings.NewReader(body))
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			return request.WithContext(reqctx.SetAdmin(ctx, admin))
+		}
+
+		It("responds with status Conflict and the occupancy when an update reaches the limit", func() {
+			listId := 2
+			exceeded := wipModel.ExceededError{StatusId: 1, ListId: &listId, Limit: 2, Count: 2}
+			mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, exceeded)
+
+			tasksCtrl.Update(recorder, newRequest(`{"id": 1, "name": "A task", "statusId": 1}`, "", false))
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+
+			var payload errorModel.Response
+			Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+			Expect(payload.Details).To(Equal(map[string]any{"statusId": 1.0, "listId": 2.0, "limit": 2.0, "count": 2.0}))
+		})
+
+		It("responds with status Conflict when a move reaches the limit", func() {
+			mockService.EXPECT().Move(gomock.Any(), 1, gomock.Any()).Return(model.GetTaskResponse{}, wipModel.ExceededError{StatusId: 1, Limit: 1, Count: 1})
+
+			tasksCtrl.Move(recorder, newRequest(`{"statusId": 1}`, "", false))
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("responds with status Forbidden when a non-administrator overrides the limits", func() {
+			tasksCtrl.Move(recorder, newRequest(`{"statusId": 1}`, "?overrideWipLimit", false))
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("lets an administrator override the limits", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
+				Expect(request.OverrideWipLimit).To(BeTrue())
+				return model.GetTaskResponse{Id: 4, Name: "A task", StatusId: 1}, nil
+			})
+
+			tasksCtrl.Add(recorder, newRequest(`{"name": "A task", "statusId": 1}`, "?overrideWipLimit", true))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+		})
+
+	})
+
+	Describe("Archive", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.Id, "1"))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Archive(gomock.Any(), 1).Return(model.GetTaskResponse{}, err)
+
+				tasksCtrl.Archive(recorder, request)
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("already archived", errors.ErrNotModified, http.StatusNotModified),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status OK and the archived entity in the payload", func() {
+			entity := model.GetTaskResponse{Id: 1, Name: "A task", Archived: true}
+			mockService.EXPECT().Archive(gomock.Any(), 1).Return(entity, nil)
+
+			tasksCtrl.Archive(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var payload model.GetTaskResponse
+			Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+			Expect(payload).To(Equal(entity))
+		})
+
+		It("unarchives the entity", func() {
+			mockService.EXPECT().Unarchive(gomock.Any(), 1).Return(model.GetTaskResponse{Id: 1, Name: "A task"}, nil)
+
+			tasksCtrl.Unarchive(recorder, request)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+	})
+
+	Describe("Clone", func() {
+
+		newRequest := func(target string) *http.Request {
+			request := httptest.NewRequest("", target, nil)
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			return request.WithContext(ctx)
+		}
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().Clone(gomock.Any(), 1, false).Return(model.GetTaskResponse{}, errors.ErrNotFound)
+
+				tasksCtrl.Clone(recorder, newRequest(url))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+				Expect(recorder.Body.String()).To(BeEmpty())
+			})
+		})
+
+		When("an error happens while cloning", func() {
+			It("responds with status InternalServerError", func() {
+				mockService.EXPECT().Clone(gomock.Any(), 1, false).Return(model.GetTaskResponse{}, fmt.Errorf("custom error"))
+
+				tasksCtrl.Clone(recorder, newRequest(url))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the entity is deep cloned", func() {
+			It("responds with status Created, the location and the clone in the payload", func() {
+				entity := model.GetTaskResponse{Id: 7, Name: "A task"}
+				mockService.EXPECT().Clone(gomock.Any(), 1, true).Return(entity, nil)
+
+				tasksCtrl.Clone(recorder, newRequest(url+"?deep"))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(HaveSuffix("/7"))
+
+				var payload model.GetTaskResponse
+				err := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payload).To(Equal(entity))
+			})
+		})
+
+	})
+
+	Describe("QuickAdd", func() {
+
+		const text = `{"text": "Pay rent tomorrow #finance"}`
+
+		newRequest := func(target, body string) *http.Request {
+			return httptest.NewRequest("", target, strings.NewReader(body))
+		}
+
+		DescribeTable("rejects invalid payloads",
+			func(body string) {
+				tasksCtrl.QuickAdd(recorder, newRequest(url, body))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("malformed", "{"),
+			Entry("without text", "{}"),
+			Entry("with a blank text", `{"text": "  "}`),
+		)
+
+		When("the text cannot be parsed", func() {
+			It("responds with status BadRequest and an error response payload", func() {
+				mockService.EXPECT().QuickAdd(gomock.Any(), model.QuickAddRequest{Text: "Pay rent tomorrow #finance"}).
+					Return(model.GetTaskResponse{}, errors.ErrInvalidArgument)
+
+				tasksCtrl.QuickAdd(recorder, newRequest(url, text))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the tenant quota is exhausted", func() {
+			It("responds with status TooManyRequests", func() {
+				mockService.EXPECT().QuickAdd(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, errors.ErrQuotaExceeded)
+
+				tasksCtrl.QuickAdd(recorder, newRequest(url, text))
+
+				Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+			})
+		})
+
+		When("an error happens while adding", func() {
+			It("responds with status InternalServerError", func() {
+				mockService.EXPECT().QuickAdd(gomock.Any(), gomock.Any()).Return(model.GetTaskResponse{}, fmt.Errorf("custom error"))
+
+				tasksCtrl.QuickAdd(recorder, newRequest(url, text))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the task is added", func() {
+			It("responds with status Created, the location and the entity in the payload", func() {
+				entity := model.GetTaskResponse{Id: 3, Name: "Pay rent"}
+				mockService.EXPECT().QuickAdd(gomock.Any(), gomock.Any()).Return(entity, nil)
+
+				tasksCtrl.QuickAdd(recorder, newRequest(url, text))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(HaveSuffix("/3"))
+
+				var payload model.GetTaskResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(entity))
+			})
+		})
+
+		When("it is a dry run", func() {
+			It("responds with status OK and the parsed request without adding the task", func() {
+				parsed := model.QuickTaskRequest{
+					UpsertTaskRequest: model.UpsertTaskRequest{Name: "Pay rent"},
+					Tags:              []string{"finance"},
+					Context:           "home",
+				}
+				mockService.EXPECT().ParseQuick(gomock.Any(), gomock.Any()).Return(parsed, nil)
+
+				tasksCtrl.QuickAdd(recorder, newRequest(url+"?dryRun", text))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Header().Get("Location")).To(BeEmpty())
+
+				var payload model.QuickTaskRequest
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Name).To(Equal("Pay rent"))
+				Expect(payload.Tags).To(Equal([]string{"finance"}))
+				Expect(payload.Context).To(Equal("home"))
+			})
+		})
+
+	})
+
 	Describe("RemoveById", func() {
 
 		var request *http.Request
@@ -624,7 +1286,7 @@ var _ = Describe("Controller", func() {
 
 			When("the entity is not found", func() {
 				It("responds with status NotFound and no payload", func() {
-					mockService.EXPECT().RemoveById(gomock.Any()).Return(errors.ErrNotFound)
+					mockService.EXPECT().RemoveById(gomock.Any(), gomock.Any()).Return(errors.ErrNotFound)
 
 					tasksCtrl.RemoveById(recorder, request)
 
@@ -633,10 +1295,20 @@ var _ = Describe("Controller", func() {
 				})
 			})
 
+			When("the entity has subtasks", func() {
+				It("responds with status Conflict and an error response payload", func() {
+					mockService.EXPECT().RemoveById(gomock.Any(), gomock.Any()).Return(errors.ErrConflict)
+
+					tasksCtrl.RemoveById(recorder, request)
+
+					Expect(recorder.Code).To(Equal(http.StatusConflict))
+				})
+			})
+
 			When("an error happens while removing", func() {
 				It("responds with status InternalServerError and an error response payload", func() {
 					customErr := fmt.Errorf("custom error")
-					mockService.EXPECT().RemoveById(gomock.Any()).Return(customErr)
+					mockService.EXPECT().RemoveById(gomock.Any(), gomock.Any()).Return(customErr)
 
 					tasksCtrl.RemoveById(recorder, request)
 
@@ -654,7 +1326,7 @@ var _ = Describe("Controller", func() {
 
 			When("the entity is found", func() {
 				It("responds with status NoContent and no payload", func() {
-					mockService.EXPECT().RemoveById(gomock.Any()).Return(nil)
+					mockService.EXPECT().RemoveById(gomock.Any(), gomock.Any()).Return(nil)
 
 					tasksCtrl.RemoveById(recorder, request)
 
@@ -666,4 +1338,136 @@ var _ = Describe("Controller", func() {
 		})
 	})
 
+	Describe("GetDependencies", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.Id, "1"))
+		})
+
+		When("the entity is not found", func() {
+			It("responds with status NotFound and no payload", func() {
+				mockService.EXPECT().GetDependencies(gomock.Any(), 1).Return(model.GetDependenciesResponse{}, errors.ErrNotFound)
+
+				tasksCtrl.GetDependencies(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the entity is found", func() {
+			It("responds with status OK and the dependencies in the payload", func() {
+				mockService.EXPECT().GetDependencies(gomock.Any(), 1).Return(model.GetDependenciesResponse{
+					Id:        1,
+					BlockedBy: []model.GetTaskResponse{{Id: 2}},
+					Blocks:    []model.GetTaskResponse{{Id: 3, Blocked: true}},
+				}, nil)
+
+				tasksCtrl.GetDependencies(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetDependenciesResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.BlockedBy).To(HaveExactElements(HaveField("Id", 2)))
+				Expect(payload.Blocks).To(HaveExactElements(SatisfyAll(HaveField("Id", 3), HaveField("Blocked", BeTrue()))))
+			})
+		})
+
+	})
+
+	Describe("GetNext", func() {
+
+		When("there is nothing to work on", func() {
+			It("responds with status NoContent and no payload", func() {
+				mockService.EXPECT().GetNext(gomock.Any()).Return(nil, nil)
+
+				tasksCtrl.GetNext(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("there are open tasks", func() {
+			It("responds with status OK and the tasks in the payload", func() {
+				mockService.EXPECT().GetNext(gomock.Any()).Return([]model.GetTaskResponse{{Id: 2}, {Id: 1, Blocked: true}}, nil)
+
+				tasksCtrl.GetNext(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload []model.GetTaskResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(HaveExactElements(HaveField("Id", 2), HaveField("Id", 1)))
+			})
+		})
+
+	})
+
+	Describe("AddDependency", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			request = request.WithContext(reqctx.SetPathParam(ctx, constants.BlockerId, "2"))
+		})
+
+		When("the blocker id is not in the context", func() {
+			It("responds with status InternalServerError", func() {
+				request = httptest.NewRequest("", url, nil)
+				request = request.WithContext(reqctx.SetPathParam(request.Context(), constants.Id, "1"))
+
+				tasksCtrl.AddDependency(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().AddDependency(gomock.Any(), 1, 2).Return(err)
+
+				tasksCtrl.AddDependency(recorder, request)
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusNoContent),
+			Entry("on an unknown task", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a self dependency", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("on a cycle", errors.ErrConflict, http.StatusConflict),
+			Entry("on a list the caller may not edit", errors.ErrForbidden, http.StatusForbidden),
+			Entry("on any other error", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+	})
+
+	Describe("RemoveDependency", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", url, nil)
+			ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+			request = request.WithContext(reqctx.SetPathParam(ctx, constants.BlockerId, "2"))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveDependency(gomock.Any(), 1, 2).Return(err)
+
+				tasksCtrl.RemoveDependency(recorder, request)
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("on success", nil, http.StatusNoContent),
+			Entry("on an unknown dependency", errors.ErrNotFound, http.StatusNotFound),
+			Entry("on a list the caller may not edit", errors.ErrForbidden, http.StatusForbidden),
+		)
+
+	})
+
 })
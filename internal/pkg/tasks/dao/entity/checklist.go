@@ -0,0 +1,7 @@
+package entity
+
+type ChecklistItem struct {
+	Id   int    `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
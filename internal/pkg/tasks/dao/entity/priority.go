@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+)
+
+type Priority int
+
+const (
+	PriorityUnset Priority = iota
+	PriorityP0
+	PriorityP1
+	PriorityP2
+	PriorityP3
+	PriorityP4
+)
+
+func ParsePriority(value string) (Priority, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return PriorityUnset, nil
+	}
+
+	for priority := PriorityP0; priority <= PriorityP4; priority++ {
+		if priority.String() == value {
+			return priority, nil
+		}
+	}
+	return PriorityUnset, errors.ErrInvalidArgument
+}
+
+func (priority Priority) String() string {
+	if priority < PriorityP0 || priority > PriorityP4 {
+		return ""
+	}
+	return fmt.Sprintf("P%d", priority-PriorityP0)
+}
+
+func (priority Priority) Less(other Priority) bool {
+	if priority == PriorityUnset || other == PriorityUnset {
+		return priority != PriorityUnset && other == PriorityUnset
+	}
+	return priority < other
+}
@@ -2,6 +2,12 @@ package entity
 
 import "time"
 
+const (
+	StatusIdTodo = iota
+	StatusIdInProgress
+	StatusIdDone
+)
+
 type Status struct {
 	Id          int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
 	Name        string    `json:"name" gorm:"column:name;type:varchar;size:255"`
@@ -9,3 +15,26 @@ type Status struct {
 	CreatedAt   time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
 	UpdatedAt   time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
 }
+
+var workflow = []Status{
+	{Id: StatusIdTodo, Name: "Todo"},
+	{Id: StatusIdInProgress, Name: "In progress"},
+	{Id: StatusIdDone, Name: "Done"},
+}
+
+func Workflow() []Status {
+	return append([]Status(nil), workflow...)
+}
+
+func IsValidStatus(statusId int) bool {
+	for _, status := range workflow {
+		if status.Id == statusId {
+			return true
+		}
+	}
+	return false
+}
+
+func IsDone(statusId int) bool {
+	return statusId == StatusIdDone
+}
@@ -3,11 +3,37 @@ package entity
 import "time"
 
 type Task struct {
-	Id          int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
-	Name        string    `json:"name" gorm:"column:name;type:varchar;size:255"`
-	Status      Status    `json:"status"`
-	StatusId    int       `json:"statusId" gorm:"column:status_id;type:text"`
-	Description string    `json:"description,omitempty" gorm:"column:description;type:varchar;size:255"`
-	CreatedAt   time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
-	UpdatedAt   time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+	Id          int             `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	Name        string          `json:"name" gorm:"column:name;type:varchar;size:255"`
+	Status      Status          `json:"status"`
+	StatusId    int             `json:"statusId" gorm:"column:status_id;type:text"`
+	Description string          `json:"description,omitempty" gorm:"column:description;type:varchar;size:255"`
+	DueAt       *time.Time      `json:"dueAt,omitempty" gorm:"column:due_at;type:timestamp"`
+	Recurrence  string          `json:"recurrence,omitempty" gorm:"column:recurrence;type:varchar;size:255"`
+	Priority    Priority        `json:"priority,omitempty" gorm:"column:priority;type:int"`
+	Rank        string          `json:"rank" gorm:"column:rank;type:varchar;size:255;index"`
+	ListId      int             `json:"listId" gorm:"column:list_id;type:int;index"`
+	Seq         int             `json:"seq" gorm:"column:seq;type:int"`
+	ParentId    *int            `json:"parentId,omitempty" gorm:"column:parent_id;type:int;index"`
+	Checklist   []ChecklistItem `json:"checklist,omitempty" gorm:"column:checklist;type:text;serializer:json"`
+	Estimate    int             `json:"estimate,omitempty" gorm:"column:estimate;type:int"`
+	AssigneeIds []int           `json:"assigneeIds,omitempty" gorm:"column:assignee_ids;type:text;serializer:json"`
+	ArchivedAt  *time.Time      `json:"archivedAt,omitempty" gorm:"column:archived_at;type:timestamp;index"`
+	CreatedAt   time.Time       `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	UpdatedAt   time.Time       `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+	CreatedBy   *int            `json:"createdBy,omitempty" gorm:"column:created_by;type:int"`
+	UpdatedBy   *int            `json:"updatedBy,omitempty" gorm:"column:updated_by;type:int"`
+}
+
+func (task Task) IsArchived() bool {
+	return task.ArchivedAt != nil
+}
+
+func (task Task) IsAssignedTo(userId int) bool {
+	for _, assigneeId := range task.AssigneeIds {
+		if assigneeId == userId {
+			return true
+		}
+	}
+	return false
 }
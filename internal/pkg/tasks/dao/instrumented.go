@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/metrics"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+)
+
+const repositoryName = "tasks"
+
+type instrumentedRepository struct {
+	repository Repository
+	observer   metrics.RepositoryObserver
+}
+
+func Instrument(repository Repository, observer metrics.RepositoryObserver) Repository {
+	if observer == nil {
+		return repository
+	}
+
+	return &instrumentedRepository{
+		repository: repository,
+		observer:   observer,
+	}
+}
+
+func (repo *instrumentedRepository) GetById(ctx context.Context, id int) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.GetById(ctx, id)
+	repo.observer.ObserveRepository(repositoryName, "GetById", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) GetAll(ctx context.Context) ([]entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.GetAll(ctx)
+	repo.observer.ObserveRepository(repositoryName, "GetAll", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Insert(ctx context.Context, task entity.Task) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.Insert(ctx, task)
+	repo.observer.ObserveRepository(repositoryName, "Insert", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) Update(ctx context.Context, task entity.Task) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.Update(ctx, task)
+	repo.observer.ObserveRepository(repositoryName, "Update", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) RemoveById(ctx context.Context, id int) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.RemoveById(ctx, id)
+	repo.observer.ObserveRepository(repositoryName, "RemoveById", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) MoveToList(ctx context.Context, id int, listId int) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.MoveToList(ctx, id, listId)
+	repo.observer.ObserveRepository(repositoryName, "MoveToList", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) UpdateChecklist(ctx context.Context, id int, checklist []entity.ChecklistItem) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.UpdateChecklist(ctx, id, checklist)
+	repo.observer.ObserveRepository(repositoryName, "UpdateChecklist", time.Since(start), err)
+	return result, err
+}
+
+func (repo *instrumentedRepository) SetArchived(ctx context.Context, id int, archivedAt *time.Time) (entity.Task, error) {
+	start := time.Now()
+	result, err := repo.repository.SetArchived(ctx, id, archivedAt)
+	repo.observer.ObserveRepository(repositoryName, "SetArchived", time.Since(start), err)
+	return result, err
+}
+
+func CountByStatus(ctx context.Context, repository Repository) (map[string]int, error) {
+	tasks, err := repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[int]string{}
+	counts := map[string]int{}
+	for _, status := range entity.Workflow() {
+		names[status.Id] = status.Name
+		counts[status.Name] = 0
+	}
+
+	for _, task := range tasks {
+		if name, found := names[task.StatusId]; found && !task.IsArchived() {
+			counts[name]++
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	metricsMock "github.com/aeon-fruit/dalil.git/test/mocks/metrics"
+)
+
+var _ = Describe("Instrumented repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl     *gomock.Controller
+		mockObserver *metricsMock.MockRepositoryObserver
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockObserver = metricsMock.NewMockRepositoryObserver(mockCtrl)
+	})
+
+	Describe("Instrument", func() {
+		It("returns the repository as is without observer", func() {
+			repo := repository.New()
+
+			Expect(repository.Instrument(repo, nil)).To(BeIdenticalTo(repo))
+		})
+
+		It("observes the operations and their errors", func() {
+			repo := repository.Instrument(repository.New(), mockObserver)
+			gomock.InOrder(
+				mockObserver.EXPECT().ObserveRepository("tasks", "Insert", gomock.Any(), nil),
+				mockObserver.EXPECT().ObserveRepository("tasks", "GetById", gomock.Any(), nil),
+				mockObserver.EXPECT().ObserveRepository("tasks", "GetById", gomock.Any(), errors.ErrNotFound),
+			)
+
+			inserted, err := repo.Insert(ctx, entity.Task{Name: "A task"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetById(ctx, inserted.Id+1)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("CountByStatus", func() {
+		It("counts the tasks not archived by status name", func() {
+			repo := repository.New()
+			for _, statusId := range []int{entity.StatusIdTodo, entity.StatusIdInProgress, entity.StatusIdInProgress} {
+				_, err := repo.Insert(ctx, entity.Task{Name: "A task", StatusId: statusId})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			archived, err := repo.Insert(ctx, entity.Task{Name: "An archived task", StatusId: entity.StatusIdTodo})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = repo.SetArchived(ctx, archived.Id, &archived.CreatedAt)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repository.CountByStatus(ctx, repo)).To(Equal(map[string]int{
+				"Todo":        1,
+				"In progress": 2,
+				"Done":        0,
+			}))
+		})
+	})
+
+})
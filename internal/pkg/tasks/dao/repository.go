@@ -1,32 +1,45 @@
 package repository
 
 import (
+	"context"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/rank"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
 )
 
 type Repository interface {
-	GetById(id int) (entity.Task, error)
-	GetAll() ([]entity.Task, error)
-	Insert(task entity.Task) (entity.Task, error)
-	Update(task entity.Task) (entity.Task, error)
-	RemoveById(id int) (entity.Task, error)
+	GetById(ctx context.Context, id int) (entity.Task, error)
+	GetAll(ctx context.Context) ([]entity.Task, error)
+	Insert(ctx context.Context, task entity.Task) (entity.Task, error)
+	Update(ctx context.Context, task entity.Task) (entity.Task, error)
+	RemoveById(ctx context.Context, id int) (entity.Task, error)
+	MoveToList(ctx context.Context, id int, listId int) (entity.Task, error)
+	UpdateChecklist(ctx context.Context, id int, checklist []entity.ChecklistItem) (entity.Task, error)
+	SetArchived(ctx context.Context, id int, archivedAt *time.Time) (entity.Task, error)
+}
+
+type partition struct {
+	tasks    map[int]entity.Task
+	seq      int
+	lastRank string
 }
 
 type memoryRepository struct {
-	tasks map[int]entity.Task
-	seq   int
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+	quotas     tenancy.Quotas
 }
 
 type RepositoryOption func(*memoryRepository)
 
 func New(options ...RepositoryOption) Repository {
 	instance := memoryRepository{
-		tasks: map[int]entity.Task{},
-		seq:   0,
+		partitions: tenancy.NewPartitions(newPartition),
 	}
 
 	for _, option := range options {
@@ -41,64 +54,247 @@ func New(options ...RepositoryOption) Repository {
 func WithTasks(tasks map[int]entity.Task) RepositoryOption {
 	return func(repository *memoryRepository) {
 		if repository != nil && tasks != nil {
-			repository.tasks = tasks
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			partition.tasks = tasks
+			for _, task := range tasks {
+				partition.trackRank(task.Rank)
+			}
 		}
 	}
 }
 
-func (repo *memoryRepository) GetById(id int) (entity.Task, error) {
-	task, found := repo.tasks[id]
+func WithQuotas(quotas tenancy.Quotas) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.quotas = quotas
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		tasks: map[int]entity.Task{},
+		seq:   0,
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.Task, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	task, found := partition.tasks[id]
 	if !found {
 		return entity.Task{}, errors.ErrNotFound
 	}
 	return task, nil
 }
 
-func (repo *memoryRepository) GetAll() ([]entity.Task, error) {
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Task, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
 	var ids []int
 	var tasks []entity.Task
-	for _, task := range repo.tasks {
+	for _, task := range partition.tasks {
 		ids = append(ids, task.Id)
 	}
 	sort.Ints(ids)
 	for _, id := range ids {
-		tasks = append(tasks, repo.tasks[id])
+		tasks = append(tasks, partition.tasks[id])
 	}
 
 	return tasks, nil
 }
 
-func (repo *memoryRepository) Insert(task entity.Task) (entity.Task, error) {
-	task.Id, repo.seq = repo.seq, repo.seq+1
+func (repo *memoryRepository) Insert(ctx context.Context, task entity.Task) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+	if repo.quotas != nil {
+		if err := repo.quotas.Check(ctx, tenancy.ResourceTasks, len(partition.tasks)); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	if task.Rank == "" {
+		task.Rank = partition.nextRank()
+	}
+	if task.Seq == 0 {
+		task.Seq = partition.nextSeq(task.ListId)
+	}
+
+	task.Id, partition.seq = partition.seq, partition.seq+1
 	task.UpdatedAt = time.Now()
 	task.CreatedAt = task.UpdatedAt
-	repo.tasks[task.Id] = task
+	partition.tasks[task.Id] = task
+	partition.trackRank(task.Rank)
 	return task, nil
 }
 
-func (repo *memoryRepository) Update(task entity.Task) (entity.Task, error) {
-	oldTask, found := repo.tasks[task.Id]
+func (repo *memoryRepository) Update(ctx context.Context, task entity.Task) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldTask, found := partition.tasks[task.Id]
 	if !found {
 		return entity.Task{}, errors.ErrNotFound
 	}
 
+	if task.Rank == "" {
+		task.Rank = oldTask.Rank
+	}
+	task.ListId = oldTask.ListId
+	task.Seq = oldTask.Seq
+	task.Checklist = oldTask.Checklist
+	task.ArchivedAt = oldTask.ArchivedAt
+
 	if oldTask.Name == task.Name &&
 		oldTask.StatusId == task.StatusId &&
-		oldTask.Description == task.Description {
+		oldTask.Description == task.Description &&
+		sameTime(oldTask.DueAt, task.DueAt) &&
+		oldTask.Recurrence == task.Recurrence &&
+		oldTask.Priority == task.Priority &&
+		oldTask.Rank == task.Rank &&
+		oldTask.Estimate == task.Estimate &&
+		sameId(oldTask.ParentId, task.ParentId) &&
+		sameIds(oldTask.AssigneeIds, task.AssigneeIds) {
 		return entity.Task{}, errors.ErrNotModified
 	}
 
 	task.UpdatedAt = time.Now()
 	task.CreatedAt = oldTask.CreatedAt
-	repo.tasks[task.Id] = task
+	task.CreatedBy = oldTask.CreatedBy
+	partition.tasks[task.Id] = task
+	partition.trackRank(task.Rank)
 	return oldTask, nil
 }
 
-func (repo *memoryRepository) RemoveById(id int) (entity.Task, error) {
-	task, found := repo.tasks[id]
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	task, found := partition.tasks[id]
+	if !found {
+		return entity.Task{}, errors.ErrNotFound
+	}
+	delete(partition.tasks, id)
+	return task, nil
+}
+
+func (repo *memoryRepository) MoveToList(ctx context.Context, id int, listId int) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	task, found := partition.tasks[id]
+	if !found {
+		return entity.Task{}, errors.ErrNotFound
+	}
+
+	if task.ListId == listId {
+		return entity.Task{}, errors.ErrNotModified
+	}
+
+	task.ListId = listId
+	task.Seq = partition.nextSeq(listId)
+	task.UpdatedAt = time.Now()
+	partition.tasks[id] = task
+	return task, nil
+}
+
+func (repo *memoryRepository) UpdateChecklist(ctx context.Context, id int, checklist []entity.ChecklistItem) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	task, found := partition.tasks[id]
+	if !found {
+		return entity.Task{}, errors.ErrNotFound
+	}
+
+	task.Checklist = append([]entity.ChecklistItem(nil), checklist...)
+	task.UpdatedAt = time.Now()
+	partition.tasks[id] = task
+	return task, nil
+}
+
+func (repo *memoryRepository) SetArchived(ctx context.Context, id int, archivedAt *time.Time) (entity.Task, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	task, found := partition.tasks[id]
 	if !found {
 		return entity.Task{}, errors.ErrNotFound
 	}
-	delete(repo.tasks, id)
+
+	if task.IsArchived() == (archivedAt != nil) {
+		return entity.Task{}, errors.ErrNotModified
+	}
+
+	task.ArchivedAt = archivedAt
+	task.UpdatedAt = time.Now()
+	partition.tasks[id] = task
 	return task, nil
 }
+
+func (partition *partition) nextSeq(listId int) int {
+	var last int
+	for _, task := range partition.tasks {
+		if task.ListId == listId && task.Seq > last {
+			last = task.Seq
+		}
+	}
+	return last + 1
+}
+
+func (partition *partition) nextRank() string {
+	if next, err := rank.Next(partition.lastRank); err == nil {
+		return next
+	}
+	return rank.First()
+}
+
+func (partition *partition) trackRank(key string) {
+	if key > partition.lastRank {
+		partition.lastRank = key
+	}
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameId(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameIds(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,14 +1,22 @@
 package repository_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
 	repository "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
 )
 
 var _ = Describe("Repository", func() {
 
+	ctx := context.Background()
+
 	When("used", func() {
 		It("needs Ginkgo specs", func() {
 			repo := repository.New()
@@ -16,4 +24,266 @@ var _ = Describe("Repository", func() {
 		})
 	})
 
+	Describe("Insert", func() {
+		It("ranks new tasks after the existing ones", func() {
+			repo := repository.New()
+
+			first, err := repo.Insert(ctx, entity.Task{Name: "first"})
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, entity.Task{Name: "second"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first.Rank).NotTo(BeEmpty())
+			Expect(second.Rank > first.Rank).To(BeTrue())
+		})
+
+		It("numbers the tasks within their list", func() {
+			repo := repository.New()
+
+			Expect(repo.Insert(ctx, entity.Task{Name: "first"})).To(HaveField("Seq", 1))
+			Expect(repo.Insert(ctx, entity.Task{Name: "other", ListId: 1})).To(HaveField("Seq", 1))
+			Expect(repo.Insert(ctx, entity.Task{Name: "second"})).To(HaveField("Seq", 2))
+		})
+
+		It("keeps an explicit rank", func() {
+			repo := repository.New()
+
+			Expect(repo.Insert(ctx, entity.Task{Name: "ranked", Rank: "c"})).To(HaveField("Rank", "c"))
+		})
+
+		It("keeps the ranks short over many appends", func() {
+			repo := repository.New()
+
+			var last string
+			for i := 0; i < 2000; i++ {
+				task, err := repo.Insert(ctx, entity.Task{Name: "appended"})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Rank > last).To(BeTrue())
+				Expect(len(task.Rank)).To(BeNumerically("<=", 4))
+				last = task.Rank
+			}
+		})
+
+		It("ranks new tasks after a task moved to the end", func() {
+			repo := repository.New()
+
+			task, _ := repo.Insert(ctx, entity.Task{Name: "moved"})
+			task.Rank = "y"
+			_, err := repo.Update(ctx, task)
+			Expect(err).NotTo(HaveOccurred())
+
+			appended, err := repo.Insert(ctx, entity.Task{Name: "appended"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(appended.Rank > "y").To(BeTrue())
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			repo repository.Repository
+			task entity.Task
+		)
+
+		BeforeEach(func() {
+			repo = repository.New()
+			task, _ = repo.Insert(ctx, entity.Task{Name: "task", Priority: entity.PriorityP1})
+		})
+
+		It("keeps the rank when none is provided", func() {
+			_, err := repo.Update(ctx, entity.Task{Id: task.Id, Name: "renamed", Priority: entity.PriorityP1})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("Rank", task.Rank))
+		})
+
+		It("changes the rank when one is provided", func() {
+			task.Rank = "a"
+
+			_, err := repo.Update(ctx, task)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("Rank", "a"))
+		})
+
+		It("considers a parent change as a modification", func() {
+			parentId := 42
+			task.ParentId = &parentId
+
+			Expect(repo.Update(ctx, task)).Error().NotTo(HaveOccurred())
+			Expect(repo.Update(ctx, task)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("considers a priority change as a modification", func() {
+			task.Priority = entity.PriorityP3
+
+			Expect(repo.Update(ctx, task)).Error().NotTo(HaveOccurred())
+			Expect(repo.Update(ctx, task)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("considers an estimate change as a modification", func() {
+			task.Estimate = 90
+
+			Expect(repo.Update(ctx, task)).Error().NotTo(HaveOccurred())
+			Expect(repo.Update(ctx, task)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("considers an assignees change as a modification", func() {
+			task.AssigneeIds = []int{1, 2}
+
+			Expect(repo.Update(ctx, task)).Error().NotTo(HaveOccurred())
+			Expect(repo.Update(ctx, task)).Error().To(Equal(errors.ErrNotModified))
+
+			task.AssigneeIds = []int{2, 1}
+			Expect(repo.Update(ctx, task)).Error().NotTo(HaveOccurred())
+		})
+
+		It("keeps the creator of the task", func() {
+			creatorId, updaterId := 1, 2
+			task, _ = repo.Insert(ctx, entity.Task{Name: "task", CreatedBy: &creatorId, UpdatedBy: &creatorId})
+
+			_, err := repo.Update(ctx, entity.Task{Id: task.Id, Name: "renamed", UpdatedBy: &updaterId})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.GetById(ctx, task.Id)).To(SatisfyAll(
+				HaveField("CreatedBy", HaveValue(Equal(creatorId))),
+				HaveField("UpdatedBy", HaveValue(Equal(updaterId))),
+			))
+		})
+	})
+
+	Describe("MoveToList", func() {
+		var (
+			repo repository.Repository
+			task entity.Task
+		)
+
+		BeforeEach(func() {
+			repo = repository.New()
+			task, _ = repo.Insert(ctx, entity.Task{Name: "task"})
+			_, _ = repo.Insert(ctx, entity.Task{Name: "other", ListId: 1})
+		})
+
+		It("renumbers the task in its new list", func() {
+			moved, err := repo.MoveToList(ctx, task.Id, 1)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.ListId).To(Equal(1))
+			Expect(moved.Seq).To(Equal(2))
+		})
+
+		It("returns ErrNotModified when the list is the same", func() {
+			Expect(repo.MoveToList(ctx, task.Id, 0)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrNotFound for an unknown task", func() {
+			Expect(repo.MoveToList(ctx, 42, 1)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("is not undone by an update", func() {
+			_, _ = repo.MoveToList(ctx, task.Id, 1)
+			_, err := repo.Update(ctx, entity.Task{Id: task.Id, Name: "renamed"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("ListId", 1))
+		})
+	})
+
+	Describe("UpdateChecklist", func() {
+		var (
+			repo repository.Repository
+			task entity.Task
+		)
+
+		BeforeEach(func() {
+			repo = repository.New()
+			task, _ = repo.Insert(ctx, entity.Task{Name: "task"})
+		})
+
+		It("replaces the checklist of the task", func() {
+			updated, err := repo.UpdateChecklist(ctx, task.Id, []entity.ChecklistItem{{Id: 1, Text: "step"}})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Checklist).To(HaveExactElements(HaveField("Text", "step")))
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("Checklist", HaveLen(1)))
+		})
+
+		It("returns ErrNotFound for an unknown task", func() {
+			Expect(repo.UpdateChecklist(ctx, 42, nil)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("is not undone by an update", func() {
+			_, _ = repo.UpdateChecklist(ctx, task.Id, []entity.ChecklistItem{{Id: 1, Text: "step"}})
+			_, err := repo.Update(ctx, entity.Task{Id: task.Id, Name: "renamed"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("Checklist", HaveLen(1)))
+		})
+	})
+
+	Describe("SetArchived", func() {
+		var (
+			repo repository.Repository
+			task entity.Task
+			now  time.Time
+		)
+
+		BeforeEach(func() {
+			repo = repository.New()
+			task, _ = repo.Insert(ctx, entity.Task{Name: "task", StatusId: entity.StatusIdDone})
+			now = time.Now()
+		})
+
+		It("archives and unarchives the task", func() {
+			archived, err := repo.SetArchived(ctx, task.Id, &now)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archived.IsArchived()).To(BeTrue())
+
+			Expect(repo.SetArchived(ctx, task.Id, nil)).To(HaveField("ArchivedAt", BeNil()))
+		})
+
+		It("returns ErrNotModified when the archived state is the same", func() {
+			Expect(repo.SetArchived(ctx, task.Id, nil)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrNotFound for an unknown task", func() {
+			Expect(repo.SetArchived(ctx, 42, &now)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("is not undone by an update", func() {
+			_, _ = repo.SetArchived(ctx, task.Id, &now)
+			_, err := repo.Update(ctx, entity.Task{Id: task.Id, Name: "renamed", StatusId: entity.StatusIdDone})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("ArchivedAt", Not(BeNil())))
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("isolates the tasks, ids and sequences of each tenant", func() {
+			repo := repository.New()
+
+			task, _ := repo.Insert(ctx, entity.Task{Name: "first"})
+			Expect(repo.GetById(acme, task.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.RemoveById(acme, task.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(acme)).To(BeEmpty())
+
+			Expect(repo.Insert(acme, entity.Task{Name: "other"})).To(And(HaveField("Id", task.Id), HaveField("Seq", 1)))
+			Expect(repo.GetById(ctx, task.Id)).To(HaveField("Name", "first"))
+		})
+
+		It("enforces the quota of the tenant", func() {
+			repo := repository.New(repository.WithQuotas(tenancy.NewQuotas(
+				tenancy.WithDefaults(tenancy.Limits{tenancy.ResourceTasks: 1}),
+			)))
+
+			Expect(repo.Insert(acme, entity.Task{Name: "first"})).Error().NotTo(HaveOccurred())
+			Expect(repo.Insert(acme, entity.Task{Name: "second"})).Error().To(Equal(errors.ErrQuotaExceeded))
+			Expect(repo.Insert(ctx, entity.Task{Name: "other"})).Error().NotTo(HaveOccurred())
+		})
+	})
+
 })
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	spanPrefix = "tasks.repository."
+	taskIdKey  = attribute.Key("task.id")
+)
+
+type tracedRepository struct {
+	repository Repository
+	tracer     trace.Tracer
+}
+
+func Trace(repository Repository, provider trace.TracerProvider) Repository {
+	if provider == nil {
+		return repository
+	}
+
+	return &tracedRepository{
+		repository: repository,
+		tracer:     provider.Tracer(tracerName),
+	}
+}
+
+func (repo *tracedRepository) GetById(ctx context.Context, id int) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"GetById", trace.WithAttributes(taskIdKey.Int(id)))
+	result, err := repo.repository.GetById(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) GetAll(ctx context.Context) ([]entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"GetAll")
+	result, err := repo.repository.GetAll(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) Insert(ctx context.Context, task entity.Task) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"Insert")
+	result, err := repo.repository.Insert(ctx, task)
+	if err == nil {
+		span.SetAttributes(taskIdKey.Int(result.Id))
+	}
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) Update(ctx context.Context, task entity.Task) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"Update", trace.WithAttributes(taskIdKey.Int(task.Id)))
+	result, err := repo.repository.Update(ctx, task)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) RemoveById(ctx context.Context, id int) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"RemoveById", trace.WithAttributes(taskIdKey.Int(id)))
+	result, err := repo.repository.RemoveById(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) MoveToList(ctx context.Context, id int, listId int) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"MoveToList",
+		trace.WithAttributes(taskIdKey.Int(id), attribute.Int("list.id", listId)))
+	result, err := repo.repository.MoveToList(ctx, id, listId)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) UpdateChecklist(ctx context.Context, id int, checklist []entity.ChecklistItem) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"UpdateChecklist", trace.WithAttributes(taskIdKey.Int(id)))
+	result, err := repo.repository.UpdateChecklist(ctx, id, checklist)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (repo *tracedRepository) SetArchived(ctx context.Context, id int, archivedAt *time.Time) (entity.Task, error) {
+	ctx, span := repo.tracer.Start(ctx, spanPrefix+"SetArchived", trace.WithAttributes(taskIdKey.Int(id)))
+	result, err := repo.repository.SetArchived(ctx, id, archivedAt)
+	tracing.End(span, err)
+	return result, err
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+)
+
+var _ = Describe("Traced repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		recorder *tracetest.SpanRecorder
+		provider *sdktrace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	})
+
+	It("returns the repository as is without tracer provider", func() {
+		repo := repository.New()
+
+		Expect(repository.Trace(repo, nil)).To(BeIdenticalTo(repo))
+	})
+
+	It("traces the operations as children of the span in context", func() {
+		repo := repository.Trace(repository.New(), provider)
+		parentCtx, parent := provider.Tracer("test").Start(ctx, "request")
+
+		inserted, err := repo.Insert(parentCtx, entity.Task{Name: "A task"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repo.GetById(parentCtx, inserted.Id+1)).Error().To(Equal(errors.ErrNotFound))
+		parent.End()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+
+		Expect(spans[0].Name()).To(Equal("tasks.repository.Insert"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(spans[0].Attributes()).To(ContainElement(attribute.Int("task.id", inserted.Id)))
+		Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+
+		Expect(spans[1].Name()).To(Equal("tasks.repository.GetById"))
+		Expect(spans[1].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(spans[1].Attributes()).To(ContainElement(attribute.Int("task.id", inserted.Id+1)))
+		Expect(spans[1].Status().Code).To(Equal(codes.Error))
+	})
+})
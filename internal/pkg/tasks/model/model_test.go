@@ -55,6 +55,33 @@ var _ = Describe("Model", func() {
 			})
 		})
 
+		When("the entity has a checklist", func() {
+			It("returns a TaskResponse that counts the done items", func() {
+				e := entity.Task{
+					Id: id,
+					Checklist: []entity.ChecklistItem{
+						{Id: 1, Text: "first", Done: true},
+						{Id: 2, Text: "second"},
+					},
+				}
+
+				m := model.EntityToGetTaskResponse(e)
+
+				Expect(m.Checklist).To(Equal(&model.ChecklistProgress{Done: 1, Total: 2}))
+			})
+		})
+
+		When("the entity is archived", func() {
+			It("returns a TaskResponse flagged as archived", func() {
+				archivedAt := time.Now()
+
+				m := model.EntityToGetTaskResponse(entity.Task{Id: id, ArchivedAt: &archivedAt})
+
+				Expect(m.Archived).To(BeTrue())
+				Expect(m.ArchivedAt).To(Equal(&archivedAt))
+			})
+		})
+
 	})
 
 	Describe("UpsertTaskRequest", func() {
@@ -105,6 +132,62 @@ var _ = Describe("Model", func() {
 				})
 			})
 
+			When("the model has a recurrence but no due date", func() {
+				It("returns false", func() {
+					m.Recurrence = "FREQ=WEEKLY"
+
+					Expect(m.IsValid(m.Id)).To(BeFalse())
+				})
+			})
+
+			When("the model has a recurrence and a due date", func() {
+				It("returns true", func() {
+					dueAt := time.Now()
+					m.Recurrence = "FREQ=WEEKLY"
+					m.DueAt = &dueAt
+
+					Expect(m.IsValid(m.Id)).To(BeTrue())
+				})
+			})
+
+			When("the model has a negative estimate", func() {
+				It("returns false", func() {
+					m.Estimate = -30
+
+					Expect(m.IsValid(m.Id)).To(BeFalse())
+				})
+			})
+
+			When("the model has only assignees", func() {
+				It("returns true", func() {
+					Expect(model.UpsertTaskRequest{AssigneeIds: []int{1}}.IsValid(nil)).To(BeTrue())
+				})
+			})
+
+			When("the model has a duplicate assignee", func() {
+				It("returns false", func() {
+					m.AssigneeIds = []int{1, 2, 1}
+
+					Expect(m.IsValid(m.Id)).To(BeFalse())
+				})
+			})
+
+			When("the model has a negative assignee", func() {
+				It("returns false", func() {
+					m.AssigneeIds = []int{-1}
+
+					Expect(m.IsValid(m.Id)).To(BeFalse())
+				})
+			})
+
+			When("the model has an unknown priority", func() {
+				It("returns false", func() {
+					m.Priority = "P9"
+
+					Expect(m.IsValid(m.Id)).To(BeFalse())
+				})
+			})
+
 			When("the model id is non-nil", func() {
 				When("the id argument is nil", func() {
 					It("returns false", func() {
@@ -159,8 +242,102 @@ var _ = Describe("Model", func() {
 				})
 			})
 
+			When("the priority field is set", func() {
+				It("returns an entity having the parsed priority", func() {
+					m.Priority = "p1"
+					expected.Priority = entity.PriorityP1
+
+					Expect(m.ToEntity()).To(Equal(expected))
+				})
+			})
+
+			When("the estimate field is set", func() {
+				It("returns an entity having the estimate", func() {
+					m.Estimate = 90
+					expected.Estimate = 90
+
+					Expect(m.ToEntity()).To(Equal(expected))
+				})
+			})
+
+			When("the assignees field is set", func() {
+				It("returns an entity having a copy of the assignees", func() {
+					m.AssigneeIds = []int{1, 2}
+					expected.AssigneeIds = []int{1, 2}
+
+					e := m.ToEntity()
+					m.AssigneeIds[0] = 3
+
+					Expect(e).To(Equal(expected))
+				})
+			})
+
+			When("the list id field is set", func() {
+				It("returns an entity in that list", func() {
+					listId := 3
+					m.ListId = &listId
+					expected.ListId = listId
+
+					Expect(m.ToEntity()).To(Equal(expected))
+				})
+			})
+
 		})
 
 	})
 
+	Describe("GetTasksRequest", func() {
+		DescribeTable("IsValid",
+			func(sort string, expected bool) {
+				Expect(model.GetTasksRequest{Sort: sort}.IsValid()).To(Equal(expected))
+			},
+			Entry("without sort", "", true),
+			Entry("sorted by id", model.SortById, true),
+			Entry("sorted by rank", model.SortByRank, true),
+			Entry("sorted by priority", model.SortByPriority, true),
+			Entry("sorted by an unknown field", "name", false),
+		)
+
+		DescribeTable("IsValid with a tag match",
+			func(tagMatch string, expected bool) {
+				Expect(model.GetTasksRequest{Tags: []string{"bug"}, TagMatch: tagMatch}.IsValid()).To(Equal(expected))
+			},
+			Entry("without tag match", "", true),
+			Entry("matching all tags", model.TagMatchAll, true),
+			Entry("matching any tag", model.TagMatchAny, true),
+			Entry("with an unknown tag match", "none", false),
+		)
+	})
+
+	Describe("MoveTaskRequest", func() {
+		anchor := func(id int) *int {
+			return &id
+		}
+
+		DescribeTable("IsValid",
+			func(request model.MoveTaskRequest, expected bool) {
+				Expect(request.IsValid(id)).To(Equal(expected))
+			},
+			Entry("without anchors", model.MoveTaskRequest{}, false),
+			Entry("to another list", model.MoveTaskRequest{ListId: anchor(1)}, true),
+			Entry("before another task", model.MoveTaskRequest{Before: anchor(1)}, true),
+			Entry("after another task", model.MoveTaskRequest{After: anchor(1)}, true),
+			Entry("between two tasks", model.MoveTaskRequest{After: anchor(1), Before: anchor(2)}, true),
+			Entry("before itself", model.MoveTaskRequest{Before: anchor(id)}, false),
+			Entry("after itself", model.MoveTaskRequest{After: anchor(id)}, false),
+			Entry("before and after the same task", model.MoveTaskRequest{After: anchor(1), Before: anchor(1)}, false),
+			Entry("to another status", model.MoveTaskRequest{StatusId: anchor(entity.StatusIdDone)}, true),
+			Entry("to an unknown status", model.MoveTaskRequest{StatusId: anchor(7)}, false),
+		)
+	})
+
+	DescribeTable("QuickAddRequest.IsValid",
+		func(text string, expected bool) {
+			Expect(model.QuickAddRequest{Text: text}.IsValid()).To(Equal(expected))
+		},
+		Entry("empty", "", false),
+		Entry("blank", " \t ", false),
+		Entry("with text", "Pay rent", true),
+	)
+
 })
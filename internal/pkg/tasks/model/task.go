@@ -1,40 +1,111 @@
 package model
 
 import (
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
 )
 
+const (
+	SortById       = "id"
+	SortByRank     = "rank"
+	SortByPriority = "priority"
+)
+
+const (
+	TagMatchAll = "all"
+	TagMatchAny = "any"
+)
+
 type GetTaskResponse struct {
-	Id          int       `json:"id"`
-	Name        string    `json:"name"`
-	StatusId    int       `json:"statusId"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
+	Id          int                `json:"id"`
+	Name        string             `json:"name"`
+	StatusId    int                `json:"statusId"`
+	Description string             `json:"description,omitempty"`
+	DueAt       *time.Time         `json:"dueAt,omitempty"`
+	Recurrence  string             `json:"recurrence,omitempty"`
+	Priority    string             `json:"priority,omitempty"`
+	Rank        string             `json:"rank,omitempty"`
+	ListId      int                `json:"listId"`
+	Seq         int                `json:"seq,omitempty"`
+	ParentId    *int               `json:"parentId,omitempty"`
+	Blocked     bool               `json:"blocked"`
+	Checklist   *ChecklistProgress `json:"checklist,omitempty"`
+	Estimate    int                `json:"estimate,omitempty"`
+	AssigneeIds []int              `json:"assigneeIds,omitempty"`
+	Archived    bool               `json:"archived"`
+	ArchivedAt  *time.Time         `json:"archivedAt,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt,omitempty"`
+	UpdatedAt   time.Time          `json:"updatedAt,omitempty"`
+	CreatedBy   *int               `json:"createdBy,omitempty"`
+	UpdatedBy   *int               `json:"updatedBy,omitempty"`
+}
+
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
 }
 
 func EntityToGetTaskResponse(entity entity.Task) GetTaskResponse {
+	var checklist *ChecklistProgress
+	if len(entity.Checklist) > 0 {
+		checklist = &ChecklistProgress{Total: len(entity.Checklist)}
+		for _, item := range entity.Checklist {
+			if item.Done {
+				checklist.Done++
+			}
+		}
+	}
+
 	return GetTaskResponse{
 		Id:          entity.Id,
 		Name:        entity.Name,
 		StatusId:    entity.StatusId,
 		Description: entity.Description,
+		DueAt:       entity.DueAt,
+		Recurrence:  entity.Recurrence,
+		Priority:    entity.Priority.String(),
+		Rank:        entity.Rank,
+		ListId:      entity.ListId,
+		Seq:         entity.Seq,
+		ParentId:    entity.ParentId,
+		Checklist:   checklist,
+		Estimate:    entity.Estimate,
+		AssigneeIds: entity.AssigneeIds,
+		Archived:    entity.IsArchived(),
+		ArchivedAt:  entity.ArchivedAt,
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
+		CreatedBy:   entity.CreatedBy,
+		UpdatedBy:   entity.UpdatedBy,
 	}
 }
 
 type UpsertTaskRequest struct {
-	Id          *int   `json:"id,omitempty"`
-	Name        string `json:"name"`
-	StatusId    int    `json:"statusId"`
-	Description string `json:"description,omitempty"`
+	Id          *int       `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	StatusId    int        `json:"statusId"`
+	Description string     `json:"description,omitempty"`
+	DueAt       *time.Time `json:"dueAt,omitempty"`
+	Recurrence  string     `json:"recurrence,omitempty"`
+	Priority    string     `json:"priority,omitempty"`
+	ListId      *int       `json:"listId,omitempty"`
+	ParentId    *int       `json:"parentId,omitempty"`
+	Estimate    int        `json:"estimate,omitempty"`
+	AssigneeIds []int      `json:"assigneeIds,omitempty"`
+
+	OverrideWipLimit bool `json:"-"`
 }
 
 func (dto UpsertTaskRequest) IsValid(id *int) bool {
-	return dto != (UpsertTaskRequest{}) &&
+	_, err := entity.ParsePriority(dto.Priority)
+	return !reflect.ValueOf(dto).IsZero() &&
+		(dto.Recurrence == "" || dto.DueAt != nil) &&
+		dto.Estimate >= 0 &&
+		areValidAssignees(dto.AssigneeIds) &&
+		err == nil &&
 		((id == nil && dto.Id == nil) ||
 			(id != nil && dto.Id != nil && *id == *dto.Id))
 }
@@ -45,10 +116,107 @@ func (dto UpsertTaskRequest) ToEntity() entity.Task {
 		id = *dto.Id
 	}
 
+	var listId int
+	if dto.ListId != nil {
+		listId = *dto.ListId
+	}
+
+	priority, _ := entity.ParsePriority(dto.Priority)
+
 	return entity.Task{
 		Id:          id,
 		Name:        dto.Name,
 		StatusId:    dto.StatusId,
 		Description: dto.Description,
+		DueAt:       dto.DueAt,
+		Recurrence:  dto.Recurrence,
+		Priority:    priority,
+		ListId:      listId,
+		ParentId:    dto.ParentId,
+		Estimate:    dto.Estimate,
+		AssigneeIds: append([]int(nil), dto.AssigneeIds...),
+	}
+}
+
+func areValidAssignees(assigneeIds []int) bool {
+	seen := map[int]bool{}
+	for _, assigneeId := range assigneeIds {
+		if assigneeId < 0 || seen[assigneeId] {
+			return false
+		}
+		seen[assigneeId] = true
 	}
+	return true
+}
+
+type QuickAddRequest struct {
+	Text string `json:"text"`
+}
+
+func (dto QuickAddRequest) IsValid() bool {
+	return strings.TrimSpace(dto.Text) != ""
+}
+
+type QuickTaskRequest struct {
+	UpsertTaskRequest
+	Tags    []string `json:"tags,omitempty"`
+	Context string   `json:"context,omitempty"`
+}
+
+type GetTasksRequest struct {
+	ListId          *int
+	Sort            string
+	Tags            []string
+	TagMatch        string
+	IncludeArchived bool
+	AssigneeId      *int
+}
+
+func (dto GetTasksRequest) IsValid() bool {
+	switch dto.Sort {
+	case "", SortById, SortByRank, SortByPriority:
+	default:
+		return false
+	}
+
+	switch dto.TagMatch {
+	case "", TagMatchAll, TagMatchAny:
+		return true
+	}
+	return false
+}
+
+type MoveTaskRequest struct {
+	ListId   *int `json:"listId,omitempty"`
+	StatusId *int `json:"statusId,omitempty"`
+	Before   *int `json:"before,omitempty"`
+	After    *int `json:"after,omitempty"`
+
+	OverrideWipLimit bool `json:"-"`
+}
+
+func (dto MoveTaskRequest) IsValid(id int) bool {
+	return (dto.ListId != nil || dto.StatusId != nil || dto.Before != nil || dto.After != nil) &&
+		(dto.StatusId == nil || entity.IsValidStatus(*dto.StatusId)) &&
+		(dto.Before == nil || *dto.Before != id) &&
+		(dto.After == nil || *dto.After != id) &&
+		(dto.Before == nil || dto.After == nil || *dto.Before != *dto.After)
+}
+
+type GetTaskTreeResponse struct {
+	GetTaskResponse
+	Completion int                   `json:"completion"`
+	Children   []GetTaskTreeResponse `json:"children,omitempty"`
+}
+
+type GetDependenciesResponse struct {
+	Id        int               `json:"id"`
+	BlockedBy []GetTaskResponse `json:"blockedBy"`
+	Blocks    []GetTaskResponse `json:"blocks"`
+}
+
+type GetOccurrencesResponse struct {
+	Id          int         `json:"id"`
+	Recurrence  string      `json:"recurrence"`
+	Occurrences []time.Time `json:"occurrences"`
 }
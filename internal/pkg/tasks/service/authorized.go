@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetById(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return authorized.service.GetById(ctx, id)
+}
+
+func (authorized *authorizedService) GetAll(ctx context.Context, request model.GetTasksRequest) ([]model.GetTaskResponse, error) {
+	if request.ListId != nil {
+		if err := authorized.policy.AuthorizeList(ctx, *request.ListId, rolesEntity.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, err := authorized.service.GetAll(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return authorized.readable(ctx, tasks)
+}
+
+func (authorized *authorizedService) GetOccurrences(ctx context.Context, id int, count int) (model.GetOccurrencesResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return model.GetOccurrencesResponse{}, err
+	}
+	return authorized.service.GetOccurrences(ctx, id, count)
+}
+
+func (authorized *authorizedService) GetChildren(ctx context.Context, id int) ([]model.GetTaskTreeResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	children, err := authorized.service.GetChildren(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return readableNodes(lists, children), nil
+}
+
+func (authorized *authorizedService) GetTree(ctx context.Context, id int) (model.GetTaskTreeResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+
+	tree, err := authorized.service.GetTree(ctx, id)
+	if err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+	tree.Children = readableNodes(lists, tree.Children)
+	return tree, nil
+}
+
+func (authorized *authorizedService) GetDependencies(ctx context.Context, id int) (model.GetDependenciesResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleViewer); err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+
+	dependencies, err := authorized.service.GetDependencies(ctx, id)
+	if err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+	dependencies.BlockedBy = readableLinks(lists, dependencies.BlockedBy)
+	dependencies.Blocks = readableLinks(lists, dependencies.Blocks)
+	return dependencies, nil
+}
+
+func (authorized *authorizedService) GetNext(ctx context.Context) ([]model.GetTaskResponse, error) {
+	tasks, err := authorized.service.GetNext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return authorized.readable(ctx, tasks)
+}
+
+func (authorized *authorizedService) Upsert(ctx context.Context, request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
+	if err := authorized.authorizeUpsert(ctx, request); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return authorized.service.Upsert(ctx, request)
+}
+
+func (authorized *authorizedService) Move(ctx context.Context, id int, request model.MoveTaskRequest) (model.GetTaskResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	if request.ListId != nil {
+		if err := authorized.policy.AuthorizeList(ctx, *request.ListId, rolesEntity.RoleEditor); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+	return authorized.service.Move(ctx, id, request)
+}
+
+func (authorized *authorizedService) Clone(ctx context.Context, id int, deep bool) (model.GetTaskResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	if deep {
+		if err := authorized.authorizeDescendants(ctx, id); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+	return authorized.service.Clone(ctx, id, deep)
+}
+
+func (authorized *authorizedService) ParseQuick(ctx context.Context, request model.QuickAddRequest) (model.QuickTaskRequest, error) {
+	return authorized.service.ParseQuick(ctx, request)
+}
+
+func (authorized *authorizedService) QuickAdd(ctx context.Context, request model.QuickAddRequest) (model.GetTaskResponse, error) {
+	parsed, err := authorized.service.ParseQuick(ctx, request)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	if err = authorized.authorizeUpsert(ctx, parsed.UpsertTaskRequest); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return authorized.service.QuickAdd(ctx, request)
+}
+
+func (authorized *authorizedService) Archive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return authorized.service.Archive(ctx, id)
+}
+
+func (authorized *authorizedService) Unarchive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return authorized.service.Unarchive(ctx, id)
+}
+
+func (authorized *authorizedService) ArchiveDone(ctx context.Context, before time.Time) (int, error) {
+	return authorized.service.ArchiveDone(ctx, before)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, id int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	if err := authorized.authorizeDescendants(ctx, id); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, id)
+}
+
+func (authorized *authorizedService) AddDependency(ctx context.Context, id int, blockerId int) error {
+	if err := authorized.authorizeDependency(ctx, id, blockerId); err != nil {
+		return err
+	}
+	return authorized.service.AddDependency(ctx, id, blockerId)
+}
+
+func (authorized *authorizedService) RemoveDependency(ctx context.Context, id int, blockerId int) error {
+	if err := authorized.authorizeDependency(ctx, id, blockerId); err != nil {
+		return err
+	}
+	return authorized.service.RemoveDependency(ctx, id, blockerId)
+}
+
+func (authorized *authorizedService) authorizeUpsert(ctx context.Context, request model.UpsertTaskRequest) error {
+	if request.Id != nil {
+		if err := authorized.policy.AuthorizeTask(ctx, *request.Id, rolesEntity.RoleEditor); err != nil {
+			return err
+		}
+	} else {
+		listId := listsEntity.DefaultListId
+		if request.ListId != nil {
+			listId = *request.ListId
+		}
+		if err := authorized.policy.AuthorizeList(ctx, listId, rolesEntity.RoleEditor); err != nil {
+			return err
+		}
+	}
+
+	if request.ParentId != nil {
+		return authorized.policy.AuthorizeTask(ctx, *request.ParentId, rolesEntity.RoleEditor)
+	}
+	return nil
+}
+
+func (authorized *authorizedService) authorizeDependency(ctx context.Context, id int, blockerId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, id, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.policy.AuthorizeTask(ctx, blockerId, rolesEntity.RoleViewer)
+}
+
+func (authorized *authorizedService) authorizeDescendants(ctx context.Context, id int) error {
+	tree, err := authorized.service.GetTree(ctx, id)
+	if err != nil {
+		return err
+	}
+	return authorized.authorizeNodes(ctx, tree.Children)
+}
+
+func (authorized *authorizedService) authorizeNodes(ctx context.Context, nodes []model.GetTaskTreeResponse) error {
+	for _, node := range nodes {
+		if err := authorized.policy.AuthorizeList(ctx, node.ListId, rolesEntity.RoleEditor); err != nil {
+			return err
+		}
+		if err := authorized.authorizeNodes(ctx, node.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (authorized *authorizedService) readable(ctx context.Context, tasks []model.GetTaskResponse) ([]model.GetTaskResponse, error) {
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetTaskResponse
+	for _, task := range tasks {
+		if lists.Contains(task.ListId) {
+			dto = append(dto, task)
+		}
+	}
+	return dto, nil
+}
+
+func readableNodes(lists policy.Lists, nodes []model.GetTaskTreeResponse) []model.GetTaskTreeResponse {
+	if nodes == nil {
+		return nil
+	}
+
+	dto := []model.GetTaskTreeResponse{}
+	for _, node := range nodes {
+		if lists.Contains(node.ListId) {
+			node.Children = readableNodes(lists, node.Children)
+			dto = append(dto, node)
+		}
+	}
+	return dto
+}
+
+func readableLinks(lists policy.Lists, tasks []model.GetTaskResponse) []model.GetTaskResponse {
+	if tasks == nil {
+		return nil
+	}
+
+	dto := []model.GetTaskResponse{}
+	for _, task := range tasks {
+		if lists.Contains(task.ListId) {
+			dto = append(dto, task)
+		}
+	}
+	return dto
+}
@@ -0,0 +1,211 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	intPtr := func(value int) *int {
+		return &value
+	}
+
+	node := func(id int, listId int, children ...model.GetTaskTreeResponse) model.GetTaskTreeResponse {
+		return model.GetTaskTreeResponse{GetTaskResponse: model.GetTaskResponse{Id: id, ListId: listId}, Children: children}
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	Describe("reads", func() {
+		It("requires the viewer role on the list of the task", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(nil)
+			mockService.EXPECT().GetById(ctx, 1).Return(model.GetTaskResponse{Id: 1}, nil)
+
+			Expect(authorizedSvc.GetById(ctx, 1)).To(Equal(model.GetTaskResponse{Id: 1}))
+		})
+
+		It("does not call the service when forbidden", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.GetTree(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+		})
+
+		It("requires the viewer role on the requested list", func() {
+			request := model.GetTasksRequest{ListId: intPtr(2)}
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.GetAll(ctx, request)).Error().To(Equal(errors.ErrForbidden))
+		})
+	})
+
+	Describe("filters", func() {
+		var grants rolesDAO.Repository
+
+		BeforeEach(func() {
+			grants = rolesDAO.New(rolesDAO.WithGrants(
+				rolesEntity.Grant{ListId: 1, UserId: 1, Role: rolesEntity.RoleOwner},
+				rolesEntity.Grant{ListId: 2, UserId: 2, Role: rolesEntity.RoleViewer},
+			))
+			authorizedSvc = service.Authorize(mockService, policy.New(policy.WithGrants(grants)))
+		})
+
+		It("keeps the tasks of the readable lists", func() {
+			userId := 2
+			userCtx := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &userId})
+			tasks := []model.GetTaskResponse{{Id: 1, ListId: 0}, {Id: 2, ListId: 1}, {Id: 3, ListId: 2}}
+			mockService.EXPECT().GetAll(userCtx, model.GetTasksRequest{}).Return(tasks, nil)
+			mockService.EXPECT().GetNext(userCtx).Return(tasks, nil)
+
+			Expect(authorizedSvc.GetAll(userCtx, model.GetTasksRequest{})).
+				To(HaveExactElements(HaveField("Id", 1), HaveField("Id", 3)))
+			Expect(authorizedSvc.GetNext(userCtx)).
+				To(HaveExactElements(HaveField("Id", 1), HaveField("Id", 3)))
+		})
+
+		It("prunes the children in the lists that are not readable", func() {
+			userId := 2
+			userCtx := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &userId})
+			children := []model.GetTaskTreeResponse{node(2, 2, node(4, 1)), node(3, 1, node(5, 2))}
+			mockService.EXPECT().GetChildren(userCtx, 1).Return(children, nil)
+			mockService.EXPECT().GetTree(userCtx, 1).Return(node(1, 0, children...), nil)
+
+			readable := []model.GetTaskTreeResponse{node(2, 2)}
+			readable[0].Children = []model.GetTaskTreeResponse{}
+			Expect(authorizedSvc.GetChildren(userCtx, 1)).To(Equal(readable))
+			Expect(authorizedSvc.GetTree(userCtx, 1)).To(HaveField("Children", Equal(readable)))
+		})
+
+		It("drops the dependencies in the lists that are not readable", func() {
+			userId := 2
+			userCtx := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &userId})
+			mockService.EXPECT().GetDependencies(userCtx, 1).Return(model.GetDependenciesResponse{
+				Id:        1,
+				BlockedBy: []model.GetTaskResponse{{Id: 2, ListId: 1}, {Id: 3, ListId: 2}},
+				Blocks:    []model.GetTaskResponse{{Id: 4, ListId: 1}},
+			}, nil)
+
+			Expect(authorizedSvc.GetDependencies(userCtx, 1)).To(Equal(model.GetDependenciesResponse{
+				Id:        1,
+				BlockedBy: []model.GetTaskResponse{{Id: 3, ListId: 2}},
+				Blocks:    []model.GetTaskResponse{},
+			}))
+		})
+	})
+
+	Describe("writes", func() {
+		It("requires the editor role on the target list of a new task", func() {
+			request := model.UpsertTaskRequest{Name: "task", ListId: intPtr(2)}
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.Upsert(ctx, request)).Error().To(Equal(errors.ErrForbidden))
+		})
+
+		It("targets the default list and the parent of a new task", func() {
+			request := model.UpsertTaskRequest{Name: "task", ParentId: intPtr(3)}
+			mockPolicy.EXPECT().AuthorizeList(ctx, 0, rolesEntity.RoleEditor).Return(nil)
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 3, rolesEntity.RoleEditor).Return(nil)
+			mockService.EXPECT().Upsert(ctx, request).Return(model.GetTaskResponse{Id: 4}, nil)
+
+			Expect(authorizedSvc.Upsert(ctx, request)).To(HaveField("Id", 4))
+		})
+
+		It("requires the editor role on an updated task", func() {
+			request := model.UpsertTaskRequest{Id: intPtr(1), Name: "task"}
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.Upsert(ctx, request)).Error().To(Equal(errors.ErrForbidden))
+		})
+
+		It("requires the editor role on both lists of a move", func() {
+			request := model.MoveTaskRequest{ListId: intPtr(2)}
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.Move(ctx, 1, request)).Error().To(Equal(errors.ErrForbidden))
+		})
+
+		It("requires the editor role to remove a task", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.RemoveById(ctx, 1)).To(Equal(errors.ErrForbidden))
+		})
+
+		It("requires the editor role on the lists of the removed descendants", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockService.EXPECT().GetTree(ctx, 1).Return(node(1, 1, node(2, 1, node(3, 2))), nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.RemoveById(ctx, 1)).To(Equal(errors.ErrForbidden))
+		})
+
+		It("removes a task whose descendants are all editable", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockService.EXPECT().GetTree(ctx, 1).Return(node(1, 1, node(2, 1)), nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockService.EXPECT().RemoveById(ctx, 1).Return(nil)
+
+			Expect(authorizedSvc.RemoveById(ctx, 1)).To(Succeed())
+		})
+
+		It("requires the editor role on the lists of the descendants of a deep clone", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil).Times(2)
+			mockService.EXPECT().GetTree(ctx, 1).Return(node(1, 1, node(2, 2)), nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+			mockService.EXPECT().Clone(ctx, 1, false).Return(model.GetTaskResponse{Id: 3}, nil)
+
+			Expect(authorizedSvc.Clone(ctx, 1, true)).Error().To(Equal(errors.ErrForbidden))
+			Expect(authorizedSvc.Clone(ctx, 1, false)).To(HaveField("Id", 3))
+		})
+
+		It("authorizes the parsed list of a quick add", func() {
+			request := model.QuickAddRequest{Text: "task @work"}
+			mockService.EXPECT().ParseQuick(ctx, request).
+				Return(model.QuickTaskRequest{UpsertTaskRequest: model.UpsertTaskRequest{Name: "task", ListId: intPtr(2)}}, nil)
+			mockPolicy.EXPECT().AuthorizeList(ctx, 2, rolesEntity.RoleEditor).Return(nil)
+			mockService.EXPECT().QuickAdd(ctx, request).Return(model.GetTaskResponse{Id: 5}, nil)
+
+			Expect(authorizedSvc.QuickAdd(ctx, request)).To(HaveField("Id", 5))
+		})
+
+		It("requires the viewer role on the blocker of a dependency", func() {
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+			mockPolicy.EXPECT().AuthorizeTask(ctx, 2, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+			Expect(authorizedSvc.AddDependency(ctx, 1, 2)).To(Equal(errors.ErrForbidden))
+		})
+	})
+
+})
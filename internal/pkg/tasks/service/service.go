@@ -1,25 +1,96 @@
 package service
 
 import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	dependenciesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao"
+	dependencyEntity "github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao/entity"
+	listsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/quickadd"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/rank"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/recurrence"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tagsModel "github.com/aeon-fruit/dalil.git/internal/pkg/tags/model"
 	dao "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	usersDAO "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
 )
 
 type Service interface {
-	GetById(id int) (model.GetTaskResponse, error)
-	GetAll() ([]model.GetTaskResponse, error)
-	Upsert(request model.UpsertTaskRequest) (model.GetTaskResponse, error)
-	RemoveById(id int) error
+	GetById(ctx context.Context, id int) (model.GetTaskResponse, error)
+	GetAll(ctx context.Context, request model.GetTasksRequest) ([]model.GetTaskResponse, error)
+	GetOccurrences(ctx context.Context, id int, count int) (model.GetOccurrencesResponse, error)
+	GetChildren(ctx context.Context, id int) ([]model.GetTaskTreeResponse, error)
+	GetTree(ctx context.Context, id int) (model.GetTaskTreeResponse, error)
+	GetDependencies(ctx context.Context, id int) (model.GetDependenciesResponse, error)
+	GetNext(ctx context.Context) ([]model.GetTaskResponse, error)
+	Upsert(ctx context.Context, request model.UpsertTaskRequest) (model.GetTaskResponse, error)
+	Move(ctx context.Context, id int, request model.MoveTaskRequest) (model.GetTaskResponse, error)
+	Clone(ctx context.Context, id int, deep bool) (model.GetTaskResponse, error)
+	ParseQuick(ctx context.Context, request model.QuickAddRequest) (model.QuickTaskRequest, error)
+	QuickAdd(ctx context.Context, request model.QuickAddRequest) (model.GetTaskResponse, error)
+	Archive(ctx context.Context, id int) (model.GetTaskResponse, error)
+	Unarchive(ctx context.Context, id int) (model.GetTaskResponse, error)
+	ArchiveDone(ctx context.Context, before time.Time) (int, error)
+	RemoveById(ctx context.Context, id int) error
+	AddDependency(ctx context.Context, id int, blockerId int) error
+	RemoveDependency(ctx context.Context, id int, blockerId int) error
+}
+
+type ParentDeletion string
+
+const (
+	ParentDeletionBlock   = ParentDeletion("block")
+	ParentDeletionOrphan  = ParentDeletion("orphan")
+	ParentDeletionCascade = ParentDeletion("cascade")
+)
+
+type TagIndex interface {
+	GetTaskIds(ctx context.Context, names []string, matchAll bool) (map[int]bool, error)
+	TagTask(ctx context.Context, taskId int, names []string) error
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type WipLimiter interface {
+	GetByStatus(ctx context.Context, statusId int) ([]wipModel.GetWipLimitResponse, error)
+}
+
+type TaskCleaner interface {
+	RemoveTask(ctx context.Context, taskId int) error
 }
 
 type serviceImpl struct {
-	repository dao.Repository
+	repository   dao.Repository
+	recurrence   recurrence.Recurrence
+	quickAdd     quickadd.Parser
+	tags         TagIndex
+	lists        listsDAO.Repository
+	dependencies dependenciesDAO.Repository
+	limits       WipLimiter
+	users        usersDAO.Repository
+	cleaners     []TaskCleaner
+	deletion     ParentDeletion
+	clock        stubs.Clock
 }
 
 type ServiceOption func(*serviceImpl)
 
 func New(options ...ServiceOption) Service {
-	instance := serviceImpl{}
+	instance := serviceImpl{
+		recurrence: recurrence.New(),
+		quickAdd:   quickadd.New(),
+		deletion:   ParentDeletionBlock,
+		clock:      stubs.New(),
+	}
 
 	for _, option := range options {
 		if option != nil {
@@ -38,45 +109,1059 @@ func WithRepository(repository dao.Repository) ServiceOption {
 	}
 }
 
-func (service *serviceImpl) GetAll() ([]model.GetTaskResponse, error) {
-	entities, err := service.repository.GetAll()
+func WithRecurrence(recurrence recurrence.Recurrence) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && recurrence != nil {
+			service.recurrence = recurrence
+		}
+	}
+}
+
+func WithQuickAdd(quickAdd quickadd.Parser) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && quickAdd != nil {
+			service.quickAdd = quickAdd
+		}
+	}
+}
+
+func WithTags(tags TagIndex) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tags = tags
+		}
+	}
+}
+
+func WithLists(lists listsDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.lists = lists
+		}
+	}
+}
+
+func WithDependencies(dependencies dependenciesDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.dependencies = dependencies
+		}
+	}
+}
+
+func WithLimits(limits WipLimiter) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.limits = limits
+		}
+	}
+}
+
+func WithUsers(users usersDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.users = users
+		}
+	}
+}
+
+func WithCleaners(cleaners ...TaskCleaner) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.cleaners = append(service.cleaners, cleaners...)
+		}
+	}
+}
+
+func WithParentDeletion(deletion ParentDeletion) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			switch deletion {
+			case ParentDeletionBlock, ParentDeletionOrphan, ParentDeletionCascade:
+				service.deletion = deletion
+			}
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && clock != nil {
+			service.clock = clock
+		}
+	}
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context, request model.GetTasksRequest) ([]model.GetTaskResponse, error) {
+	if request.ListId != nil {
+		if err := service.checkList(ctx, *request.ListId); err != nil {
+			return nil, err
+		}
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := service.getBlocked(ctx, entities)
 	if err != nil {
 		return nil, err
 	}
 
+	if request.ListId != nil || request.AssigneeId != nil || !request.IncludeArchived {
+		var listed []entity.Task
+		for _, task := range entities {
+			if (request.ListId == nil || task.ListId == *request.ListId) &&
+				(request.AssigneeId == nil || task.IsAssignedTo(*request.AssigneeId)) &&
+				(request.IncludeArchived || !task.IsArchived()) {
+				listed = append(listed, task)
+			}
+		}
+		entities = listed
+	}
+
+	if len(request.Tags) > 0 && service.tags != nil {
+		ids, err := service.tags.GetTaskIds(ctx, request.Tags, request.TagMatch != model.TagMatchAny)
+		if err != nil {
+			return nil, err
+		}
+
+		var tagged []entity.Task
+		for _, task := range entities {
+			if ids[task.Id] {
+				tagged = append(tagged, task)
+			}
+		}
+		entities = tagged
+	}
+
+	switch request.Sort {
+	case model.SortByRank:
+		sort.SliceStable(entities, func(i, j int) bool {
+			if entities[i].StatusId != entities[j].StatusId {
+				return entities[i].StatusId < entities[j].StatusId
+			}
+			return entities[i].Rank < entities[j].Rank
+		})
+	case model.SortByPriority:
+		sort.SliceStable(entities, func(i, j int) bool {
+			if entities[i].Priority != entities[j].Priority {
+				return entities[i].Priority.Less(entities[j].Priority)
+			}
+			return entities[i].Rank < entities[j].Rank
+		})
+	}
+
 	var dto []model.GetTaskResponse
 	for _, task := range entities {
-		dto = append(dto, model.EntityToGetTaskResponse(task))
+		dto = append(dto, toResponse(task, blocked))
 	}
 	return dto, nil
 }
 
-func (service *serviceImpl) GetById(id int) (model.GetTaskResponse, error) {
-	task, err := service.repository.GetById(id)
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	task, err := service.repository.GetById(ctx, id)
 	if err != nil {
 		return model.GetTaskResponse{}, err
 	}
 
-	return model.EntityToGetTaskResponse(task), nil
+	if service.dependencies == nil {
+		return model.EntityToGetTaskResponse(task), nil
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	blocked, err := service.getBlocked(ctx, entities)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return toResponse(task, blocked), nil
 }
 
-func (service *serviceImpl) RemoveById(id int) error {
-	_, err := service.repository.RemoveById(id)
+func (service *serviceImpl) GetOccurrences(ctx context.Context, id int, count int) (model.GetOccurrencesResponse, error) {
+	task, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetOccurrencesResponse{}, err
+	}
+
+	dto := model.GetOccurrencesResponse{
+		Id:         task.Id,
+		Recurrence: task.Recurrence,
+	}
+	if task.Recurrence == "" || task.DueAt == nil {
+		return dto, nil
+	}
+
+	dto.Occurrences, err = service.recurrence.Preview(task.Recurrence, *task.DueAt, count)
+	if err != nil {
+		return model.GetOccurrencesResponse{}, err
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) GetChildren(ctx context.Context, id int) ([]model.GetTaskTreeResponse, error) {
+	if _, err := service.repository.GetById(ctx, id); err != nil {
+		return nil, err
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := service.getBlocked(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+
+	children := childrenOf(entities)
+
+	var dto []model.GetTaskTreeResponse
+	for _, child := range children[id] {
+		node := toTree(child, children, blocked, map[int]bool{id: true})
+		node.Children = nil
+		dto = append(dto, node)
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) GetTree(ctx context.Context, id int) (model.GetTaskTreeResponse, error) {
+	task, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+
+	blocked, err := service.getBlocked(ctx, entities)
+	if err != nil {
+		return model.GetTaskTreeResponse{}, err
+	}
+
+	return toTree(task, childrenOf(entities), blocked, map[int]bool{}), nil
+}
+
+func (service *serviceImpl) GetDependencies(ctx context.Context, id int) (model.GetDependenciesResponse, error) {
+	if _, err := service.repository.GetById(ctx, id); err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+
+	dto := model.GetDependenciesResponse{
+		Id:        id,
+		BlockedBy: []model.GetTaskResponse{},
+		Blocks:    []model.GetTaskResponse{},
+	}
+	if service.dependencies == nil {
+		return dto, nil
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+
+	dependencies, err := service.dependencies.GetAll(ctx)
+	if err != nil {
+		return model.GetDependenciesResponse{}, err
+	}
+
+	blocked := blockedIds(entities, dependencies)
+	tasks := map[int]entity.Task{}
+	for _, task := range entities {
+		tasks[task.Id] = task
+	}
+
+	for _, dependency := range dependencies {
+		if dependency.BlockedId == id {
+			if blocker, found := tasks[dependency.BlockerId]; found {
+				dto.BlockedBy = append(dto.BlockedBy, toResponse(blocker, blocked))
+			}
+		}
+		if dependency.BlockerId == id {
+			if blockedTask, found := tasks[dependency.BlockedId]; found {
+				dto.Blocks = append(dto.Blocks, toResponse(blockedTask, blocked))
+			}
+		}
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) GetNext(ctx context.Context) ([]model.GetTaskResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []dependencyEntity.Dependency
+	if service.dependencies != nil {
+		if dependencies, err = service.dependencies.GetAll(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	open := map[int]entity.Task{}
+	for _, task := range entities {
+		if !entity.IsDone(task.StatusId) && !task.IsArchived() {
+			open[task.Id] = task
+		}
+	}
+
+	blocks := map[int][]int{}
+	pending := map[int]int{}
+	for _, dependency := range dependencies {
+		_, blockerOpen := open[dependency.BlockerId]
+		_, blockedOpen := open[dependency.BlockedId]
+		if blockerOpen && blockedOpen {
+			blocks[dependency.BlockerId] = append(blocks[dependency.BlockerId], dependency.BlockedId)
+			pending[dependency.BlockedId]++
+		}
+	}
+
+	var ready []entity.Task
+	for _, task := range open {
+		if pending[task.Id] == 0 {
+			ready = append(ready, task)
+		}
+	}
+
+	blocked := blockedIds(entities, dependencies)
+
+	var dto []model.GetTaskResponse
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			return workBefore(ready[i], ready[j])
+		})
+
+		task := ready[0]
+		ready = ready[1:]
+		dto = append(dto, toResponse(task, blocked))
+
+		for _, blockedId := range blocks[task.Id] {
+			if pending[blockedId]--; pending[blockedId] == 0 {
+				ready = append(ready, open[blockedId])
+			}
+		}
+	}
+	return dto, nil
+}
+
+func workBefore(task entity.Task, other entity.Task) bool {
+	if task.Priority != other.Priority {
+		return task.Priority.Less(other.Priority)
+	}
+	if task.Rank != other.Rank {
+		return task.Rank < other.Rank
+	}
+	return task.Id < other.Id
+}
+
+func (service *serviceImpl) AddDependency(ctx context.Context, id int, blockerId int) error {
+	if id == blockerId {
+		return errors.ErrInvalidArgument
+	}
+
+	for _, taskId := range []int{id, blockerId} {
+		if _, err := service.repository.GetById(ctx, taskId); err != nil {
+			return err
+		}
+	}
+
+	if service.dependencies == nil {
+		return errors.ErrInvalidArgument
+	}
+
+	dependencies, err := service.dependencies.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	blocks := map[int][]int{}
+	for _, dependency := range dependencies {
+		blocks[dependency.BlockerId] = append(blocks[dependency.BlockerId], dependency.BlockedId)
+	}
+	if reachable(id, blockerId, blocks, map[int]bool{}) {
+		return errors.ErrConflict
+	}
+
+	_, err = service.dependencies.Insert(ctx, dependencyEntity.Dependency{BlockerId: blockerId, BlockedId: id})
+	if err == errors.ErrNotModified {
+		return nil
+	}
 	return err
 }
 
-func (service *serviceImpl) Upsert(request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
+func (service *serviceImpl) RemoveDependency(ctx context.Context, id int, blockerId int) error {
+	if service.dependencies == nil {
+		return errors.ErrNotFound
+	}
+
+	return service.dependencies.Remove(ctx, blockerId, id)
+}
+
+func reachable(from int, to int, edges map[int][]int, visited map[int]bool) bool {
+	if from == to {
+		return true
+	}
+	visited[from] = true
+
+	for _, next := range edges[from] {
+		if !visited[next] && reachable(next, to, edges, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func (service *serviceImpl) getBlocked(ctx context.Context, entities []entity.Task) (map[int]bool, error) {
+	if service.dependencies == nil {
+		return nil, nil
+	}
+
+	dependencies, err := service.dependencies.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return blockedIds(entities, dependencies), nil
+}
+
+func blockedIds(entities []entity.Task, dependencies []dependencyEntity.Dependency) map[int]bool {
+	done := map[int]bool{}
+	for _, task := range entities {
+		done[task.Id] = entity.IsDone(task.StatusId)
+	}
+
+	blocked := map[int]bool{}
+	for _, dependency := range dependencies {
+		if isDone, found := done[dependency.BlockerId]; found && !isDone {
+			blocked[dependency.BlockedId] = true
+		}
+	}
+	return blocked
+}
+
+func toResponse(task entity.Task, blocked map[int]bool) model.GetTaskResponse {
+	dto := model.EntityToGetTaskResponse(task)
+	dto.Blocked = blocked[task.Id]
+	return dto
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	children := childrenOf(entities)
+	if len(children[id]) > 0 {
+		switch service.deletion {
+		case ParentDeletionBlock:
+			return errors.ErrConflict
+		case ParentDeletionOrphan:
+			for _, child := range children[id] {
+				child.ParentId = nil
+				if _, err := service.repository.Update(ctx, child); err != nil {
+					return err
+				}
+			}
+		case ParentDeletionCascade:
+			for _, descendant := range descendants(id, children, map[int]bool{id: true}) {
+				if err := service.remove(ctx, descendant.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return service.remove(ctx, id)
+}
+
+func (service *serviceImpl) remove(ctx context.Context, id int) error {
+	if _, err := service.repository.RemoveById(ctx, id); err != nil {
+		return err
+	}
+
+	if service.dependencies != nil {
+		if err := service.dependencies.RemoveTask(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	for _, cleaner := range service.cleaners {
+		if err := cleaner.RemoveTask(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if service.tags != nil {
+		return service.tags.RemoveTask(ctx, id)
+	}
+	return nil
+}
+
+func childrenOf(entities []entity.Task) map[int][]entity.Task {
+	children := map[int][]entity.Task{}
+	for _, task := range entities {
+		if task.ParentId != nil {
+			children[*task.ParentId] = append(children[*task.ParentId], task)
+		}
+	}
+	return children
+}
+
+func descendants(id int, children map[int][]entity.Task, visited map[int]bool) []entity.Task {
+	var tasks []entity.Task
+	for _, child := range children[id] {
+		if visited[child.Id] {
+			continue
+		}
+		visited[child.Id] = true
+		tasks = append(tasks, descendants(child.Id, children, visited)...)
+		tasks = append(tasks, child)
+	}
+	return tasks
+}
+
+func toTree(task entity.Task, children map[int][]entity.Task, blocked map[int]bool, visited map[int]bool) model.GetTaskTreeResponse {
+	node, completion := buildTree(task, children, blocked, visited)
+	node.Completion = int(math.Round(completion * 100))
+	return node
+}
+
+func buildTree(task entity.Task, children map[int][]entity.Task, blocked map[int]bool, visited map[int]bool) (model.GetTaskTreeResponse, float64) {
+	visited[task.Id] = true
+	node := model.GetTaskTreeResponse{
+		GetTaskResponse: toResponse(task, blocked),
+	}
+
+	var sum float64
+	for _, child := range children[task.Id] {
+		if visited[child.Id] {
+			continue
+		}
+		childNode, completion := buildTree(child, children, blocked, visited)
+		childNode.Completion = int(math.Round(completion * 100))
+		node.Children = append(node.Children, childNode)
+		sum += completion
+	}
+
+	completion := 0.0
+	if entity.IsDone(task.StatusId) {
+		completion = 1
+	} else if len(node.Children) > 0 {
+		completion = sum / float64(len(node.Children))
+	}
+	return node, completion
+}
+
+func (service *serviceImpl) Upsert(ctx context.Context, request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
 	task := request.ToEntity()
 
+	if task.Recurrence != "" {
+		if err := service.recurrence.Validate(task.Recurrence); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+
+	if err := service.checkParent(ctx, &task, request); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	if err := service.checkAssignees(ctx, task, request); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	task.UpdatedBy = actor(ctx)
+
+	if service.limits != nil && !request.OverrideWipLimit {
+		var from *entity.Task
+		to := task
+		if request.Id != nil {
+			oldTask, err := service.repository.GetById(ctx, task.Id)
+			if err != nil {
+				return model.GetTaskResponse{}, err
+			}
+			from, to.ListId = &oldTask, oldTask.ListId
+		}
+
+		if err := service.checkLimits(ctx, from, to); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+
 	var err error
 	if request.Id == nil {
-		task, err = service.repository.Insert(task)
+		if err = service.checkList(ctx, task.ListId); err == nil {
+			task.CreatedBy = task.UpdatedBy
+			task, err = service.repository.Insert(ctx, task)
+		}
 	} else {
-		task, err = service.repository.Update(task)
+		var oldTask entity.Task
+		oldTask, err = service.repository.Update(ctx, task)
+		if err == nil && !entity.IsDone(oldTask.StatusId) && entity.IsDone(task.StatusId) {
+			task.ListId = oldTask.ListId
+			task.Checklist = oldTask.Checklist
+			err = service.insertNextOccurrence(ctx, task)
+		}
+		task = oldTask
+	}
+
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return model.EntityToGetTaskResponse(task), nil
+}
+
+func (service *serviceImpl) Clone(ctx context.Context, id int, deep bool) (model.GetTaskResponse, error) {
+	task, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	var children map[int][]entity.Task
+	if deep {
+		entities, err := service.repository.GetAll(ctx)
+		if err != nil {
+			return model.GetTaskResponse{}, err
+		}
+		children = childrenOf(entities)
+	}
+
+	cloneId, err := service.clone(ctx, task, task.ParentId, deep, children, map[int]bool{task.Id: true})
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return service.GetById(ctx, cloneId)
+}
+
+func (service *serviceImpl) clone(ctx context.Context, task entity.Task, parentId *int, deep bool, children map[int][]entity.Task, visited map[int]bool) (int, error) {
+	listId := task.ListId
+	dto, err := service.Upsert(ctx, model.UpsertTaskRequest{
+		Name:        task.Name,
+		StatusId:    entity.StatusIdTodo,
+		Description: task.Description,
+		DueAt:       task.DueAt,
+		Recurrence:  task.Recurrence,
+		Priority:    task.Priority.String(),
+		ListId:      &listId,
+		ParentId:    parentId,
+		Estimate:    task.Estimate,
+		AssigneeIds: task.AssigneeIds,
+	})
+	if err != nil || !deep {
+		return dto.Id, err
+	}
+
+	if len(task.Checklist) > 0 {
+		var checklist []entity.ChecklistItem
+		for _, item := range task.Checklist {
+			item.Done = false
+			checklist = append(checklist, item)
+		}
+		if _, err = service.repository.UpdateChecklist(ctx, dto.Id, checklist); err != nil {
+			return dto.Id, err
+		}
+	}
+
+	for _, child := range children[task.Id] {
+		if visited[child.Id] {
+			continue
+		}
+		visited[child.Id] = true
+		if _, err = service.clone(ctx, child, &dto.Id, deep, children, visited); err != nil {
+			return dto.Id, err
+		}
+	}
+	return dto.Id, nil
+}
+
+func (service *serviceImpl) ParseQuick(ctx context.Context, request model.QuickAddRequest) (model.QuickTaskRequest, error) {
+	task, err := service.quickAdd.Parse(request.Text)
+	if err != nil {
+		return model.QuickTaskRequest{}, err
+	}
+
+	for _, name := range task.Tags {
+		if !tagsModel.IsValidName(name) {
+			return model.QuickTaskRequest{}, errors.ErrInvalidArgument
+		}
+	}
+
+	var listId *int
+	if task.Context != "" {
+		list, err := service.findList(ctx, task.Context)
+		if err == nil {
+			listId = &list.Id
+		} else if err != errors.ErrNotFound {
+			return model.QuickTaskRequest{}, err
+		}
+	}
+
+	return model.QuickTaskRequest{
+		UpsertTaskRequest: model.UpsertTaskRequest{
+			Name:     task.Name,
+			StatusId: entity.StatusIdTodo,
+			DueAt:    task.DueAt,
+			Priority: task.Priority,
+			ListId:   listId,
+		},
+		Tags:    task.Tags,
+		Context: task.Context,
+	}, nil
+}
+
+func (service *serviceImpl) QuickAdd(ctx context.Context, request model.QuickAddRequest) (model.GetTaskResponse, error) {
+	parsed, err := service.ParseQuick(ctx, request)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	task, err := service.Upsert(ctx, parsed.UpsertTaskRequest)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	if len(parsed.Tags) > 0 && service.tags != nil {
+		if err = service.tags.TagTask(ctx, task.Id, parsed.Tags); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+	return task, nil
+}
+
+func (service *serviceImpl) findList(ctx context.Context, name string) (listsEntity.List, error) {
+	if service.lists == nil {
+		return listsEntity.List{}, errors.ErrNotFound
+	}
+
+	lists, err := service.lists.GetAll(ctx)
+	if err != nil {
+		return listsEntity.List{}, err
+	}
+
+	for _, list := range lists {
+		if strings.EqualFold(list.Name, name) {
+			return list, nil
+		}
+	}
+	return listsEntity.List{}, errors.ErrNotFound
+}
+
+func (service *serviceImpl) Archive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	now := service.clock.Now()
+	return service.setArchived(ctx, id, &now)
+}
+
+func (service *serviceImpl) Unarchive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	return service.setArchived(ctx, id, nil)
+}
+
+func (service *serviceImpl) ArchiveDone(ctx context.Context, before time.Time) (int, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := service.clock.Now()
+	var count int
+	for _, task := range entities {
+		if !entity.IsDone(task.StatusId) || task.IsArchived() || !task.UpdatedAt.Before(before) {
+			continue
+		}
+		if _, err = service.repository.SetArchived(ctx, task.Id, &now); err == nil {
+			count++
+		} else if err != errors.ErrNotModified {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+func (service *serviceImpl) setArchived(ctx context.Context, id int, archivedAt *time.Time) (model.GetTaskResponse, error) {
+	if _, err := service.repository.SetArchived(ctx, id, archivedAt); err != nil {
+		return model.GetTaskResponse{}, err
+	}
+	return service.GetById(ctx, id)
+}
+
+func (service *serviceImpl) Move(ctx context.Context, id int, request model.MoveTaskRequest) (model.GetTaskResponse, error) {
+	task, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	to := task
+	if request.ListId != nil && *request.ListId != task.ListId {
+		if err = service.checkList(ctx, *request.ListId); err == errors.ErrNotFound {
+			return model.GetTaskResponse{}, errors.ErrInvalidArgument
+		} else if err != nil {
+			return model.GetTaskResponse{}, err
+		}
+		to.ListId = *request.ListId
+	}
+	if request.StatusId != nil {
+		to.StatusId = *request.StatusId
+	}
+
+	if !request.OverrideWipLimit {
+		if err = service.checkLimits(ctx, &task, to); err != nil {
+			return model.GetTaskResponse{}, err
+		}
 	}
 
+	entities, err := service.repository.GetAll(ctx)
 	if err != nil {
 		return model.GetTaskResponse{}, err
 	}
+
+	anchored := request.StatusId != nil && (request.Before != nil || request.After != nil)
+
+	var ranked []entity.Task
+	for _, other := range entities {
+		if other.Id != id && other.ListId == to.ListId && (!anchored || other.StatusId == to.StatusId) {
+			ranked = append(ranked, other)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rank < ranked[j].Rank
+	})
+
+	lower, upper, err := rankBounds(ranked, request)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	to.Rank, err = rank.Between(lower, upper)
+	if err != nil {
+		return model.GetTaskResponse{}, err
+	}
+
+	statusId := task.StatusId
+	if to.ListId != task.ListId {
+		task, err = service.repository.MoveToList(ctx, id, to.ListId)
+		if err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
+
+	task.StatusId = to.StatusId
+	task.Rank = to.Rank
+	task.UpdatedBy = actor(ctx)
+
+	if _, err = service.repository.Update(ctx, task); err != nil && err != errors.ErrNotModified {
+		return model.GetTaskResponse{}, err
+	}
+
+	if !entity.IsDone(statusId) && entity.IsDone(task.StatusId) {
+		if err = service.insertNextOccurrence(ctx, task); err != nil {
+			return model.GetTaskResponse{}, err
+		}
+	}
 	return model.EntityToGetTaskResponse(task), nil
 }
+
+func rankBounds(ranked []entity.Task, request model.MoveTaskRequest) (lower string, upper string, err error) {
+	indexOf := func(id int) int {
+		for index, task := range ranked {
+			if task.Id == id {
+				return index
+			}
+		}
+		return -1
+	}
+
+	if request.After != nil {
+		index := indexOf(*request.After)
+		if index < 0 {
+			return "", "", errors.ErrInvalidArgument
+		}
+		lower = ranked[index].Rank
+		if request.Before == nil && index+1 < len(ranked) {
+			upper = ranked[index+1].Rank
+		}
+	}
+
+	if request.Before != nil {
+		index := indexOf(*request.Before)
+		if index < 0 {
+			return "", "", errors.ErrInvalidArgument
+		}
+		upper = ranked[index].Rank
+		if request.After == nil && index > 0 {
+			lower = ranked[index-1].Rank
+		}
+	}
+
+	if request.After == nil && request.Before == nil && len(ranked) > 0 {
+		lower = ranked[len(ranked)-1].Rank
+	}
+
+	return lower, upper, nil
+}
+
+func (service *serviceImpl) insertNextOccurrence(ctx context.Context, task entity.Task) error {
+	if task.Recurrence == "" || task.DueAt == nil {
+		return nil
+	}
+
+	dueAt, rule, err := service.recurrence.Next(task.Recurrence, *task.DueAt)
+	if err == errors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var checklist []entity.ChecklistItem
+	for _, item := range task.Checklist {
+		item.Done = false
+		checklist = append(checklist, item)
+	}
+
+	userId := actor(ctx)
+	_, err = service.repository.Insert(ctx, entity.Task{
+		Name:        task.Name,
+		StatusId:    entity.StatusIdTodo,
+		Description: task.Description,
+		DueAt:       &dueAt,
+		Recurrence:  rule,
+		ListId:      task.ListId,
+		ParentId:    task.ParentId,
+		Checklist:   checklist,
+		Estimate:    task.Estimate,
+		AssigneeIds: task.AssigneeIds,
+		CreatedBy:   userId,
+		UpdatedBy:   userId,
+	})
+	return err
+}
+
+func (service *serviceImpl) checkParent(ctx context.Context, task *entity.Task, request model.UpsertTaskRequest) error {
+	if task.ParentId == nil {
+		return nil
+	}
+
+	if request.Id != nil && *task.ParentId == task.Id {
+		return errors.ErrInvalidArgument
+	}
+
+	parent, err := service.repository.GetById(ctx, *task.ParentId)
+	if err == errors.ErrNotFound {
+		return errors.ErrInvalidArgument
+	}
+	if err != nil {
+		return err
+	}
+
+	if request.Id == nil && request.ListId == nil {
+		task.ListId = parent.ListId
+	}
+
+	visited := map[int]bool{parent.Id: true}
+	for ancestor := parent; request.Id != nil && ancestor.ParentId != nil; {
+		if *ancestor.ParentId == task.Id || visited[*ancestor.ParentId] {
+			return errors.ErrInvalidArgument
+		}
+		visited[*ancestor.ParentId] = true
+
+		ancestor, err = service.repository.GetById(ctx, *ancestor.ParentId)
+		if err == errors.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *serviceImpl) checkLimits(ctx context.Context, from *entity.Task, to entity.Task) error {
+	if service.limits == nil {
+		return nil
+	}
+
+	statusChanged := from == nil || from.StatusId != to.StatusId
+	if !statusChanged && from.ListId == to.ListId {
+		return nil
+	}
+
+	limits, err := service.limits.GetByStatus(ctx, to.StatusId)
+	if err != nil || len(limits) == 0 {
+		return err
+	}
+
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, limit := range limits {
+		if (limit.ListId == nil && !statusChanged) || (limit.ListId != nil && *limit.ListId != to.ListId) {
+			continue
+		}
+
+		count := 0
+		for _, task := range entities {
+			if task.StatusId == to.StatusId && !task.IsArchived() && (from == nil || task.Id != from.Id) &&
+				(limit.ListId == nil || task.ListId == *limit.ListId) {
+				count++
+			}
+		}
+
+		if count >= limit.Limit {
+			return wipModel.ExceededError{StatusId: limit.StatusId, ListId: limit.ListId, Limit: limit.Limit, Count: count}
+		}
+	}
+	return nil
+}
+
+func (service *serviceImpl) checkList(ctx context.Context, listId int) error {
+	if service.lists == nil {
+		return nil
+	}
+
+	_, err := service.lists.GetById(ctx, listId)
+	return err
+}
+
+func (service *serviceImpl) checkAssignees(ctx context.Context, task entity.Task, request model.UpsertTaskRequest) error {
+	if service.users == nil || len(task.AssigneeIds) == 0 {
+		return nil
+	}
+
+	var oldTask entity.Task
+	if request.Id != nil {
+		var err error
+		if oldTask, err = service.repository.GetById(ctx, task.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, assigneeId := range task.AssigneeIds {
+		user, err := service.users.GetById(ctx, assigneeId)
+		if err == errors.ErrNotFound {
+			return errors.ErrInvalidArgument
+		}
+		if err != nil {
+			return err
+		}
+		if !user.IsActive() && !oldTask.IsAssignedTo(assigneeId) {
+			return errors.ErrInvalidArgument
+		}
+	}
+	return nil
+}
+
+func actor(ctx context.Context) *int {
+	principal, err := reqctx.GetPrincipal(ctx)
+	if err != nil {
+		return nil
+	}
+	return principal.UserId
+}
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,14 +9,31 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	dependencyEntity "github.com/aeon-fruit/dalil.git/internal/pkg/dependencies/dao/entity"
+	listsRepository "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/quickadd"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/recurrence"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
 	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	usersRepository "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	usersEntity "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	wipModel "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	dependenciesDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/dependencies/dao"
+	listsDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/dao"
 	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
 )
 
 var _ = Describe("Service", func() {
 
+	ctx := context.Background()
+
 	const (
 		id              = 1
 		taskName        = "A task"
@@ -45,9 +63,9 @@ var _ = Describe("Service", func() {
 
 	Describe("WithRepository", func() {
 		It("changes a non-nil instance", func() {
-			mockRepository.EXPECT().GetAll().Return(nil, customErr)
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
 
-			Expect(tasksSvc.GetAll()).Error().To(Equal(customErr))
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{})).Error().To(Equal(customErr))
 		})
 	})
 
@@ -55,9 +73,9 @@ var _ = Describe("Service", func() {
 
 		When("an error happens while retrieving the dao", func() {
 			It("returns an empty dto plus the error", func() {
-				mockRepository.EXPECT().GetById(gomock.Any()).Return(entity.Task{}, customErr)
+				mockRepository.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(entity.Task{}, customErr)
 
-				Expect(tasksSvc.GetById(id)).Error().To(Equal(customErr))
+				Expect(tasksSvc.GetById(ctx, id)).Error().To(Equal(customErr))
 			})
 		})
 
@@ -89,9 +107,9 @@ var _ = Describe("Service", func() {
 					CreatedAt:   createdAt,
 					UpdatedAt:   updatedAt,
 				}
-				mockRepository.EXPECT().GetById(gomock.Any()).Return(dao, nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(dao, nil)
 
-				Expect(tasksSvc.GetById(id)).To(Equal(dto))
+				Expect(tasksSvc.GetById(ctx, id)).To(Equal(dto))
 			})
 		})
 
@@ -101,17 +119,17 @@ var _ = Describe("Service", func() {
 
 		When("an error happens while retrieving the list of dao", func() {
 			It("returns nil and the error", func() {
-				mockRepository.EXPECT().GetAll().Return(nil, customErr)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
 
-				Expect(tasksSvc.GetAll()).Error().To(Equal(customErr))
+				Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{})).Error().To(Equal(customErr))
 			})
 		})
 
 		When("the list of dao is empty", func() {
 			It("returns nil and no error", func() {
-				mockRepository.EXPECT().GetAll().Return(nil, nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
 
-				Expect(tasksSvc.GetAll()).To(BeNil())
+				Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{})).To(BeNil())
 			})
 		})
 
@@ -168,14 +186,285 @@ var _ = Describe("Service", func() {
 					},
 				}
 
-				mockRepository.EXPECT().GetAll().Return(daoList, nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
 
-				Expect(tasksSvc.GetAll()).To(Equal(dtoList))
+				Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{})).To(Equal(dtoList))
 			})
 		})
 
 	})
 
+	Describe("GetAll with a sort", func() {
+		var daoList []entity.Task
+
+		BeforeEach(func() {
+			daoList = []entity.Task{
+				{Id: 0, StatusId: entity.StatusIdDone, Priority: entity.PriorityP0, Rank: "a"},
+				{Id: 1, StatusId: entity.StatusIdTodo, Priority: entity.PriorityUnset, Rank: "c"},
+				{Id: 2, StatusId: entity.StatusIdTodo, Priority: entity.PriorityP3, Rank: "b"},
+				{Id: 3, StatusId: entity.StatusIdInProgress, Priority: entity.PriorityP3, Rank: "d"},
+			}
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+		})
+
+		ids := func(dtoList []model.GetTaskResponse) (ids []int) {
+			for _, dto := range dtoList {
+				ids = append(ids, dto.Id)
+			}
+			return
+		}
+
+		It("sorts by rank within status", func() {
+			dtoList, err := tasksSvc.GetAll(ctx, model.GetTasksRequest{Sort: model.SortByRank})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids(dtoList)).To(Equal([]int{2, 1, 3, 0}))
+		})
+
+		It("sorts by priority then rank, unset priorities last", func() {
+			dtoList, err := tasksSvc.GetAll(ctx, model.GetTasksRequest{Sort: model.SortByPriority})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids(dtoList)).To(Equal([]int{0, 2, 3, 1}))
+		})
+	})
+
+	Describe("GetAll in a list", func() {
+		var mockLists *listsDaoMock.MockRepository
+
+		BeforeEach(func() {
+			mockLists = listsDaoMock.NewMockRepository(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(mockRepository), service.WithLists(mockLists))
+		})
+
+		It("returns ErrNotFound when the list is not found", func() {
+			listId := 7
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{ListId: &listId})).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("keeps the tasks of the list only", func() {
+			listId := 1
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{Id: listId}, nil)
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.Task{{Id: 0}, {Id: 1, ListId: 1}, {Id: 2, ListId: 2}}, nil)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{ListId: &listId})).To(HaveExactElements(HaveField("Id", 1)))
+		})
+
+		It("refuses to add a task to an unknown list", func() {
+			listId := 7
+			mockLists.EXPECT().GetById(gomock.Any(), listId).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "task", ListId: &listId})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("GetAll with tags", func() {
+		var mockTags *serviceMock.MockTagIndex
+
+		BeforeEach(func() {
+			mockTags = serviceMock.NewMockTagIndex(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(mockRepository), service.WithTags(mockTags))
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.Task{{Id: 0}, {Id: 1}, {Id: 2}}, nil)
+		})
+
+		It("keeps the tasks having all the tags by default", func() {
+			mockTags.EXPECT().GetTaskIds(gomock.Any(), []string{"bug", "urgent"}, true).Return(map[int]bool{0: true, 2: true}, nil)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{Tags: []string{"bug", "urgent"}})).
+				To(HaveExactElements(HaveField("Id", 0), HaveField("Id", 2)))
+		})
+
+		It("keeps the tasks having any of the tags when asked", func() {
+			mockTags.EXPECT().GetTaskIds(gomock.Any(), []string{"bug"}, false).Return(map[int]bool{1: true}, nil)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{Tags: []string{"bug"}, TagMatch: model.TagMatchAny})).
+				To(HaveExactElements(HaveField("Id", 1)))
+		})
+
+		It("returns the error of the tag lookup", func() {
+			mockTags.EXPECT().GetTaskIds(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, customErr)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{Tags: []string{"bug"}})).Error().To(Equal(customErr))
+		})
+	})
+
+	Describe("Move", func() {
+		var daoList []entity.Task
+
+		anchor := func(id int) *int {
+			return &id
+		}
+
+		BeforeEach(func() {
+			daoList = []entity.Task{
+				{Id: 0, Name: "zero", Rank: "i"},
+				{Id: 1, Name: "one", Rank: "r"},
+				{Id: 2, Name: "two", Rank: "u"},
+			}
+		})
+
+		When("the task is not found", func() {
+			It("returns ErrNotFound", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 5).Return(entity.Task{}, errors.ErrNotFound)
+
+				Expect(tasksSvc.Move(ctx, 5, model.MoveTaskRequest{After: anchor(1)})).Error().To(Equal(errors.ErrNotFound))
+			})
+		})
+
+		When("an anchor is not found", func() {
+			It("returns ErrInvalidArgument", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+
+				Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{After: anchor(7)})).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		When("the anchors are in the wrong order", func() {
+			It("returns ErrInvalidArgument", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+
+				Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{After: anchor(2), Before: anchor(1)})).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		DescribeTable("updates the rank of the task only",
+			func(id int, request model.MoveTaskRequest, lower string, upper string) {
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(daoList[id], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.Name).To(Equal(daoList[id].Name))
+					Expect(task.Rank > lower).To(BeTrue())
+					if upper != "" {
+						Expect(task.Rank < upper).To(BeTrue())
+					}
+					return daoList[id], nil
+				})
+
+				dto, err := tasksSvc.Move(ctx, id, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.Rank).NotTo(Equal(daoList[id].Rank))
+			},
+			Entry("after the last task", 0, model.MoveTaskRequest{After: anchor(2)}, "u", ""),
+			Entry("after a task followed by another", 2, model.MoveTaskRequest{After: anchor(0)}, "i", "r"),
+			Entry("before the first task", 2, model.MoveTaskRequest{Before: anchor(0)}, "", "i"),
+			Entry("before a task preceded by another", 0, model.MoveTaskRequest{Before: anchor(2)}, "r", "u"),
+			Entry("between two tasks", 1, model.MoveTaskRequest{After: anchor(0), Before: anchor(2)}, "i", "u"),
+		)
+
+		Context("across lists", func() {
+			var mockLists *listsDaoMock.MockRepository
+
+			BeforeEach(func() {
+				mockLists = listsDaoMock.NewMockRepository(mockCtrl)
+				tasksSvc = service.New(service.WithRepository(mockRepository), service.WithLists(mockLists))
+				daoList = append(daoList, entity.Task{Id: 3, Name: "three", Rank: "c", ListId: 1, Seq: 1})
+			})
+
+			When("the list is not found", func() {
+				It("returns ErrInvalidArgument", func() {
+					mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+					mockLists.EXPECT().GetById(gomock.Any(), 7).Return(listsEntity.List{}, errors.ErrNotFound)
+
+					Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{ListId: anchor(7)})).Error().To(Equal(errors.ErrInvalidArgument))
+				})
+			})
+
+			When("the list is found", func() {
+				It("moves the task at the end of the list", func() {
+					moved := daoList[0]
+					moved.ListId, moved.Seq = 1, 2
+					mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+					mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+					mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+					mockRepository.EXPECT().MoveToList(gomock.Any(), 0, 1).Return(moved, nil)
+					mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+						Expect(task.Rank > "c").To(BeTrue())
+						return moved, nil
+					})
+
+					dto, err := tasksSvc.Move(ctx, 0, model.MoveTaskRequest{ListId: anchor(1)})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(dto.ListId).To(Equal(1))
+					Expect(dto.Seq).To(Equal(2))
+				})
+
+				It("only accepts anchors of the destination list and leaves the task in its list otherwise", func() {
+					mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+					mockLists.EXPECT().GetById(gomock.Any(), 1).Return(listsEntity.List{Id: 1}, nil)
+					mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+					mockRepository.EXPECT().MoveToList(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+					mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+					Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{ListId: anchor(1), After: anchor(2)})).Error().
+						To(Equal(errors.ErrInvalidArgument))
+				})
+			})
+		})
+
+		Context("across statuses", func() {
+			BeforeEach(func() {
+				daoList[1].StatusId = entity.StatusIdInProgress
+			})
+
+			It("changes the status and moves the task at the end of the list", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.StatusId).To(Equal(entity.StatusIdInProgress))
+					Expect(task.Rank > "u").To(BeTrue())
+					return daoList[0], nil
+				})
+
+				dto, err := tasksSvc.Move(ctx, 0, model.MoveTaskRequest{StatusId: anchor(entity.StatusIdInProgress)})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.StatusId).To(Equal(entity.StatusIdInProgress))
+			})
+
+			It("changes the status and the rank in a single update", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(daoList[2], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.StatusId).To(Equal(entity.StatusIdInProgress))
+					Expect(task.Rank < "r").To(BeTrue())
+					return daoList[2], nil
+				})
+
+				Expect(tasksSvc.Move(ctx, 2, model.MoveTaskRequest{StatusId: anchor(entity.StatusIdInProgress), Before: anchor(1)})).
+					Error().NotTo(HaveOccurred())
+			})
+
+			It("only accepts anchors of the destination status", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+
+				Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{StatusId: anchor(entity.StatusIdInProgress), After: anchor(2)})).
+					Error().To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("inserts the next occurrence of a recurring task moved to done", func() {
+				dueAt := time.Date(2023, 3, 13, 9, 0, 0, 0, time.UTC)
+				daoList[0].DueAt, daoList[0].Recurrence = &dueAt, "FREQ=DAILY"
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(daoList[0], nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.StatusId).To(Equal(entity.StatusIdTodo))
+					Expect(*task.DueAt).To(Equal(dueAt.AddDate(0, 0, 1)))
+					return task, nil
+				})
+
+				Expect(tasksSvc.Move(ctx, 0, model.MoveTaskRequest{StatusId: anchor(entity.StatusIdDone)})).Error().NotTo(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("Upsert", func() {
 		var dao entity.Task
 		var inDto model.UpsertTaskRequest
@@ -219,25 +508,25 @@ var _ = Describe("Service", func() {
 		When("the id in the dto is nil", func() {
 
 			It("tries to insert and no update is called", func() {
-				mockRepository.EXPECT().Insert(gomock.Any()).Times(1)
-				mockRepository.EXPECT().Update(gomock.Any()).Times(0)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Times(1)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 
-				tasksSvc.Upsert(inDto)
+				tasksSvc.Upsert(ctx, inDto)
 			})
 
 			When("an error happens while inserting the new dao", func() {
 				It("returns an empty dto and the error", func() {
-					mockRepository.EXPECT().Insert(gomock.Any()).Return(entity.Task{}, customErr)
+					mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.Task{}, customErr)
 
-					Expect(tasksSvc.Upsert(inDto)).Error().To(Equal(customErr))
+					Expect(tasksSvc.Upsert(ctx, inDto)).Error().To(Equal(customErr))
 				})
 			})
 
 			When("inserting the new dao is successful", func() {
 				It("returns the new dto with an id and no error", func() {
-					mockRepository.EXPECT().Insert(gomock.Any()).Return(dao, nil)
+					mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(dao, nil)
 
-					Expect(tasksSvc.Upsert(inDto)).To(Equal(outDto))
+					Expect(tasksSvc.Upsert(ctx, inDto)).To(Equal(outDto))
 				})
 			})
 		})
@@ -250,49 +539,830 @@ var _ = Describe("Service", func() {
 			})
 
 			It("tries to insert and no update is called", func() {
-				mockRepository.EXPECT().Update(gomock.Any()).Times(1)
-				mockRepository.EXPECT().Insert(gomock.Any()).Times(0)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Times(0)
 
-				tasksSvc.Upsert(inDto)
+				tasksSvc.Upsert(ctx, inDto)
 			})
 
 			When("an error happens while updating the new dao", func() {
 				It("returns an empty dto and the error", func() {
-					mockRepository.EXPECT().Update(gomock.Any()).Return(entity.Task{}, customErr)
+					mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{}, customErr)
 
-					Expect(tasksSvc.Upsert(inDto)).Error().To(Equal(customErr))
+					Expect(tasksSvc.Upsert(ctx, inDto)).Error().To(Equal(customErr))
 				})
 			})
 
 			When("updating the dao is successful", func() {
 				It("returns the updated dto and no error", func() {
-					mockRepository.EXPECT().Update(gomock.Any()).Return(dao, nil)
+					mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(dao, nil)
 
-					Expect(tasksSvc.Upsert(inDto)).To(Equal(outDto))
+					Expect(tasksSvc.Upsert(ctx, inDto)).To(Equal(outDto))
 				})
 			})
 		})
 
 	})
 
+	Describe("GetOccurrences", func() {
+
+		var dueAt time.Time
+
+		BeforeEach(func() {
+			dueAt = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+			tasksSvc = service.New(
+				service.WithRepository(mockRepository),
+				service.WithRecurrence(recurrence.New(recurrence.WithClock(stubs.NewFixed(dueAt.Add(10*24*time.Hour))))),
+			)
+		})
+
+		When("an error happens while retrieving the dao", func() {
+			It("returns an empty dto plus the error", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Task{}, customErr)
+
+				Expect(tasksSvc.GetOccurrences(ctx, id, 3)).Error().To(Equal(customErr))
+			})
+		})
+
+		When("the task is not recurring", func() {
+			It("returns a dto without occurrences", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Task{Id: id, DueAt: &dueAt}, nil)
+
+				Expect(tasksSvc.GetOccurrences(ctx, id, 3)).To(Equal(model.GetOccurrencesResponse{Id: id}))
+			})
+		})
+
+		When("the task is recurring", func() {
+			It("returns the next occurrences starting from now", func() {
+				const rule = "FREQ=WEEKLY"
+				mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Task{Id: id, DueAt: &dueAt, Recurrence: rule}, nil)
+
+				Expect(tasksSvc.GetOccurrences(ctx, id, 3)).To(Equal(model.GetOccurrencesResponse{
+					Id:         id,
+					Recurrence: rule,
+					Occurrences: []time.Time{
+						dueAt.Add(14 * 24 * time.Hour),
+						dueAt.Add(21 * 24 * time.Hour),
+						dueAt.Add(28 * 24 * time.Hour),
+					},
+				}))
+			})
+		})
+
+	})
+
+	Describe("Upsert of a recurring task", func() {
+		var dueAt time.Time
+		var inDto model.UpsertTaskRequest
+
+		BeforeEach(func() {
+			dtoId := id
+			dueAt = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+			inDto = model.UpsertTaskRequest{
+				Id:          &dtoId,
+				Name:        taskName,
+				StatusId:    entity.StatusIdDone,
+				Description: taskDescription,
+				DueAt:       &dueAt,
+				Recurrence:  "FREQ=WEEKLY;COUNT=3",
+			}
+		})
+
+		When("the recurrence is not a valid rule", func() {
+			It("returns ErrInvalidArgument without reaching the repository", func() {
+				inDto.Recurrence = "FREQ=SOMETIMES"
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().To(Equal(errors.ErrInvalidArgument))
+			})
+		})
+
+		When("the task moves to the done status", func() {
+			It("inserts the next occurrence with a shifted due date", func() {
+				nextDueAt := dueAt.Add(7 * 24 * time.Hour)
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{Id: id, StatusId: entity.StatusIdInProgress}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), entity.Task{
+					Name:        taskName,
+					StatusId:    entity.StatusIdTodo,
+					Description: taskDescription,
+					DueAt:       &nextDueAt,
+					Recurrence:  "RRULE:FREQ=WEEKLY;COUNT=2",
+				}).Return(entity.Task{}, nil)
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().ToNot(HaveOccurred())
+			})
+
+			It("carries the checklist over to the next occurrence with all items unchecked", func() {
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{
+					Id:        id,
+					StatusId:  entity.StatusIdInProgress,
+					Checklist: []entity.ChecklistItem{{Id: 1, Text: "step", Done: true}},
+				}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.Checklist).To(Equal([]entity.ChecklistItem{{Id: 1, Text: "step"}}))
+					return task, nil
+				})
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().ToNot(HaveOccurred())
+			})
+
+			It("carries the estimate over to the next occurrence", func() {
+				inDto.Estimate = 45
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{Id: id, StatusId: entity.StatusIdInProgress}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.Estimate).To(Equal(45))
+					return task, nil
+				})
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().ToNot(HaveOccurred())
+			})
+		})
+
+		When("the task moves to the done status at its last occurrence", func() {
+			It("doesn't insert a next occurrence", func() {
+				inDto.Recurrence = "FREQ=WEEKLY;COUNT=1"
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{Id: id}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().ToNot(HaveOccurred())
+			})
+		})
+
+		When("the task was already done", func() {
+			It("doesn't insert a next occurrence", func() {
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{Id: id, StatusId: entity.StatusIdDone}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().ToNot(HaveOccurred())
+			})
+		})
+
+		When("an error happens while inserting the next occurrence", func() {
+			It("returns the error", func() {
+				mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Task{Id: id}, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.Task{}, customErr)
+
+				Expect(tasksSvc.Upsert(ctx, inDto)).Error().To(Equal(customErr))
+			})
+		})
+
+	})
+
 	Describe("RemoveById", func() {
 
 		When("an error happens while removing the dao", func() {
 			It("returns the error", func() {
-				mockRepository.EXPECT().RemoveById(id).Return(entity.Task{}, customErr)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+				mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Task{}, customErr)
 
-				Expect(tasksSvc.RemoveById(id)).To(Equal(customErr))
+				Expect(tasksSvc.RemoveById(ctx, id)).To(Equal(customErr))
 			})
 		})
 
 		When("removing the dao is successful", func() {
 			It("returns no error", func() {
-				mockRepository.EXPECT().RemoveById(id).Return(entity.Task{}, nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+				mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Task{}, nil)
+
+				Expect(tasksSvc.RemoveById(ctx, id)).ToNot(HaveOccurred())
+			})
+		})
+
+		When("the tasks are tagged", func() {
+			It("detaches the tags of the removed task", func() {
+				mockTags := serviceMock.NewMockTagIndex(mockCtrl)
+				tasksSvc = service.New(service.WithRepository(mockRepository), service.WithTags(mockTags))
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+				mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Task{}, nil)
+				mockTags.EXPECT().RemoveTask(gomock.Any(), id).Return(nil)
 
-				Expect(tasksSvc.RemoveById(id)).ToNot(HaveOccurred())
+				Expect(tasksSvc.RemoveById(ctx, id)).ToNot(HaveOccurred())
 			})
 		})
 
+		When("an error happens while retrieving the subtasks", func() {
+			It("returns the error", func() {
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
+
+				Expect(tasksSvc.RemoveById(ctx, id)).To(Equal(customErr))
+			})
+		})
+
+		Context("the task has subtasks", func() {
+			var daoList []entity.Task
+
+			BeforeEach(func() {
+				parentId, childId := id, id+1
+				daoList = []entity.Task{
+					{Id: parentId, Name: "parent"},
+					{Id: childId, Name: "child", ParentId: &parentId},
+					{Id: id + 2, Name: "grandchild", ParentId: &childId},
+				}
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+			})
+
+			It("refuses to remove the task by default", func() {
+				Expect(tasksSvc.RemoveById(ctx, id)).To(Equal(errors.ErrConflict))
+			})
+
+			It("detaches the children when orphaning", func() {
+				tasksSvc = service.New(service.WithRepository(mockRepository), service.WithParentDeletion(service.ParentDeletionOrphan))
+				orphan := daoList[1]
+				orphan.ParentId = nil
+				mockRepository.EXPECT().Update(gomock.Any(), orphan).Return(daoList[1], nil)
+				mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(daoList[0], nil)
+
+				Expect(tasksSvc.RemoveById(ctx, id)).To(Succeed())
+			})
+
+			It("removes the descendants first when cascading", func() {
+				tasksSvc = service.New(service.WithRepository(mockRepository), service.WithParentDeletion(service.ParentDeletionCascade))
+				gomock.InOrder(
+					mockRepository.EXPECT().RemoveById(gomock.Any(), id+2).Return(daoList[2], nil),
+					mockRepository.EXPECT().RemoveById(gomock.Any(), id+1).Return(daoList[1], nil),
+					mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(daoList[0], nil),
+				)
+
+				Expect(tasksSvc.RemoveById(ctx, id)).To(Succeed())
+			})
+		})
+
+	})
+
+	Describe("Subtasks", func() {
+		var daoList []entity.Task
+
+		parent := func(id int) *int {
+			return &id
+		}
+
+		BeforeEach(func() {
+			daoList = []entity.Task{
+				{Id: 0, Name: "root"},
+				{Id: 1, Name: "done", StatusId: entity.StatusIdDone, ParentId: parent(0)},
+				{Id: 2, Name: "half", ParentId: parent(0)},
+				{Id: 3, Name: "todo", ParentId: parent(2)},
+				{Id: 4, Name: "done too", StatusId: entity.StatusIdDone, ParentId: parent(2)},
+				{Id: 5, Name: "unrelated"},
+			}
+		})
+
+		Describe("GetTree", func() {
+			It("returns ErrNotFound when the task is not found", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Task{}, errors.ErrNotFound)
+
+				Expect(tasksSvc.GetTree(ctx, 7)).Error().To(Equal(errors.ErrNotFound))
+			})
+
+			It("rolls the completion up to the parents", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+
+				tree, err := tasksSvc.GetTree(ctx, 0)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tree.Completion).To(Equal(75))
+				Expect(tree.Children).To(HaveExactElements(
+					HaveField("Completion", 100),
+					SatisfyAll(HaveField("Completion", 50), HaveField("Children", HaveLen(2))),
+				))
+			})
+		})
+
+		Describe("GetChildren", func() {
+			It("returns the direct children only", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+
+				children, err := tasksSvc.GetChildren(ctx, 0)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(children).To(HaveExactElements(
+					SatisfyAll(HaveField("Id", 1), HaveField("Children", BeEmpty())),
+					SatisfyAll(HaveField("Id", 2), HaveField("Completion", 50), HaveField("Children", BeEmpty())),
+				))
+			})
+		})
+
+		Describe("Upsert with a parent", func() {
+			It("rejects an unknown parent", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Task{}, errors.ErrNotFound)
+
+				Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "child", ParentId: parent(7)})).Error().
+					To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("rejects a task being its own parent", func() {
+				Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Id: parent(2), Name: "half", ParentId: parent(2)})).Error().
+					To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("rejects a cycle", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 3).Return(daoList[3], nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(daoList[2], nil)
+
+				Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Id: parent(0), Name: "root", ParentId: parent(3)})).Error().
+					To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("adds the subtask to the list of its parent", func() {
+				listed := daoList[0]
+				listed.ListId = 4
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(listed, nil)
+				mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task entity.Task) (entity.Task, error) {
+					Expect(task.ListId).To(Equal(4))
+					Expect(task.ParentId).To(HaveValue(Equal(0)))
+					return task, nil
+				})
+
+				Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "child", ParentId: parent(0)})).Error().NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Dependencies", func() {
+
+		var mockDependencies *dependenciesDaoMock.MockRepository
+		var daoList []entity.Task
+
+		BeforeEach(func() {
+			mockDependencies = dependenciesDaoMock.NewMockRepository(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(mockRepository), service.WithDependencies(mockDependencies))
+			daoList = []entity.Task{
+				{Id: 0, StatusId: entity.StatusIdDone, Rank: "a"},
+				{Id: 1, StatusId: entity.StatusIdTodo, Rank: "b"},
+				{Id: 2, StatusId: entity.StatusIdTodo, Rank: "c"},
+				{Id: 3, StatusId: entity.StatusIdTodo, Rank: "d", Priority: entity.PriorityP1},
+			}
+		})
+
+		dependencies := []dependencyEntity.Dependency{
+			{BlockerId: 0, BlockedId: 1},
+			{BlockerId: 3, BlockedId: 2},
+			{BlockerId: 1, BlockedId: 2},
+		}
+
+		Describe("GetById", func() {
+			It("flags the task as blocked while one of its blockers is not done", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(daoList[2], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+
+				Expect(tasksSvc.GetById(ctx, 2)).To(HaveField("Blocked", BeTrue()))
+			})
+
+			It("does not flag the task as blocked when all its blockers are done", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(daoList[1], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+
+				Expect(tasksSvc.GetById(ctx, 1)).To(HaveField("Blocked", BeFalse()))
+			})
+		})
+
+		Describe("GetDependencies", func() {
+			It("returns ErrNotFound when the task is not found", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Task{}, errors.ErrNotFound)
+
+				Expect(tasksSvc.GetDependencies(ctx, 7)).Error().To(Equal(errors.ErrNotFound))
+			})
+
+			It("returns the blockers and the blocked tasks", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(daoList[1], nil)
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+
+				dto, err := tasksSvc.GetDependencies(ctx, 1)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.Id).To(Equal(1))
+				Expect(dto.BlockedBy).To(HaveExactElements(HaveField("Id", 0)))
+				Expect(dto.Blocks).To(HaveExactElements(SatisfyAll(HaveField("Id", 2), HaveField("Blocked", BeTrue()))))
+			})
+		})
+
+		Describe("GetNext", func() {
+			It("returns the open tasks in a topological order", func() {
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+
+				next, err := tasksSvc.GetNext(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(HaveExactElements(
+					SatisfyAll(HaveField("Id", 3), HaveField("Blocked", BeFalse())),
+					SatisfyAll(HaveField("Id", 1), HaveField("Blocked", BeFalse())),
+					SatisfyAll(HaveField("Id", 2), HaveField("Blocked", BeTrue())),
+				))
+			})
+		})
+
+		Describe("AddDependency", func() {
+			It("rejects a task blocking itself", func() {
+				Expect(tasksSvc.AddDependency(ctx, 1, 1)).To(Equal(errors.ErrInvalidArgument))
+			})
+
+			It("returns ErrNotFound when the blocker is not found", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(daoList[1], nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 7).Return(entity.Task{}, errors.ErrNotFound)
+
+				Expect(tasksSvc.AddDependency(ctx, 1, 7)).To(Equal(errors.ErrNotFound))
+			})
+
+			It("rejects a dependency creating a cycle", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 2).Return(daoList[2], nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+
+				Expect(tasksSvc.AddDependency(ctx, 0, 2)).To(Equal(errors.ErrConflict))
+			})
+
+			It("adds the dependency", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 3).Return(daoList[3], nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+				mockDependencies.EXPECT().Insert(gomock.Any(), dependencyEntity.Dependency{BlockerId: 0, BlockedId: 3}).
+					Return(dependencyEntity.Dependency{}, nil)
+
+				Expect(tasksSvc.AddDependency(ctx, 3, 0)).To(Succeed())
+			})
+
+			It("ignores an existing dependency", func() {
+				mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(daoList[1], nil)
+				mockRepository.EXPECT().GetById(gomock.Any(), 0).Return(daoList[0], nil)
+				mockDependencies.EXPECT().GetAll(gomock.Any()).Return(dependencies, nil)
+				mockDependencies.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(dependencyEntity.Dependency{}, errors.ErrNotModified)
+
+				Expect(tasksSvc.AddDependency(ctx, 1, 0)).To(Succeed())
+			})
+		})
+
+		Describe("RemoveDependency", func() {
+			It("removes the dependency", func() {
+				mockDependencies.EXPECT().Remove(gomock.Any(), 0, 1).Return(nil)
+
+				Expect(tasksSvc.RemoveDependency(ctx, 1, 0)).To(Succeed())
+			})
+		})
+
+		Describe("RemoveById", func() {
+			It("removes the dependencies of the task", func() {
+				mockRepository.EXPECT().GetAll(gomock.Any()).Return(daoList, nil)
+				mockRepository.EXPECT().RemoveById(gomock.Any(), 1).Return(daoList[1], nil)
+				mockDependencies.EXPECT().RemoveTask(gomock.Any(), 1).Return(nil)
+
+				Expect(tasksSvc.RemoveById(ctx, 1)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("Clone", func() {
+		var repo repository.Repository
+		var parentId int
+
+		BeforeEach(func() {
+			repo = repository.New()
+			tasksSvc = service.New(service.WithRepository(repo))
+
+			parent, _ := repo.Insert(ctx, entity.Task{Name: "parent", StatusId: entity.StatusIdDone, Estimate: 30})
+			parentId = parent.Id
+			_, _ = repo.UpdateChecklist(ctx, parentId, []entity.ChecklistItem{{Id: 0, Text: "step", Done: true}})
+			child, _ := repo.Insert(ctx, entity.Task{Name: "child", ParentId: &parentId})
+			_, _ = repo.Insert(ctx, entity.Task{Name: "grandchild", ParentId: &child.Id})
+		})
+
+		It("returns ErrNotFound when the task is not found", func() {
+			Expect(tasksSvc.Clone(ctx, 7, false)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("copies the task alone as a new todo", func() {
+			clone, err := tasksSvc.Clone(ctx, parentId, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clone.Id).NotTo(Equal(parentId))
+			Expect(clone.Name).To(Equal("parent"))
+			Expect(clone.StatusId).To(Equal(entity.StatusIdTodo))
+			Expect(clone.Estimate).To(Equal(30))
+			Expect(clone.Checklist).To(BeNil())
+			Expect(repo.GetAll(ctx)).To(HaveLen(4))
+		})
+
+		It("copies the subtasks and the checklist when deep", func() {
+			clone, err := tasksSvc.Clone(ctx, parentId, true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clone.Checklist).To(Equal(&model.ChecklistProgress{Done: 0, Total: 1}))
+			Expect(repo.GetAll(ctx)).To(HaveLen(6))
+
+			tree, err := tasksSvc.GetTree(ctx, clone.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tree.Children).To(HaveExactElements(
+				SatisfyAll(HaveField("Name", "child"), HaveField("Children", HaveExactElements(HaveField("Name", "grandchild")))),
+			))
+		})
+	})
+
+	Describe("WIP limits", func() {
+		var repo repository.Repository
+		var mockLimits *serviceMock.MockWipLimiter
+		var todo, doing entity.Task
+
+		inProgress := entity.StatusIdInProgress
+		listId := func(id int) *int {
+			return &id
+		}
+
+		BeforeEach(func() {
+			repo = repository.New()
+			todo, _ = repo.Insert(ctx, entity.Task{Name: "todo", ListId: 1})
+			doing, _ = repo.Insert(ctx, entity.Task{Name: "doing", StatusId: entity.StatusIdInProgress, ListId: 1})
+			_, _ = repo.Insert(ctx, entity.Task{Name: "elsewhere", StatusId: entity.StatusIdInProgress, ListId: 2})
+			mockLimits = serviceMock.NewMockWipLimiter(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(repo), service.WithLimits(mockLimits))
+		})
+
+		It("rejects a task moved into a status whose global limit is reached", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{{StatusId: inProgress, Limit: 2}}, nil)
+
+			Expect(tasksSvc.Move(ctx, todo.Id, model.MoveTaskRequest{StatusId: &inProgress})).Error().
+				To(Equal(wipModel.ExceededError{StatusId: inProgress, Limit: 2, Count: 2}))
+			Expect(repo.GetById(ctx, todo.Id)).To(HaveField("StatusId", entity.StatusIdTodo))
+		})
+
+		It("rejects a task moved into a status whose limit in the list is reached", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{
+				{StatusId: inProgress, ListId: listId(2), Limit: 1},
+				{StatusId: inProgress, ListId: listId(1), Limit: 1},
+			}, nil)
+
+			Expect(tasksSvc.Move(ctx, todo.Id, model.MoveTaskRequest{StatusId: &inProgress})).Error().
+				To(Equal(wipModel.ExceededError{StatusId: inProgress, ListId: listId(1), Limit: 1, Count: 1}))
+		})
+
+		It("rejects an updated task entering the status", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{{StatusId: inProgress, ListId: listId(1), Limit: 1}}, nil)
+
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Id: &todo.Id, Name: "todo", StatusId: inProgress})).Error().
+				To(BeAssignableToTypeOf(wipModel.ExceededError{}))
+		})
+
+		It("rejects a new task added in the status", func() {
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{{StatusId: inProgress, Limit: 2}}, nil)
+
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "new", StatusId: inProgress})).Error().
+				To(BeAssignableToTypeOf(wipModel.ExceededError{}))
+			Expect(repo.GetAll(ctx)).To(HaveLen(3))
+		})
+
+		It("does not count the archived tasks", func() {
+			now := time.Now()
+			_, _ = repo.SetArchived(ctx, doing.Id, &now)
+			mockLimits.EXPECT().GetByStatus(gomock.Any(), inProgress).Return([]wipModel.GetWipLimitResponse{{StatusId: inProgress, ListId: listId(1), Limit: 1}}, nil)
+
+			Expect(tasksSvc.Move(ctx, todo.Id, model.MoveTaskRequest{StatusId: &inProgress})).To(HaveField("StatusId", inProgress))
+		})
+
+		It("lets the limit be overridden", func() {
+			Expect(tasksSvc.Move(ctx, todo.Id, model.MoveTaskRequest{StatusId: &inProgress, OverrideWipLimit: true})).
+				To(HaveField("StatusId", inProgress))
+		})
+
+		It("does not check the limits when the task stays in its status and list", func() {
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Id: &doing.Id, Name: "doing it", StatusId: inProgress})).
+				Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Quick add", func() {
+		var repo repository.Repository
+		var mockTags *serviceMock.MockTagIndex
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2023, time.March, 17, 10, 15, 0, 0, time.UTC)
+			repo = repository.New()
+			lists := listsRepository.New()
+			_, _ = lists.Insert(ctx, listsEntity.List{Name: "Home"})
+			mockTags = serviceMock.NewMockTagIndex(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(repo), service.WithLists(lists), service.WithTags(mockTags),
+				service.WithQuickAdd(quickadd.New(quickadd.WithClock(stubs.NewFixed(now)))))
+		})
+
+		It("parses the line into a task request", func() {
+			dueAt := time.Date(2023, time.March, 18, 9, 0, 0, 0, time.UTC)
+			listId := 1
+
+			Expect(tasksSvc.ParseQuick(ctx, model.QuickAddRequest{Text: "Pay rent tomorrow 9am #finance !p1 @home"})).
+				To(Equal(model.QuickTaskRequest{
+					UpsertTaskRequest: model.UpsertTaskRequest{Name: "Pay rent", DueAt: &dueAt, Priority: "P1", ListId: &listId},
+					Tags:              []string{"finance"},
+					Context:           "home",
+				}))
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+		})
+
+		It("reports an unknown context and keeps the default list", func() {
+			dueAt := time.Date(2023, time.March, 18, 9, 0, 0, 0, time.UTC)
+			tasksSvc = service.New(service.WithRepository(repo), service.WithLists(listsRepository.New()),
+				service.WithQuickAdd(quickadd.New(quickadd.WithClock(stubs.NewFixed(now)))))
+
+			Expect(tasksSvc.ParseQuick(ctx, model.QuickAddRequest{Text: "Pay rent tomorrow 9am #finance !p1 @home"})).
+				To(Equal(model.QuickTaskRequest{
+					UpsertTaskRequest: model.UpsertTaskRequest{Name: "Pay rent", DueAt: &dueAt, Priority: "P1"},
+					Tags:              []string{"finance"},
+					Context:           "home",
+				}))
+		})
+
+		It("adds the task of an unknown context to the default list", func() {
+			task, err := tasksSvc.QuickAdd(ctx, model.QuickAddRequest{Text: "Pay rent @office"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.ListId).To(Equal(listsEntity.DefaultListId))
+		})
+
+		It("returns ErrInvalidArgument for an invalid tag", func() {
+			Expect(tasksSvc.ParseQuick(ctx, model.QuickAddRequest{Text: "Pay rent #rent,bills"})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("returns ErrInvalidArgument when the line has no name", func() {
+			Expect(tasksSvc.QuickAdd(ctx, model.QuickAddRequest{Text: "tomorrow #finance"})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("adds the parsed task and tags it", func() {
+			mockTags.EXPECT().TagTask(gomock.Any(), 0, []string{"finance"}).Return(nil)
+
+			task, err := tasksSvc.QuickAdd(ctx, model.QuickAddRequest{Text: "Pay rent tomorrow #finance !p1 @home"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.Name).To(Equal("Pay rent"))
+			Expect(task.Priority).To(Equal("P1"))
+			Expect(task.ListId).To(Equal(1))
+			Expect(repo.GetAll(ctx)).To(HaveLen(1))
+		})
+
+		It("returns the error of the tags", func() {
+			customErr := fmt.Errorf("custom error")
+			mockTags.EXPECT().TagTask(gomock.Any(), 0, []string{"finance"}).Return(customErr)
+
+			Expect(tasksSvc.QuickAdd(ctx, model.QuickAddRequest{Text: "Pay rent #finance"})).Error().To(Equal(customErr))
+		})
+	})
+
+	Describe("Archiving", func() {
+		var repo repository.Repository
+		var now time.Time
+		var done, recent, open entity.Task
+
+		BeforeEach(func() {
+			now = time.Date(2023, time.March, 17, 9, 30, 0, 0, time.UTC)
+			done = entity.Task{Id: 0, Name: "done", StatusId: entity.StatusIdDone, UpdatedAt: now.Add(-48 * time.Hour)}
+			recent = entity.Task{Id: 1, Name: "recent", StatusId: entity.StatusIdDone, UpdatedAt: now.Add(-time.Hour)}
+			open = entity.Task{Id: 2, Name: "open", UpdatedAt: now.Add(-48 * time.Hour)}
+			repo = repository.New(repository.WithTasks(map[int]entity.Task{0: done, 1: recent, 2: open}))
+			tasksSvc = service.New(service.WithRepository(repo), service.WithClock(stubs.NewFixed(now)))
+		})
+
+		It("archives and unarchives a task", func() {
+			archived, err := tasksSvc.Archive(ctx, open.Id)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(archived.Archived).To(BeTrue())
+			Expect(archived.ArchivedAt).To(HaveValue(Equal(now)))
+			Expect(tasksSvc.Archive(ctx, open.Id)).Error().To(Equal(errors.ErrNotModified))
+
+			Expect(tasksSvc.Unarchive(ctx, open.Id)).To(HaveField("Archived", false))
+		})
+
+		It("returns ErrNotFound for an unknown task", func() {
+			Expect(tasksSvc.Archive(ctx, 42)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("excludes the archived tasks from the lists unless requested", func() {
+			_, _ = tasksSvc.Archive(ctx, done.Id)
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{})).To(HaveExactElements(
+				HaveField("Name", "recent"), HaveField("Name", "open")))
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{IncludeArchived: true})).To(HaveLen(3))
+		})
+
+		It("archives the done tasks last updated before the given time", func() {
+			count, err := tasksSvc.ArchiveDone(ctx, now.Add(-24*time.Hour))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+			Expect(repo.GetById(ctx, done.Id)).To(HaveField("ArchivedAt", HaveValue(Equal(now))))
+			Expect(repo.GetById(ctx, recent.Id)).To(HaveField("ArchivedAt", BeNil()))
+			Expect(repo.GetById(ctx, open.Id)).To(HaveField("ArchivedAt", BeNil()))
+		})
+
+		It("does not count the tasks archived already", func() {
+			Expect(tasksSvc.ArchiveDone(ctx, now)).To(Equal(2))
+			Expect(tasksSvc.ArchiveDone(ctx, now)).To(Equal(0))
+		})
+	})
+
+	Describe("WithCleaners", func() {
+		It("lets the cleaners remove what belongs to a removed task", func() {
+			cleaner := serviceMock.NewMockTaskCleaner(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(mockRepository), service.WithCleaners(cleaner))
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+			mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Task{Id: id}, nil)
+			cleaner.EXPECT().RemoveTask(gomock.Any(), id).Return(nil)
+
+			Expect(tasksSvc.RemoveById(ctx, id)).To(Succeed())
+		})
+
+		It("returns the error of a cleaner", func() {
+			customErr := fmt.Errorf("custom error")
+			cleaner := serviceMock.NewMockTaskCleaner(mockCtrl)
+			tasksSvc = service.New(service.WithRepository(mockRepository), service.WithCleaners(cleaner))
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+			mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Task{Id: id}, nil)
+			cleaner.EXPECT().RemoveTask(gomock.Any(), id).Return(customErr)
+
+			Expect(tasksSvc.RemoveById(ctx, id)).To(Equal(customErr))
+		})
+	})
+
+	Describe("Assignees", func() {
+		var (
+			repo          repository.Repository
+			users         usersRepository.Repository
+			jane, john    usersEntity.User
+			authenticated context.Context
+		)
+
+		BeforeEach(func() {
+			repo = repository.New()
+			users = usersRepository.New()
+			jane, _ = users.Insert(ctx, usersEntity.User{Username: "jane"})
+			john, _ = users.Insert(ctx, usersEntity.User{Username: "john"})
+			authenticated = reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "jane", UserId: &jane.Id})
+			tasksSvc = service.New(service.WithRepository(repo), service.WithUsers(users))
+		})
+
+		It("stamps the user creating and updating a task", func() {
+			created, err := tasksSvc.Upsert(authenticated, model.UpsertTaskRequest{Name: "task"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.CreatedBy).To(HaveValue(Equal(jane.Id)))
+			Expect(created.UpdatedBy).To(HaveValue(Equal(jane.Id)))
+
+			byJohn := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &john.Id})
+			_, err = tasksSvc.Upsert(byJohn, model.UpsertTaskRequest{Id: &created.Id, Name: "renamed"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tasksSvc.GetById(ctx, created.Id)).To(SatisfyAll(
+				HaveField("CreatedBy", HaveValue(Equal(jane.Id))),
+				HaveField("UpdatedBy", HaveValue(Equal(john.Id))),
+			))
+		})
+
+		It("leaves the stamps empty without a user", func() {
+			created, err := tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "task"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.CreatedBy).To(BeNil())
+			Expect(created.UpdatedBy).To(BeNil())
+		})
+
+		It("returns ErrInvalidArgument for an unknown assignee", func() {
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "task", AssigneeIds: []int{42}})).
+				Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("keeps an inactive user already assigned only", func() {
+			created, err := tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "task", AssigneeIds: []int{jane.Id}})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = users.Deactivate(ctx, jane.Id, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "other", AssigneeIds: []int{jane.Id}})).
+				Error().To(Equal(errors.ErrInvalidArgument))
+			Expect(tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Id: &created.Id, Name: "renamed", AssigneeIds: []int{jane.Id}})).
+				Error().NotTo(HaveOccurred())
+		})
+
+		It("filters the tasks by assignee", func() {
+			_, err := tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "mine", AssigneeIds: []int{jane.Id, john.Id}})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "theirs", AssigneeIds: []int{john.Id}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{AssigneeId: &jane.Id})).
+				To(HaveExactElements(HaveField("Name", "mine")))
+			Expect(tasksSvc.GetAll(ctx, model.GetTasksRequest{AssigneeId: &john.Id})).To(HaveLen(2))
+		})
+
+		It("copies the assignees of a cloned task", func() {
+			created, err := tasksSvc.Upsert(ctx, model.UpsertTaskRequest{Name: "task", AssigneeIds: []int{john.Id}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tasksSvc.Clone(authenticated, created.Id, false)).To(SatisfyAll(
+				HaveField("AssigneeIds", Equal([]int{john.Id})),
+				HaveField("CreatedBy", HaveValue(Equal(jane.Id))),
+			))
+		})
 	})
 
 })
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	spanPrefix = "tasks.service."
+	taskIdKey  = attribute.Key("task.id")
+)
+
+type tracedService struct {
+	service Service
+	tracer  trace.Tracer
+}
+
+func Trace(service Service, provider trace.TracerProvider) Service {
+	if provider == nil {
+		return service
+	}
+
+	return &tracedService{
+		service: service,
+		tracer:  provider.Tracer(tracerName),
+	}
+}
+
+func (traced *tracedService) start(ctx context.Context, operation string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return traced.tracer.Start(ctx, spanPrefix+operation, trace.WithAttributes(attributes...))
+}
+
+func (traced *tracedService) GetById(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "GetById", taskIdKey.Int(id))
+	result, err := traced.service.GetById(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetAll(ctx context.Context, request model.GetTasksRequest) ([]model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "GetAll")
+	result, err := traced.service.GetAll(ctx, request)
+	if err == nil {
+		span.SetAttributes(attribute.Int("task.count", len(result)))
+	}
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetOccurrences(ctx context.Context, id int, count int) (model.GetOccurrencesResponse, error) {
+	ctx, span := traced.start(ctx, "GetOccurrences", taskIdKey.Int(id))
+	result, err := traced.service.GetOccurrences(ctx, id, count)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetChildren(ctx context.Context, id int) ([]model.GetTaskTreeResponse, error) {
+	ctx, span := traced.start(ctx, "GetChildren", taskIdKey.Int(id))
+	result, err := traced.service.GetChildren(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetTree(ctx context.Context, id int) (model.GetTaskTreeResponse, error) {
+	ctx, span := traced.start(ctx, "GetTree", taskIdKey.Int(id))
+	result, err := traced.service.GetTree(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetDependencies(ctx context.Context, id int) (model.GetDependenciesResponse, error) {
+	ctx, span := traced.start(ctx, "GetDependencies", taskIdKey.Int(id))
+	result, err := traced.service.GetDependencies(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) GetNext(ctx context.Context) ([]model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "GetNext")
+	result, err := traced.service.GetNext(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) Upsert(ctx context.Context, request model.UpsertTaskRequest) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "Upsert")
+	result, err := traced.service.Upsert(ctx, request)
+	if err == nil {
+		span.SetAttributes(taskIdKey.Int(result.Id))
+	}
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) Move(ctx context.Context, id int, request model.MoveTaskRequest) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "Move", taskIdKey.Int(id))
+	result, err := traced.service.Move(ctx, id, request)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) Clone(ctx context.Context, id int, deep bool) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "Clone", taskIdKey.Int(id), attribute.Bool("task.deep", deep))
+	result, err := traced.service.Clone(ctx, id, deep)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) ParseQuick(ctx context.Context, request model.QuickAddRequest) (model.QuickTaskRequest, error) {
+	ctx, span := traced.start(ctx, "ParseQuick")
+	result, err := traced.service.ParseQuick(ctx, request)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) QuickAdd(ctx context.Context, request model.QuickAddRequest) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "QuickAdd")
+	result, err := traced.service.QuickAdd(ctx, request)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) Archive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "Archive", taskIdKey.Int(id))
+	result, err := traced.service.Archive(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) Unarchive(ctx context.Context, id int) (model.GetTaskResponse, error) {
+	ctx, span := traced.start(ctx, "Unarchive", taskIdKey.Int(id))
+	result, err := traced.service.Unarchive(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) ArchiveDone(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := traced.start(ctx, "ArchiveDone")
+	result, err := traced.service.ArchiveDone(ctx, before)
+	span.SetAttributes(attribute.Int("task.count", result))
+	tracing.End(span, err)
+	return result, err
+}
+
+func (traced *tracedService) RemoveById(ctx context.Context, id int) error {
+	ctx, span := traced.start(ctx, "RemoveById", taskIdKey.Int(id))
+	err := traced.service.RemoveById(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+func (traced *tracedService) AddDependency(ctx context.Context, id int, blockerId int) error {
+	ctx, span := traced.start(ctx, "AddDependency", taskIdKey.Int(id), attribute.Int("task.blocker_id", blockerId))
+	err := traced.service.AddDependency(ctx, id, blockerId)
+	tracing.End(span, err)
+	return err
+}
+
+func (traced *tracedService) RemoveDependency(ctx context.Context, id int, blockerId int) error {
+	ctx, span := traced.start(ctx, "RemoveDependency", taskIdKey.Int(id), attribute.Int("task.blocker_id", blockerId))
+	err := traced.service.RemoveDependency(ctx, id, blockerId)
+	tracing.End(span, err)
+	return err
+}
@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+)
+
+var _ = Describe("Traced service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		recorder    *tracetest.SpanRecorder
+		provider    *sdktrace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	})
+
+	It("returns the service as is without tracer provider", func() {
+		Expect(service.Trace(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("passes the span context down to the service", func() {
+		tracedSvc := service.Trace(mockService, provider)
+		mockService.EXPECT().GetById(gomock.Any(), 1).DoAndReturn(func(ctx context.Context, id int) (model.GetTaskResponse, error) {
+			Expect(trace.SpanFromContext(ctx).SpanContext().IsValid()).To(BeTrue())
+			return model.GetTaskResponse{Id: id}, nil
+		})
+
+		Expect(tracedSvc.GetById(ctx, 1)).To(Equal(model.GetTaskResponse{Id: 1}))
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("tasks.service.GetById"))
+		Expect(spans[0].Attributes()).To(ContainElement(attribute.Int("task.id", 1)))
+		Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+	})
+
+	It("records the errors of the service", func() {
+		tracedSvc := service.Trace(mockService, provider)
+		mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(errors.ErrConflict)
+
+		Expect(tracedSvc.RemoveById(ctx, 1)).To(Equal(errors.ErrConflict))
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("tasks.service.RemoveById"))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+	})
+})
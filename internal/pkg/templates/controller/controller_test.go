@@ -0,0 +1,189 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/templates/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder      *httptest.ResponseRecorder
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		templatesCtrl controller.Controller
+	)
+
+	withTemplateId := func(request *http.Request, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), constants.TemplateId, value))
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		templatesCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+
+		When("the template id is not found", func() {
+			It("responds with status InternalServerError and an error response payload", func() {
+				templatesCtrl.GetById(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+
+				var payload errorModel.Response
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the template is found", func() {
+			It("responds with status OK and the template in the payload", func() {
+				template := model.GetTemplateResponse{Id: 1, Name: "Weekly", TaskName: "Review {{date}}"}
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(template, nil)
+
+				templatesCtrl.GetById(recorder, withTemplateId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var payload model.GetTemplateResponse
+				Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+				Expect(payload).To(Equal(template))
+			})
+		})
+
+	})
+
+	Describe("GetAll", func() {
+		It("responds with status NoContent when there is no template", func() {
+			mockService.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+
+			templatesCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("Add", func() {
+		It("responds with status BadRequest when the task name is missing", func() {
+			templatesCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"Weekly"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status TooManyRequests when the tenant quota is exhausted", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTemplateResponse{}, errors.ErrQuotaExceeded)
+
+			templatesCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"Weekly","taskName":"Review","dueIn":"24h"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("responds with status Created and the template in the payload", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", DueIn: "24h"}).
+				Return(model.GetTemplateResponse{Id: 1, Name: "Weekly", TaskName: "Review", DueIn: "24h"}, nil)
+
+			templatesCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"name":"Weekly","taskName":"Review","dueIn":"24h"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/1"))
+		})
+	})
+
+	Describe("Update", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetTemplateResponse{}, err)
+
+				templatesCtrl.Update(recorder, withTemplateId(httptest.NewRequest("", url,
+					strings.NewReader(`{"id":1,"name":"Weekly","taskName":"Review"}`)), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("not modified", errors.ErrNotModified, http.StatusNotModified),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("RemoveById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(err)
+
+				templatesCtrl.RemoveById(recorder, withTemplateId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("removed", nil, http.StatusNoContent),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+		)
+	})
+
+	Describe("Instantiate", func() {
+		It("responds with status BadRequest when the payload format is wrong", func() {
+			templatesCtrl.Instantiate(recorder, withTemplateId(httptest.NewRequest("", url, strings.NewReader("{")), "1"))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Instantiate(gomock.Any(), 1, model.InstantiateTemplateRequest{}).Return(tasksModel.GetTaskResponse{}, err)
+
+				templatesCtrl.Instantiate(recorder, withTemplateId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("unknown placeholder", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("quota exceeded", errors.ErrQuotaExceeded, http.StatusTooManyRequests),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status Created and the new task in the payload", func() {
+			task := tasksModel.GetTaskResponse{Id: 7, Name: "Review for core"}
+			mockService.EXPECT().Instantiate(gomock.Any(), 1, model.InstantiateTemplateRequest{Variables: map[string]string{"team": "core"}}).
+				Return(task, nil)
+
+			templatesCtrl.Instantiate(recorder, withTemplateId(httptest.NewRequest("", url,
+				strings.NewReader(`{"variables":{"team":"core"}}`)), "1"))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/7"))
+
+			var payload tasksModel.GetTaskResponse
+			Expect(json.NewDecoder(bytes.NewReader(recorder.Body.Bytes())).Decode(&payload)).To(Succeed())
+			Expect(payload).To(Equal(task))
+		})
+	})
+
+})
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Template struct {
+	Id          int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	Name        string    `json:"name" gorm:"column:name;type:varchar;size:255"`
+	TaskName    string    `json:"taskName" gorm:"column:task_name;type:varchar;size:255"`
+	Description string    `json:"description,omitempty" gorm:"column:description;type:varchar;size:255"`
+	Priority    string    `json:"priority,omitempty" gorm:"column:priority;type:varchar;size:16"`
+	ListId      *int      `json:"listId,omitempty" gorm:"column:list_id;type:int"`
+	Estimate    int       `json:"estimate,omitempty" gorm:"column:estimate;type:int"`
+	DueIn       string    `json:"dueIn,omitempty" gorm:"column:due_in;type:varchar;size:32"`
+	Checklist   []string  `json:"checklist,omitempty" gorm:"column:checklist;type:text;serializer:json"`
+	CreatedAt   time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	UpdatedAt   time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+}
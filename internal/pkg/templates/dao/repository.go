@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.Template, error)
+	GetAll(ctx context.Context) ([]entity.Template, error)
+	Insert(ctx context.Context, template entity.Template) (entity.Template, error)
+	Update(ctx context.Context, template entity.Template) (entity.Template, error)
+	RemoveById(ctx context.Context, id int) (entity.Template, error)
+}
+
+type partition struct {
+	templates map[int]entity.Template
+	seq       int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+	quotas     tenancy.Quotas
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithTemplates(templates map[int]entity.Template) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && templates != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			partition.templates = templates
+			for id := range templates {
+				if id >= partition.seq {
+					partition.seq = id + 1
+				}
+			}
+		}
+	}
+}
+
+func WithQuotas(quotas tenancy.Quotas) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.quotas = quotas
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		templates: map[int]entity.Template{},
+		seq:       0,
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.Template, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	template, found := partition.templates[id]
+	if !found {
+		return entity.Template{}, errors.ErrNotFound
+	}
+	return template, nil
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.Template, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var ids []int
+	var templates []entity.Template
+	for _, template := range partition.templates {
+		ids = append(ids, template.Id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		templates = append(templates, partition.templates[id])
+	}
+
+	return templates, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, template entity.Template) (entity.Template, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+	if repo.quotas != nil {
+		if err := repo.quotas.Check(ctx, tenancy.ResourceTemplates, len(partition.templates)); err != nil {
+			return entity.Template{}, err
+		}
+	}
+
+	template.Id, partition.seq = partition.seq, partition.seq+1
+	template.UpdatedAt = time.Now()
+	template.CreatedAt = template.UpdatedAt
+	partition.templates[template.Id] = template
+	return template, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, template entity.Template) (entity.Template, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldTemplate, found := partition.templates[template.Id]
+	if !found {
+		return entity.Template{}, errors.ErrNotFound
+	}
+
+	if oldTemplate.Name == template.Name &&
+		oldTemplate.TaskName == template.TaskName &&
+		oldTemplate.Description == template.Description &&
+		oldTemplate.Priority == template.Priority &&
+		sameId(oldTemplate.ListId, template.ListId) &&
+		oldTemplate.Estimate == template.Estimate &&
+		oldTemplate.DueIn == template.DueIn &&
+		sameItems(oldTemplate.Checklist, template.Checklist) {
+		return entity.Template{}, errors.ErrNotModified
+	}
+
+	template.UpdatedAt = time.Now()
+	template.CreatedAt = oldTemplate.CreatedAt
+	partition.templates[template.Id] = template
+	return template, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.Template, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	template, found := partition.templates[id]
+	if !found {
+		return entity.Template{}, errors.ErrNotFound
+	}
+	delete(partition.templates, id)
+	return template, nil
+}
+
+func sameId(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var repo repository.Repository
+
+	BeforeEach(func() {
+		repo = repository.New()
+	})
+
+	Describe("Insert", func() {
+		It("assigns increasing ids", func() {
+			Expect(repo.Insert(ctx, entity.Template{Name: "Weekly"})).To(HaveField("Id", 0))
+			Expect(repo.Insert(ctx, entity.Template{Name: "Daily"})).To(HaveField("Id", 1))
+			Expect(repo.GetAll(ctx)).To(HaveExactElements(HaveField("Name", "Weekly"), HaveField("Name", "Daily")))
+		})
+
+		It("continues after the given templates", func() {
+			repo = repository.New(repository.WithTemplates(map[int]entity.Template{4: {Id: 4, Name: "Weekly"}}))
+
+			Expect(repo.Insert(ctx, entity.Template{Name: "Daily"})).To(HaveField("Id", 5))
+		})
+	})
+
+	Describe("Update", func() {
+		It("returns the updated template", func() {
+			template, _ := repo.Insert(ctx, entity.Template{Name: "Weekly", Checklist: []string{"a"}})
+
+			Expect(repo.Update(ctx, entity.Template{Id: template.Id, Name: "Weekly", Checklist: []string{"a", "b"}})).
+				To(HaveField("Checklist", HaveLen(2)))
+		})
+
+		It("returns ErrNotModified when nothing changes", func() {
+			template, _ := repo.Insert(ctx, entity.Template{Name: "Weekly", Checklist: []string{"a"}})
+
+			Expect(repo.Update(ctx, template)).Error().To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrNotFound for an unknown template", func() {
+			Expect(repo.Update(ctx, entity.Template{Id: 42, Name: "Weekly"})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the template", func() {
+			template, _ := repo.Insert(ctx, entity.Template{Name: "Weekly"})
+
+			Expect(repo.RemoveById(ctx, template.Id)).Error().NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, template.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrNotFound for an unknown template", func() {
+			Expect(repo.RemoveById(ctx, 42)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("isolates the templates of each tenant", func() {
+			template, _ := repo.Insert(ctx, entity.Template{Name: "Release"})
+
+			Expect(repo.GetById(acme, template.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(acme)).To(BeEmpty())
+		})
+
+		It("enforces the quota of the tenant", func() {
+			repo = repository.New(repository.WithQuotas(tenancy.NewQuotas(
+				tenancy.WithDefaults(tenancy.Limits{tenancy.ResourceTemplates: 1}),
+			)))
+
+			Expect(repo.Insert(acme, entity.Template{Name: "Release"})).Error().NotTo(HaveOccurred())
+			Expect(repo.Insert(acme, entity.Template{Name: "Hotfix"})).Error().To(Equal(errors.ErrQuotaExceeded))
+		})
+	})
+
+})
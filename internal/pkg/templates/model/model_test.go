@@ -0,0 +1,69 @@
+package model_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/model"
+)
+
+var _ = Describe("Model", func() {
+
+	const id = 10
+
+	templateId := func(id int) *int {
+		return &id
+	}
+
+	Describe("EntityToGetTemplateResponse", func() {
+		It("returns a TemplateResponse that contains the entity's values", func() {
+			now := time.Now()
+			e := entity.Template{Id: id, Name: "Weekly", TaskName: "Review {{date}}", Priority: "P1", ListId: templateId(2),
+				Estimate: 30, DueIn: "24h", Checklist: []string{"inbox"}, CreatedAt: now.Add(-time.Hour), UpdatedAt: now}
+
+			Expect(model.EntityToGetTemplateResponse(e)).To(Equal(model.GetTemplateResponse{
+				Id:        id,
+				Name:      "Weekly",
+				TaskName:  "Review {{date}}",
+				Priority:  "P1",
+				ListId:    templateId(2),
+				Estimate:  30,
+				DueIn:     "24h",
+				Checklist: []string{"inbox"},
+				CreatedAt: e.CreatedAt,
+				UpdatedAt: e.UpdatedAt,
+			}))
+		})
+	})
+
+	Describe("UpsertTemplateRequest", func() {
+
+		DescribeTable("IsValid",
+			func(request model.UpsertTemplateRequest, id *int, expected bool) {
+				Expect(request.IsValid(id)).To(Equal(expected))
+			},
+			Entry("a new template", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review"}, nil, true),
+			Entry("a new template without name", model.UpsertTemplateRequest{TaskName: "Review"}, nil, false),
+			Entry("a new template without task name", model.UpsertTemplateRequest{Name: "Weekly"}, nil, false),
+			Entry("a new template with an unknown priority", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", Priority: "urgent"}, nil, false),
+			Entry("a new template with a negative estimate", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", Estimate: -1}, nil, false),
+			Entry("a new template with a due delay", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", DueIn: "48h"}, nil, true),
+			Entry("a new template with a malformed due delay", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", DueIn: "2 days"}, nil, false),
+			Entry("a new template with a negative due delay", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", DueIn: "-1h"}, nil, false),
+			Entry("a new template with a blank checklist item", model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review", Checklist: []string{" "}}, nil, false),
+			Entry("a new template with an id", model.UpsertTemplateRequest{Id: templateId(id), Name: "Weekly", TaskName: "Review"}, nil, false),
+			Entry("an existing template", model.UpsertTemplateRequest{Id: templateId(id), Name: "Weekly", TaskName: "Review"}, templateId(id), true),
+			Entry("an existing template with another id", model.UpsertTemplateRequest{Id: templateId(id + 1), Name: "Weekly", TaskName: "Review"}, templateId(id), false),
+		)
+
+		It("converts to an entity", func() {
+			Expect(model.UpsertTemplateRequest{Id: templateId(id), Name: "Weekly", TaskName: "Review", DueIn: "1h"}.ToEntity()).
+				To(Equal(entity.Template{Id: id, Name: "Weekly", TaskName: "Review", DueIn: "1h"}))
+		})
+
+	})
+
+})
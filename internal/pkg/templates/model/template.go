@@ -0,0 +1,99 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao/entity"
+)
+
+type GetTemplateResponse struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	TaskName    string    `json:"taskName"`
+	Description string    `json:"description,omitempty"`
+	Priority    string    `json:"priority,omitempty"`
+	ListId      *int      `json:"listId,omitempty"`
+	Estimate    int       `json:"estimate,omitempty"`
+	DueIn       string    `json:"dueIn,omitempty"`
+	Checklist   []string  `json:"checklist,omitempty"`
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
+}
+
+func EntityToGetTemplateResponse(entity entity.Template) GetTemplateResponse {
+	return GetTemplateResponse{
+		Id:          entity.Id,
+		Name:        entity.Name,
+		TaskName:    entity.TaskName,
+		Description: entity.Description,
+		Priority:    entity.Priority,
+		ListId:      entity.ListId,
+		Estimate:    entity.Estimate,
+		DueIn:       entity.DueIn,
+		Checklist:   entity.Checklist,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+}
+
+type UpsertTemplateRequest struct {
+	Id          *int     `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	TaskName    string   `json:"taskName"`
+	Description string   `json:"description,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	ListId      *int     `json:"listId,omitempty"`
+	Estimate    int      `json:"estimate,omitempty"`
+	DueIn       string   `json:"dueIn,omitempty"`
+	Checklist   []string `json:"checklist,omitempty"`
+}
+
+func (dto UpsertTemplateRequest) IsValid(id *int) bool {
+	if dto.Name == "" || dto.TaskName == "" || dto.Estimate < 0 {
+		return false
+	}
+
+	if _, err := tasksEntity.ParsePriority(dto.Priority); err != nil {
+		return false
+	}
+
+	if dto.DueIn != "" {
+		if dueIn, err := time.ParseDuration(dto.DueIn); err != nil || dueIn < 0 {
+			return false
+		}
+	}
+
+	for _, item := range dto.Checklist {
+		if strings.TrimSpace(item) == "" {
+			return false
+		}
+	}
+
+	return (id == nil && dto.Id == nil) ||
+		(id != nil && dto.Id != nil && *id == *dto.Id)
+}
+
+func (dto UpsertTemplateRequest) ToEntity() entity.Template {
+	var id int
+	if dto.Id != nil {
+		id = *dto.Id
+	}
+
+	return entity.Template{
+		Id:          id,
+		Name:        dto.Name,
+		TaskName:    dto.TaskName,
+		Description: dto.Description,
+		Priority:    dto.Priority,
+		ListId:      dto.ListId,
+		Estimate:    dto.Estimate,
+		DueIn:       dto.DueIn,
+		Checklist:   dto.Checklist,
+	}
+}
+
+type InstantiateTemplateRequest struct {
+	Variables map[string]string `json:"variables,omitempty"`
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	checklistsModel "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	checklists "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	tasks "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/service"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/model"
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetTemplateResponse, error)
+	GetAll(ctx context.Context) ([]model.GetTemplateResponse, error)
+	Upsert(ctx context.Context, request model.UpsertTemplateRequest) (model.GetTemplateResponse, error)
+	RemoveById(ctx context.Context, id int) error
+	Instantiate(ctx context.Context, id int, request model.InstantiateTemplateRequest) (tasksModel.GetTaskResponse, error)
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasks.Service
+	checklists checklists.Service
+	clock      stubs.Clock
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{
+		clock: stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasks.Service) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func WithChecklists(checklists checklists.Service) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.checklists = checklists
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && clock != nil {
+			service.clock = clock
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetTemplateResponse, error) {
+	template, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetTemplateResponse{}, err
+	}
+	return model.EntityToGetTemplateResponse(template), nil
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context) ([]model.GetTemplateResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetTemplateResponse
+	for _, template := range entities {
+		dto = append(dto, model.EntityToGetTemplateResponse(template))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Upsert(ctx context.Context, request model.UpsertTemplateRequest) (model.GetTemplateResponse, error) {
+	template := request.ToEntity()
+
+	var err error
+	if request.Id == nil {
+		template, err = service.repository.Insert(ctx, template)
+	} else {
+		template, err = service.repository.Update(ctx, template)
+	}
+
+	if err != nil {
+		return model.GetTemplateResponse{}, err
+	}
+	return model.EntityToGetTemplateResponse(template), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	_, err := service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) Instantiate(ctx context.Context, id int, request model.InstantiateTemplateRequest) (tasksModel.GetTaskResponse, error) {
+	template, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return tasksModel.GetTaskResponse{}, err
+	}
+
+	now := service.clock.Now()
+	values := builtins(now)
+	for name, value := range request.Variables {
+		values[name] = value
+	}
+
+	name, err := expand(template.TaskName, values)
+	if err != nil {
+		return tasksModel.GetTaskResponse{}, err
+	}
+	description, err := expand(template.Description, values)
+	if err != nil {
+		return tasksModel.GetTaskResponse{}, err
+	}
+	var checklist []string
+	for _, item := range template.Checklist {
+		text, err := expand(item, values)
+		if err != nil {
+			return tasksModel.GetTaskResponse{}, err
+		}
+		checklist = append(checklist, text)
+	}
+
+	var dueAt *time.Time
+	if template.DueIn != "" {
+		dueIn, err := time.ParseDuration(template.DueIn)
+		if err != nil {
+			return tasksModel.GetTaskResponse{}, errors.ErrInvalidArgument
+		}
+		due := now.Add(dueIn)
+		dueAt = &due
+	}
+
+	task, err := service.tasks.Upsert(ctx, tasksModel.UpsertTaskRequest{
+		Name:        name,
+		Description: description,
+		DueAt:       dueAt,
+		Priority:    template.Priority,
+		ListId:      template.ListId,
+		Estimate:    template.Estimate,
+	})
+	if err != nil {
+		return tasksModel.GetTaskResponse{}, err
+	}
+
+	if len(checklist) == 0 || service.checklists == nil {
+		return task, nil
+	}
+
+	for _, text := range checklist {
+		if _, err = service.checklists.Add(ctx, task.Id, checklistsModel.AddChecklistItemRequest{Text: text}); err != nil {
+			return tasksModel.GetTaskResponse{}, err
+		}
+	}
+	return service.tasks.GetById(ctx, task.Id)
+}
+
+func builtins(now time.Time) map[string]string {
+	return map[string]string{
+		"date":    now.Format("2006-01-02"),
+		"time":    now.Format("15:04"),
+		"weekday": now.Weekday().String(),
+		"month":   now.Month().String(),
+		"year":    strconv.Itoa(now.Year()),
+	}
+}
+
+func expand(text string, values map[string]string) (string, error) {
+	var err error
+	expanded := placeholder.ReplaceAllStringFunc(text, func(match string) string {
+		value, found := values[placeholder.FindStringSubmatch(match)[1]]
+		if !found {
+			err = errors.ErrInvalidArgument
+		}
+		return value
+	})
+	return expanded, err
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	checklistsModel "github.com/aeon-fruit/dalil.git/internal/pkg/checklists/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tasksModel "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/templates/service"
+	checklistsServiceMock "github.com/aeon-fruit/dalil.git/test/mocks/checklists/service"
+	tasksServiceMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/service"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/templates/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const id = 1
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockTasks      *tasksServiceMock.MockService
+		mockChecklists *checklistsServiceMock.MockService
+		templatesSvc   service.Service
+		now            time.Time
+		weekly         entity.Template
+	)
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		now = time.Date(2023, time.March, 17, 9, 30, 0, 0, time.UTC)
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockTasks = tasksServiceMock.NewMockService(mockCtrl)
+		mockChecklists = checklistsServiceMock.NewMockService(mockCtrl)
+		templatesSvc = service.New(service.WithRepository(mockRepository), service.WithTasks(mockTasks),
+			service.WithChecklists(mockChecklists), service.WithClock(stubs.NewFixed(now)))
+
+		weekly = entity.Template{Id: id, Name: "Weekly", TaskName: "Review of {{ date }}", Priority: "P1", Estimate: 30}
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Template{}, customErr)
+
+			Expect(templatesSvc.GetById(ctx, id)).Error().To(Equal(customErr))
+		})
+
+		It("returns the template", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+
+			Expect(templatesSvc.GetById(ctx, id)).To(Equal(model.EntityToGetTemplateResponse(weekly)))
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns the templates", func() {
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.Template{weekly}, nil)
+
+			Expect(templatesSvc.GetAll(ctx)).To(HaveExactElements(model.EntityToGetTemplateResponse(weekly)))
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("inserts a template without id", func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.Template{Name: "Weekly", TaskName: "Review"}).Return(weekly, nil)
+
+			Expect(templatesSvc.Upsert(ctx, model.UpsertTemplateRequest{Name: "Weekly", TaskName: "Review"})).
+				To(Equal(model.EntityToGetTemplateResponse(weekly)))
+		})
+
+		It("updates a template with an id", func() {
+			templateId := id
+			mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(entity.Template{}, errors.ErrNotModified)
+
+			Expect(templatesSvc.Upsert(ctx, model.UpsertTemplateRequest{Id: &templateId, Name: "Weekly", TaskName: "Review"})).
+				Error().To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().RemoveById(gomock.Any(), id).Return(entity.Template{}, errors.ErrNotFound)
+
+			Expect(templatesSvc.RemoveById(ctx, id)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("Instantiate", func() {
+		It("returns ErrNotFound when the template is not found", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(entity.Template{}, errors.ErrNotFound)
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{})).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("returns ErrInvalidArgument for an unknown placeholder", func() {
+			weekly.Description = "For {{ team }}"
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("creates a task with the placeholders replaced", func() {
+			weekly.Description = "{{weekday}} {{month}} {{year}} at {{time}} for {{team}}"
+			weekly.DueIn = "48h"
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+			task := tasksModel.GetTaskResponse{Id: 7, Name: "Review of 2023-03-17"}
+			mockTasks.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, request tasksModel.UpsertTaskRequest) (tasksModel.GetTaskResponse, error) {
+				Expect(request.Id).To(BeNil())
+				Expect(request.Name).To(Equal("Review of 2023-03-17"))
+				Expect(request.Description).To(Equal("Friday March 2023 at 09:30 for core"))
+				Expect(request.DueAt).To(HaveValue(Equal(now.Add(48 * time.Hour))))
+				Expect(request.Priority).To(Equal("P1"))
+				Expect(request.Estimate).To(Equal(30))
+				return task, nil
+			})
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{Variables: map[string]string{"team": "core"}})).
+				To(Equal(task))
+		})
+
+		It("lets the variables override the built-in placeholders", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+			mockTasks.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, request tasksModel.UpsertTaskRequest) (tasksModel.GetTaskResponse, error) {
+				Expect(request.Name).To(Equal("Review of tomorrow"))
+				return tasksModel.GetTaskResponse{Id: 7}, nil
+			})
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{Variables: map[string]string{"date": "tomorrow"}})).
+				Error().NotTo(HaveOccurred())
+		})
+
+		It("returns the error of the tasks service", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+			mockTasks.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(tasksModel.GetTaskResponse{}, errors.ErrInvalidArgument)
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{})).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("adds the checklist to the new task", func() {
+			weekly.Checklist = []string{"Inbox of {{date}}", "Calendar"}
+			mockRepository.EXPECT().GetById(gomock.Any(), id).Return(weekly, nil)
+			mockTasks.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(tasksModel.GetTaskResponse{Id: 7}, nil)
+			gomock.InOrder(
+				mockChecklists.EXPECT().Add(gomock.Any(), 7, checklistsModel.AddChecklistItemRequest{Text: "Inbox of 2023-03-17"}),
+				mockChecklists.EXPECT().Add(gomock.Any(), 7, checklistsModel.AddChecklistItemRequest{Text: "Calendar"}),
+			)
+			task := tasksModel.GetTaskResponse{Id: 7, Checklist: &tasksModel.ChecklistProgress{Total: 2}}
+			mockTasks.EXPECT().GetById(gomock.Any(), 7).Return(task, nil)
+
+			Expect(templatesSvc.Instantiate(ctx, id, model.InstantiateTemplateRequest{})).To(Equal(task))
+		})
+	})
+
+})
@@ -0,0 +1,48 @@
+package tenancy
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+type Partitions[T any] struct {
+	mutex      sync.Mutex
+	create     func() *T
+	partitions map[string]*T
+}
+
+func NewPartitions[T any](create func() *T) *Partitions[T] {
+	return &Partitions[T]{
+		create:     create,
+		partitions: map[string]*T{},
+	}
+}
+
+func (partitions *Partitions[T]) Get(ctx context.Context) *T {
+	return partitions.Of(Of(ctx))
+}
+
+func (partitions *Partitions[T]) Of(tenant string) *T {
+	partitions.mutex.Lock()
+	defer partitions.mutex.Unlock()
+
+	partition, found := partitions.partitions[tenant]
+	if !found {
+		partition = partitions.create()
+		partitions.partitions[tenant] = partition
+	}
+	return partition
+}
+
+func (partitions *Partitions[T]) Tenants() []string {
+	partitions.mutex.Lock()
+	defer partitions.mutex.Unlock()
+
+	var tenants []string
+	for tenant := range partitions.partitions {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+	return tenants
+}
@@ -0,0 +1,102 @@
+package tenancy
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+)
+
+type Resource string
+
+const (
+	ResourceTasks       Resource = "tasks"
+	ResourceLists       Resource = "lists"
+	ResourceTags        Resource = "tags"
+	ResourceTemplates   Resource = "templates"
+	ResourceAttachments Resource = "attachments"
+)
+
+type Limits map[Resource]int
+
+type Quotas interface {
+	Check(ctx context.Context, resource Resource, count int) error
+	Limit(tenant string, resource Resource) int
+}
+
+type quotasImpl struct {
+	defaults  Limits
+	overrides map[string]Limits
+}
+
+type QuotasOption func(*quotasImpl)
+
+func NewQuotas(options ...QuotasOption) Quotas {
+	instance := quotasImpl{
+		defaults:  Limits{},
+		overrides: map[string]Limits{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithDefaults(limits Limits) QuotasOption {
+	return func(quotas *quotasImpl) {
+		if quotas != nil && limits != nil {
+			quotas.defaults = limits
+		}
+	}
+}
+
+func WithOverrides(overrides map[string]Limits) QuotasOption {
+	return func(quotas *quotasImpl) {
+		if quotas != nil && overrides != nil {
+			quotas.overrides = overrides
+		}
+	}
+}
+
+func LoadOverrides(path string) (map[string]Limits, error) {
+	overrides := map[string]Limits{}
+	if path == "" {
+		return overrides, nil
+	}
+
+	err := persistence.ReadJSON(path, &overrides)
+	if err == errors.ErrNotFound {
+		return overrides, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for tenant := range overrides {
+		if !IsValid(tenant) {
+			return nil, errors.ErrInvalidArgument
+		}
+	}
+	return overrides, nil
+}
+
+func (quotas *quotasImpl) Check(ctx context.Context, resource Resource, count int) error {
+	limit := quotas.Limit(Of(ctx), resource)
+	if limit > 0 && count >= limit {
+		return errors.ErrQuotaExceeded
+	}
+	return nil
+}
+
+func (quotas *quotasImpl) Limit(tenant string, resource Resource) int {
+	if limits, found := quotas.overrides[tenant]; found {
+		if limit, found := limits[resource]; found {
+			return limit
+		}
+	}
+	return quotas.defaults[resource]
+}
@@ -0,0 +1,80 @@
+package tenancy
+
+import (
+	"context"
+	goErrors "errors"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+type Registry interface {
+	Add(tenant string)
+	All() []string
+}
+
+type memoryRegistry struct {
+	mutex   sync.RWMutex
+	tenants map[string]bool
+}
+
+func NewRegistry(tenants ...string) Registry {
+	instance := memoryRegistry{
+		tenants: map[string]bool{DefaultTenant: true},
+	}
+
+	for _, tenant := range tenants {
+		instance.Add(tenant)
+	}
+
+	return &instance
+}
+
+func (registry *memoryRegistry) Add(tenant string) {
+	if tenant == "" {
+		return
+	}
+
+	registry.mutex.RLock()
+	found := registry.tenants[tenant]
+	registry.mutex.RUnlock()
+	if found {
+		return
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.tenants[tenant] = true
+}
+
+func (registry *memoryRegistry) All() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	var tenants []string
+	for tenant := range registry.tenants {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+func Each(ctx context.Context, registry Registry, fn func(ctx context.Context) error) error {
+	if registry == nil {
+		return fn(ctx)
+	}
+
+	var errs []error
+	for _, tenant := range registry.All() {
+		tenantCtx := With(ctx, tenant)
+		if logger, err := logr.FromContext(ctx); err == nil {
+			tenantCtx = logr.NewContext(tenantCtx, logger.WithValues("tenant", tenant))
+		}
+
+		if err := fn(tenantCtx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return goErrors.Join(errs...)
+}
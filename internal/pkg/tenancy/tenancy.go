@@ -0,0 +1,40 @@
+package tenancy
+
+import (
+	"context"
+	"regexp"
+
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+)
+
+const DefaultTenant = "default"
+
+var tenantPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+func IsValid(tenant string) bool {
+	return tenantPattern.MatchString(tenant)
+}
+
+func Of(ctx context.Context) string {
+	tenant, err := reqctx.GetTenant(ctx)
+	if err != nil || tenant == "" {
+		return DefaultTenant
+	}
+	return tenant
+}
+
+func With(ctx context.Context, tenant string) context.Context {
+	return reqctx.SetTenant(ctx, tenant)
+}
+
+func Scoped(ctx context.Context) (string, bool) {
+	tenant, err := reqctx.GetTenant(ctx)
+	if err != nil || tenant == "" {
+		return "", false
+	}
+	return tenant, true
+}
+
+func Owns(tenant string, owner string) bool {
+	return owner == tenant || owner == "" && tenant == DefaultTenant
+}
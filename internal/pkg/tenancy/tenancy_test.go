@@ -0,0 +1,156 @@
+package tenancy_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+)
+
+var _ = Describe("Tenancy", func() {
+
+	acme := tenancy.With(context.Background(), "acme")
+
+	DescribeTable("IsValid",
+		func(tenant string, valid bool) {
+			Expect(tenancy.IsValid(tenant)).To(Equal(valid))
+		},
+		Entry("default", tenancy.DefaultTenant, true),
+		Entry("with digits, dashes and underscores", "team-42_eu", true),
+		Entry("empty", "", false),
+		Entry("with upper case letters", "Acme", false),
+		Entry("with a path separator", "../acme", false),
+		Entry("starting with a dash", "-acme", false),
+	)
+
+	Describe("Of", func() {
+		It("returns the tenant of the context", func() {
+			Expect(tenancy.Of(acme)).To(Equal("acme"))
+		})
+
+		It("defaults to the default tenant", func() {
+			Expect(tenancy.Of(context.Background())).To(Equal(tenancy.DefaultTenant))
+		})
+	})
+
+	Describe("Partitions", func() {
+		type partition struct {
+			seq int
+		}
+
+		It("creates one partition per tenant on first use", func() {
+			partitions := tenancy.NewPartitions(func() *partition { return &partition{} })
+
+			partitions.Get(acme).seq++
+			partitions.Get(acme).seq++
+			partitions.Get(context.Background()).seq++
+
+			Expect(partitions.Get(acme).seq).To(Equal(2))
+			Expect(partitions.Of(tenancy.DefaultTenant).seq).To(Equal(1))
+			Expect(partitions.Tenants()).To(Equal([]string{"acme", tenancy.DefaultTenant}))
+		})
+	})
+
+	Describe("Registry", func() {
+		It("always holds the default tenant", func() {
+			Expect(tenancy.NewRegistry().All()).To(Equal([]string{tenancy.DefaultTenant}))
+		})
+
+		It("holds the added tenants once", func() {
+			registry := tenancy.NewRegistry("globex")
+			registry.Add("acme")
+			registry.Add("acme")
+			registry.Add("")
+
+			Expect(registry.All()).To(Equal([]string{"acme", tenancy.DefaultTenant, "globex"}))
+		})
+	})
+
+	Describe("Each", func() {
+		It("runs once for the context as is without a registry", func() {
+			var scopes []bool
+			Expect(tenancy.Each(context.Background(), nil, func(ctx context.Context) error {
+				_, scoped := tenancy.Scoped(ctx)
+				scopes = append(scopes, scoped)
+				return nil
+			})).To(Succeed())
+
+			Expect(scopes).To(Equal([]bool{false}))
+		})
+
+		It("runs for every tenant and joins the errors", func() {
+			customErr := fmt.Errorf("custom error")
+
+			var tenants []string
+			err := tenancy.Each(context.Background(), tenancy.NewRegistry("acme", "globex"), func(ctx context.Context) error {
+				tenants = append(tenants, tenancy.Of(ctx))
+				if tenancy.Of(ctx) == "acme" {
+					return customErr
+				}
+				return nil
+			})
+
+			Expect(tenants).To(Equal([]string{"acme", tenancy.DefaultTenant, "globex"}))
+			Expect(err).To(MatchError(customErr))
+		})
+	})
+
+	Describe("Quotas", func() {
+		quotas := tenancy.NewQuotas(
+			tenancy.WithDefaults(tenancy.Limits{tenancy.ResourceTasks: 2}),
+			tenancy.WithOverrides(map[string]tenancy.Limits{"acme": {tenancy.ResourceTasks: 5, tenancy.ResourceLists: 1}}),
+		)
+
+		It("applies the default limits", func() {
+			Expect(quotas.Check(context.Background(), tenancy.ResourceTasks, 1)).To(Succeed())
+			Expect(quotas.Check(context.Background(), tenancy.ResourceTasks, 2)).To(Equal(errors.ErrQuotaExceeded))
+		})
+
+		It("applies the limits of the tenant", func() {
+			Expect(quotas.Check(acme, tenancy.ResourceTasks, 4)).To(Succeed())
+			Expect(quotas.Check(acme, tenancy.ResourceTasks, 5)).To(Equal(errors.ErrQuotaExceeded))
+			Expect(quotas.Check(acme, tenancy.ResourceLists, 1)).To(Equal(errors.ErrQuotaExceeded))
+		})
+
+		It("does not limit the resources without limit", func() {
+			Expect(quotas.Check(context.Background(), tenancy.ResourceTags, 1000)).To(Succeed())
+			Expect(quotas.Limit("acme", tenancy.ResourceTags)).To(BeZero())
+		})
+	})
+
+	Describe("LoadOverrides", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		It("returns no overrides without file", func() {
+			Expect(tenancy.LoadOverrides("")).To(BeEmpty())
+			Expect(tenancy.LoadOverrides(filepath.Join(dir, "missing.json"))).To(BeEmpty())
+		})
+
+		It("reads the limits of each tenant", func() {
+			path := filepath.Join(dir, "quotas.json")
+			Expect(os.WriteFile(path, []byte(`{"acme":{"tasks":10,"lists":2}}`), 0o600)).To(Succeed())
+
+			Expect(tenancy.LoadOverrides(path)).To(Equal(map[string]tenancy.Limits{
+				"acme": {tenancy.ResourceTasks: 10, tenancy.ResourceLists: 2},
+			}))
+		})
+
+		It("rejects invalid tenants", func() {
+			path := filepath.Join(dir, "quotas.json")
+			Expect(os.WriteFile(path, []byte(`{"Acme":{"tasks":10}}`), 0o600)).To(Succeed())
+
+			Expect(tenancy.LoadOverrides(path)).Error().To(Equal(errors.ErrInvalidArgument))
+		})
+	})
+
+})
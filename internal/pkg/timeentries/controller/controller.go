@@ -0,0 +1,282 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/service"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByTaskIdFailed   = "GetByTaskId failed"
+	getByTaskIdResponse = "GetByTaskId response"
+	startFailed         = "Start failed"
+	startResponse       = "Start response"
+	stopFailed          = "Stop failed"
+	stopResponse        = "Stop response"
+	addFailed           = "Add failed"
+	addResponse         = "Add response"
+	removeByIdFailed    = "RemoveById failed"
+	getReportFailed     = "GetReport failed"
+	getReportResponse   = "GetReport response"
+)
+
+const (
+	timerRunning    = "A timer is already running on the task"
+	timerNotRunning = "No timer is running on the task"
+	dateLayout      = "2006-01-02"
+	viewerRequired  = "The operation requires the viewer role on the list"
+	editorRequired  = "The operation requires the editor role on the list"
+)
+
+type Controller interface {
+	GetByTaskId(w http.ResponseWriter, r *http.Request)
+	Start(w http.ResponseWriter, r *http.Request)
+	Stop(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	RemoveById(w http.ResponseWriter, r *http.Request)
+	GetReport(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetByTaskId(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	dtos, err := ctrl.service.GetByTaskId(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, viewerRequired))
+		} else {
+			logger.Error(err, getByTaskIdFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	if len(dtos) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getByTaskIdResponse, constants.Payload, dtos)
+
+	_ = marshaller.SerializeEntity(w, dtos)
+}
+
+func (ctrl *controllerImpl) Start(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Start(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, timerRunning))
+		} else {
+			logger.Error(err, startFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(startResponse, constants.Payload, dto)
+
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Stop(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Stop(r.Context(), taskId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, timerNotRunning))
+		} else {
+			logger.Error(err, stopFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(stopResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	request, stop := common.GetValidRequestOrStop[model.AddTimeEntryRequest](w, r, addFailed)
+	if stop {
+		return
+	}
+
+	dto, err := ctrl.service.Add(r.Context(), taskId, request)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, addFailed, constants.Id, taskId)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, dto.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(addResponse, constants.Payload, dto)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func (ctrl *controllerImpl) RemoveById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	taskId, stop := common.GetPathParamOrStop(w, r, constants.Id)
+	if stop {
+		return
+	}
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.EntryId)
+	if stop {
+		return
+	}
+
+	err := ctrl.service.RemoveById(r.Context(), taskId, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrForbidden {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusForbidden, editorRequired))
+		} else {
+			logger.Error(err, removeByIdFailed, constants.Id, taskId, constants.EntryId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *controllerImpl) GetReport(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	request, err := getReportRequest(r)
+	if err != nil || !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, getReportFailed, constants.GroupBy, request.GroupBy,
+			constants.From, urlparams.ParseQueryParam(r, constants.From), constants.To, urlparams.ParseQueryParam(r, constants.To))
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid report parameters"))
+		return
+	}
+
+	dto, err := ctrl.service.GetReport(r.Context(), request)
+	if err != nil {
+		logger.Error(err, getReportFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	logger.V(1).Info(getReportResponse, constants.Payload, dto)
+
+	_ = marshaller.SerializeEntity(w, dto)
+}
+
+func getReportRequest(r *http.Request) (request model.GetTimeReportRequest, err error) {
+	request.GroupBy = urlparams.ParseQueryParam(r, constants.GroupBy)
+	if request.GroupBy == "" {
+		request.GroupBy = model.GroupByTask
+	}
+
+	if request.From, err = getQueryTime(r, constants.From, false); err != nil {
+		return request, err
+	}
+	request.To, err = getQueryTime(r, constants.To, true)
+	return request, err
+}
+
+func getQueryTime(r *http.Request, key string, endOfDay bool) (*time.Time, error) {
+	value := urlparams.ParseQueryParam(r, key)
+	if value == "" {
+		return nil, nil
+	}
+
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		if endOfDay {
+			date = date.AddDate(0, 0, 1)
+		}
+		return &date, nil
+	}
+
+	instant, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &instant, nil
+}
@@ -0,0 +1,257 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/timeentries/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder        *httptest.ResponseRecorder
+		mockCtrl        *gomock.Controller
+		mockService     *serviceMock.MockService
+		timeEntriesCtrl controller.Controller
+	)
+
+	newRequest := func(target string, body string, entryId string) *http.Request {
+		request := httptest.NewRequest("", target, strings.NewReader(body))
+		ctx := reqctx.SetPathParam(request.Context(), constants.Id, "1")
+		if entryId != "" {
+			ctx = reqctx.SetPathParam(ctx, constants.EntryId, entryId)
+		}
+		return request.WithContext(ctx)
+	}
+
+	errorMessage := func() string {
+		var payload errorModel.Response
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &payload)).To(Succeed())
+		return payload.Message
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		timeEntriesCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+
+		When("the task id is not in the context", func() {
+			It("responds with status InternalServerError", func() {
+				timeEntriesCtrl.GetByTaskId(recorder, httptest.NewRequest("", url, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		When("the caller cannot read the task", func() {
+			It("responds with status Forbidden", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(nil, errors.ErrForbidden)
+
+				timeEntriesCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the task has no entries", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return(nil, nil)
+
+				timeEntriesCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the task has entries", func() {
+			It("responds with status OK and the entries in the payload", func() {
+				mockService.EXPECT().GetByTaskId(gomock.Any(), 1).Return([]model.GetTimeEntryResponse{{Id: 2, TaskId: 1, Minutes: 30}}, nil)
+
+				timeEntriesCtrl.GetByTaskId(recorder, newRequest(url, "", ""))
+
+				var payload []model.GetTimeEntryResponse
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &payload)).To(Succeed())
+				Expect(payload).To(HaveExactElements(HaveField("Minutes", 30)))
+			})
+		})
+
+	})
+
+	Describe("Start", func() {
+
+		DescribeTable("maps the service errors",
+			func(err error, expectedCode int) {
+				mockService.EXPECT().Start(gomock.Any(), 1).Return(model.GetTimeEntryResponse{}, err)
+
+				timeEntriesCtrl.Start(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(expectedCode))
+			},
+			Entry("task not editable", errors.ErrForbidden, http.StatusForbidden),
+			Entry("task not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("timer already running", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected error", errors.ErrInvalidArgument, http.StatusInternalServerError),
+		)
+
+		When("the timer is started", func() {
+			It("responds with status Created and the running entry in the payload", func() {
+				mockService.EXPECT().Start(gomock.Any(), 1).Return(model.GetTimeEntryResponse{Id: 2, TaskId: 1, Running: true}, nil)
+
+				timeEntriesCtrl.Start(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"running":true`))
+			})
+		})
+
+	})
+
+	Describe("Stop", func() {
+
+		When("no timer is running", func() {
+			It("responds with status Conflict", func() {
+				mockService.EXPECT().Stop(gomock.Any(), 1).Return(model.GetTimeEntryResponse{}, errors.ErrConflict)
+
+				timeEntriesCtrl.Stop(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+				Expect(errorMessage()).To(Equal("No timer is running on the task"))
+			})
+		})
+
+		When("the timer is stopped", func() {
+			It("responds with status OK and the stopped entry in the payload", func() {
+				mockService.EXPECT().Stop(gomock.Any(), 1).Return(model.GetTimeEntryResponse{Id: 2, TaskId: 1, Minutes: 25}, nil)
+
+				timeEntriesCtrl.Stop(recorder, newRequest(url, "", ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"minutes":25`))
+			})
+		})
+
+	})
+
+	Describe("Add", func() {
+
+		DescribeTable("rejects an invalid body",
+			func(body string) {
+				timeEntriesCtrl.Add(recorder, newRequest(url, body, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("not JSON", "minutes"),
+			Entry("without minutes", `{"note":"review"}`),
+		)
+
+		When("the entry is logged", func() {
+			It("responds with status Created, the location and the entry in the payload", func() {
+				mockService.EXPECT().Add(gomock.Any(), 1, model.AddTimeEntryRequest{Minutes: 30, Note: "review"}).
+					Return(model.GetTimeEntryResponse{Id: 2, TaskId: 1, Minutes: 30}, nil)
+
+				timeEntriesCtrl.Add(recorder, newRequest(url, `{"minutes":30,"note":"review"}`, ""))
+
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+				Expect(recorder.Header().Get("Location")).To(Equal("url/2"))
+			})
+		})
+
+	})
+
+	Describe("RemoveById", func() {
+
+		When("the entry is not found", func() {
+			It("responds with status NotFound", func() {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 2).Return(errors.ErrNotFound)
+
+				timeEntriesCtrl.RemoveById(recorder, newRequest(url, "", "2"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the entry is removed", func() {
+			It("responds with status NoContent", func() {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1, 2).Return(nil)
+
+				timeEntriesCtrl.RemoveById(recorder, newRequest(url, "", "2"))
+
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+	})
+
+	Describe("GetReport", func() {
+
+		DescribeTable("rejects invalid parameters",
+			func(query string) {
+				timeEntriesCtrl.GetReport(recorder, httptest.NewRequest("", url+query, nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("with an unknown grouping", "?groupBy=tag"),
+			Entry("with an invalid start", "?from=yesterday"),
+			Entry("with an inverted range", "?from=2023-03-12&to=2023-03-10"),
+		)
+
+		It("groups by task over all time by default", func() {
+			mockService.EXPECT().GetReport(gomock.Any(), model.GetTimeReportRequest{GroupBy: model.GroupByTask}).
+				Return(model.GetTimeReportResponse{GroupBy: model.GroupByTask, Rows: []model.TimeReportRow{}}, nil)
+
+			timeEntriesCtrl.GetReport(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("includes the whole last day of a range of dates", func() {
+			from := time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)
+			mockService.EXPECT().GetReport(gomock.Any(), model.GetTimeReportRequest{GroupBy: model.GroupByList, From: &from, To: &to}).
+				Return(model.GetTimeReportResponse{GroupBy: model.GroupByList, Rows: []model.TimeReportRow{}}, nil)
+
+			timeEntriesCtrl.GetReport(recorder, httptest.NewRequest("", url+"?groupBy=list&from=2023-03-10&to=2023-03-12", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("accepts timestamps as bounds", func() {
+			from := time.Date(2023, 3, 10, 9, 0, 0, 0, time.UTC)
+			mockService.EXPECT().GetReport(gomock.Any(), model.GetTimeReportRequest{GroupBy: model.GroupByTask, From: &from}).
+				Return(model.GetTimeReportResponse{GroupBy: model.GroupByTask, Rows: []model.TimeReportRow{}}, nil)
+
+			timeEntriesCtrl.GetReport(recorder, httptest.NewRequest("", url+"?from=2023-03-10T09:00:00Z", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+	})
+
+})
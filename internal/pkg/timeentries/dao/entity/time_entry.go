@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type TimeEntry struct {
+	Id        int        `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	TaskId    int        `json:"taskId" gorm:"column:task_id;type:int;index"`
+	StartedAt time.Time  `json:"startedAt" gorm:"column:started_at;type:timestamp;index"`
+	EndedAt   *time.Time `json:"endedAt,omitempty" gorm:"column:ended_at;type:timestamp"`
+	Note      string     `json:"note,omitempty" gorm:"column:note;type:varchar;size:255"`
+	CreatedAt time.Time  `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+}
+
+func (entry TimeEntry) IsRunning() bool {
+	return entry.EndedAt == nil
+}
+
+func (entry TimeEntry) Duration(now time.Time) time.Duration {
+	if entry.EndedAt != nil {
+		return entry.EndedAt.Sub(entry.StartedAt)
+	}
+	return now.Sub(entry.StartedAt)
+}
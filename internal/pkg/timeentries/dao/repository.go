@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.TimeEntry, error)
+	GetAll(ctx context.Context) ([]entity.TimeEntry, error)
+	GetByTaskId(ctx context.Context, taskId int) ([]entity.TimeEntry, error)
+	Insert(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error)
+	StartIfIdle(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error)
+	Update(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error)
+	RemoveById(ctx context.Context, id int) (entity.TimeEntry, error)
+	RemoveByTaskId(ctx context.Context, taskId int) error
+}
+
+type partition struct {
+	entries map[int]entity.TimeEntry
+	seq     int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithEntries(entries map[int]entity.TimeEntry) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && entries != nil {
+			partition := repository.partitions.Of(tenancy.DefaultTenant)
+			partition.entries = entries
+			for id := range entries {
+				if id >= partition.seq {
+					partition.seq = id + 1
+				}
+			}
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		entries: map[int]entity.TimeEntry{},
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.TimeEntry, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	entry, found := partition.entries[id]
+	if !found {
+		return entity.TimeEntry{}, errors.ErrNotFound
+	}
+	return entry, nil
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.TimeEntry, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var entries []entity.TimeEntry
+	for _, entry := range partition.entries {
+		entries = append(entries, entry)
+	}
+	sortByStart(entries)
+
+	return entries, nil
+}
+
+func (repo *memoryRepository) GetByTaskId(ctx context.Context, taskId int) ([]entity.TimeEntry, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var entries []entity.TimeEntry
+	for _, entry := range partition.entries {
+		if entry.TaskId == taskId {
+			entries = append(entries, entry)
+		}
+	}
+	sortByStart(entries)
+
+	return entries, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	return repo.partitions.Get(ctx).insert(entry), nil
+}
+
+func (repo *memoryRepository) StartIfIdle(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for _, other := range partition.entries {
+		if other.TaskId == entry.TaskId && other.IsRunning() {
+			return entity.TimeEntry{}, errors.ErrConflict
+		}
+	}
+
+	entry.EndedAt = nil
+	return partition.insert(entry), nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, entry entity.TimeEntry) (entity.TimeEntry, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldEntry, found := partition.entries[entry.Id]
+	if !found {
+		return entity.TimeEntry{}, errors.ErrNotFound
+	}
+
+	entry.TaskId = oldEntry.TaskId
+	entry.CreatedAt = oldEntry.CreatedAt
+	partition.entries[entry.Id] = entry
+	return entry, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.TimeEntry, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	entry, found := partition.entries[id]
+	if !found {
+		return entity.TimeEntry{}, errors.ErrNotFound
+	}
+	delete(partition.entries, id)
+	return entry, nil
+}
+
+func (repo *memoryRepository) RemoveByTaskId(ctx context.Context, taskId int) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	for id, entry := range partition.entries {
+		if entry.TaskId == taskId {
+			delete(partition.entries, id)
+		}
+	}
+	return nil
+}
+
+func sortByStart(entries []entity.TimeEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].StartedAt.Equal(entries[j].StartedAt) {
+			return entries[i].Id < entries[j].Id
+		}
+		return entries[i].StartedAt.Before(entries[j].StartedAt)
+	})
+}
+
+func (partition *partition) insert(entry entity.TimeEntry) entity.TimeEntry {
+	entry.Id, partition.seq = partition.seq, partition.seq+1
+	entry.CreatedAt = time.Now()
+	partition.entries[entry.Id] = entry
+	return entry
+}
@@ -0,0 +1,111 @@
+package repository_test
+
+import (
+	"context"
+
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var (
+		repo  repository.Repository
+		now   time.Time
+		entry entity.TimeEntry
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		repo = repository.New()
+		entry, _ = repo.Insert(ctx, entity.TimeEntry{TaskId: 1, StartedAt: now})
+		_, _ = repo.Insert(ctx, entity.TimeEntry{TaskId: 2, StartedAt: now.Add(-time.Hour)})
+		_, _ = repo.Insert(ctx, entity.TimeEntry{TaskId: 1, StartedAt: now.Add(-2 * time.Hour)})
+	})
+
+	Describe("Insert", func() {
+		It("assigns an id and a creation timestamp", func() {
+			Expect(entry.Id).To(Equal(0))
+			Expect(entry.CreatedAt).NotTo(BeZero())
+		})
+	})
+
+	Describe("StartIfIdle", func() {
+		It("returns ErrConflict when an entry of the task is running", func() {
+			Expect(repo.StartIfIdle(ctx, entity.TimeEntry{TaskId: 1, StartedAt: now})).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("inserts a running entry when no entry of the task is running", func() {
+			started, err := repo.StartIfIdle(ctx, entity.TimeEntry{TaskId: 3, StartedAt: now})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started.Id).To(Equal(3))
+			Expect(started.IsRunning()).To(BeTrue())
+			Expect(repo.StartIfIdle(ctx, entity.TimeEntry{TaskId: 3, StartedAt: now})).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns all the entries, earliest first", func() {
+			Expect(repo.GetAll(ctx)).To(HaveExactElements(HaveField("Id", 2), HaveField("Id", 1), HaveField("Id", 0)))
+		})
+	})
+
+	Describe("GetByTaskId", func() {
+		It("returns the entries of the task, earliest first", func() {
+			Expect(repo.GetByTaskId(ctx, 1)).To(HaveExactElements(HaveField("Id", 2), HaveField("Id", 0)))
+		})
+	})
+
+	Describe("Update", func() {
+		It("keeps the task of the entry", func() {
+			endedAt := now.Add(time.Minute)
+
+			updated, err := repo.Update(ctx, entity.TimeEntry{Id: entry.Id, TaskId: 9, StartedAt: now, EndedAt: &endedAt})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.TaskId).To(Equal(1))
+			Expect(updated.EndedAt).To(Equal(&endedAt))
+			Expect(updated.CreatedAt).To(Equal(entry.CreatedAt))
+		})
+
+		It("returns ErrNotFound for an unknown entry", func() {
+			Expect(repo.Update(ctx, entity.TimeEntry{Id: 42})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the entry", func() {
+			Expect(repo.RemoveById(ctx, entry.Id)).To(HaveField("TaskId", 1))
+			Expect(repo.GetById(ctx, entry.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveByTaskId", func() {
+		It("removes the entries of the task only", func() {
+			Expect(repo.RemoveByTaskId(ctx, 1)).To(Succeed())
+			Expect(repo.GetByTaskId(ctx, 1)).To(BeEmpty())
+			Expect(repo.GetByTaskId(ctx, 2)).To(HaveLen(1))
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the entries of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+
+			Expect(repo.GetById(acme, entry.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(acme)).To(BeEmpty())
+			Expect(repo.GetAll(ctx)).To(HaveLen(3))
+		})
+	})
+
+})
@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+)
+
+var _ = Describe("Model", func() {
+
+	now := time.Date(2023, 3, 12, 18, 0, 0, 0, time.UTC)
+
+	Describe("EntityToGetTimeEntryResponse", func() {
+		It("returns the logged minutes of a stopped entry", func() {
+			endedAt := now.Add(-10 * time.Minute)
+			e := entity.TimeEntry{Id: 1, TaskId: 2, StartedAt: now.Add(-time.Hour), EndedAt: &endedAt, Note: "review"}
+
+			Expect(model.EntityToGetTimeEntryResponse(e, now)).To(Equal(model.GetTimeEntryResponse{
+				Id:        1,
+				TaskId:    2,
+				StartedAt: now.Add(-time.Hour),
+				EndedAt:   &endedAt,
+				Minutes:   50,
+				Note:      "review",
+			}))
+		})
+
+		It("returns the minutes elapsed so far of a running entry", func() {
+			e := entity.TimeEntry{Id: 1, TaskId: 2, StartedAt: now.Add(-20 * time.Minute)}
+
+			dto := model.EntityToGetTimeEntryResponse(e, now)
+
+			Expect(dto.Running).To(BeTrue())
+			Expect(dto.Minutes).To(Equal(20))
+		})
+	})
+
+	Describe("AddTimeEntryRequest", func() {
+		DescribeTable("IsValid",
+			func(request model.AddTimeEntryRequest, expected bool) {
+				Expect(request.IsValid()).To(Equal(expected))
+			},
+			Entry("with minutes", model.AddTimeEntryRequest{Minutes: 30}, true),
+			Entry("without minutes", model.AddTimeEntryRequest{}, false),
+			Entry("with negative minutes", model.AddTimeEntryRequest{Minutes: -5}, false),
+			Entry("with a too long note", model.AddTimeEntryRequest{Minutes: 30, Note: strings.Repeat("a", 256)}, false),
+		)
+
+		Describe("ToEntity", func() {
+			It("ends the entry now when no start is given", func() {
+				e := model.AddTimeEntryRequest{Minutes: 30, Note: " review "}.ToEntity(2, now)
+
+				Expect(e.TaskId).To(Equal(2))
+				Expect(e.StartedAt).To(Equal(now.Add(-30 * time.Minute)))
+				Expect(*e.EndedAt).To(Equal(now))
+				Expect(e.Note).To(Equal("review"))
+			})
+
+			It("starts the entry at the given start", func() {
+				startedAt := now.Add(-24 * time.Hour)
+
+				e := model.AddTimeEntryRequest{StartedAt: &startedAt, Minutes: 30}.ToEntity(2, now)
+
+				Expect(e.StartedAt).To(Equal(startedAt))
+				Expect(*e.EndedAt).To(Equal(startedAt.Add(30 * time.Minute)))
+			})
+		})
+	})
+
+	Describe("GetTimeReportRequest", func() {
+		earlier, later := now.Add(-time.Hour), now
+
+		DescribeTable("IsValid",
+			func(request model.GetTimeReportRequest, expected bool) {
+				Expect(request.IsValid()).To(Equal(expected))
+			},
+			Entry("grouped by task", model.GetTimeReportRequest{GroupBy: model.GroupByTask}, true),
+			Entry("grouped by list", model.GetTimeReportRequest{GroupBy: model.GroupByList}, true),
+			Entry("grouped by status", model.GetTimeReportRequest{GroupBy: model.GroupByStatus}, true),
+			Entry("grouped by an unknown field", model.GetTimeReportRequest{GroupBy: "tag"}, false),
+			Entry("within a range", model.GetTimeReportRequest{GroupBy: model.GroupByTask, From: &earlier, To: &later}, true),
+			Entry("within an inverted range", model.GetTimeReportRequest{GroupBy: model.GroupByTask, From: &later, To: &earlier}, false),
+		)
+	})
+
+})
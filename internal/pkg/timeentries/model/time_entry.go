@@ -0,0 +1,104 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+)
+
+const (
+	GroupByTask   = "task"
+	GroupByList   = "list"
+	GroupByStatus = "status"
+)
+
+const maxNoteLength = 255
+
+type GetTimeEntryResponse struct {
+	Id        int        `json:"id"`
+	TaskId    int        `json:"taskId"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	Minutes   int        `json:"minutes"`
+	Running   bool       `json:"running"`
+	Note      string     `json:"note,omitempty"`
+}
+
+func EntityToGetTimeEntryResponse(entity entity.TimeEntry, now time.Time) GetTimeEntryResponse {
+	return GetTimeEntryResponse{
+		Id:        entity.Id,
+		TaskId:    entity.TaskId,
+		StartedAt: entity.StartedAt,
+		EndedAt:   entity.EndedAt,
+		Minutes:   ToMinutes(entity.Duration(now)),
+		Running:   entity.IsRunning(),
+		Note:      entity.Note,
+	}
+}
+
+func ToMinutes(duration time.Duration) int {
+	return int(duration.Round(time.Minute) / time.Minute)
+}
+
+type AddTimeEntryRequest struct {
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	Minutes   int        `json:"minutes"`
+	Note      string     `json:"note,omitempty"`
+}
+
+func (dto AddTimeEntryRequest) IsValid() bool {
+	return dto.Minutes > 0 && len(strings.TrimSpace(dto.Note)) <= maxNoteLength
+}
+
+func (dto AddTimeEntryRequest) ToEntity(taskId int, now time.Time) entity.TimeEntry {
+	duration := time.Duration(dto.Minutes) * time.Minute
+
+	startedAt := now.Add(-duration)
+	if dto.StartedAt != nil {
+		startedAt = *dto.StartedAt
+	}
+	endedAt := startedAt.Add(duration)
+
+	return entity.TimeEntry{
+		TaskId:    taskId,
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+		Note:      strings.TrimSpace(dto.Note),
+	}
+}
+
+type GetTimeReportRequest struct {
+	GroupBy  string
+	From     *time.Time
+	To       *time.Time
+	Readable func(listId int) bool
+}
+
+func (dto GetTimeReportRequest) IsValid() bool {
+	switch dto.GroupBy {
+	case GroupByTask, GroupByList, GroupByStatus:
+	default:
+		return false
+	}
+	return dto.From == nil || dto.To == nil || dto.From.Before(*dto.To)
+}
+
+type TimeReportRow struct {
+	Id       int `json:"id"`
+	Estimate int `json:"estimate"`
+	Logged   int `json:"logged"`
+}
+
+type TimeReportTotal struct {
+	Estimate int `json:"estimate"`
+	Logged   int `json:"logged"`
+}
+
+type GetTimeReportResponse struct {
+	GroupBy string          `json:"groupBy"`
+	From    *time.Time      `json:"from,omitempty"`
+	To      *time.Time      `json:"to,omitempty"`
+	Rows    []TimeReportRow `json:"rows"`
+	Total   TimeReportTotal `json:"total"`
+}
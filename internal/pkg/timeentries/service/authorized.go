@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetByTaskId(ctx context.Context, taskId int) ([]model.GetTimeEntryResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleViewer); err != nil {
+		return nil, err
+	}
+	return authorized.service.GetByTaskId(ctx, taskId)
+}
+
+func (authorized *authorizedService) Start(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return authorized.service.Start(ctx, taskId)
+}
+
+func (authorized *authorizedService) Stop(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return authorized.service.Stop(ctx, taskId)
+}
+
+func (authorized *authorizedService) Add(ctx context.Context, taskId int, request model.AddTimeEntryRequest) (model.GetTimeEntryResponse, error) {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return authorized.service.Add(ctx, taskId, request)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, taskId int, id int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, taskId, id)
+}
+
+func (authorized *authorizedService) GetReport(ctx context.Context, request model.GetTimeReportRequest) (model.GetTimeReportResponse, error) {
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return model.GetTimeReportResponse{}, err
+	}
+
+	request.Readable = lists.Contains
+	return authorized.service.GetReport(ctx, request)
+}
+
+func (authorized *authorizedService) RemoveTask(ctx context.Context, taskId int) error {
+	if err := authorized.policy.AuthorizeTask(ctx, taskId, rolesEntity.RoleEditor); err != nil {
+		return err
+	}
+	return authorized.service.RemoveTask(ctx, taskId)
+}
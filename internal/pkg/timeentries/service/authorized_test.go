@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/timeentries/service"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get the entries of a task", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetByTaskId(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to start a timer", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Start(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to stop a timer", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().Stop(ctx, 1).Return(model.GetTimeEntryResponse{Id: 2}, nil)
+
+		Expect(authorizedSvc.Stop(ctx, 1)).To(HaveField("Id", 2))
+	})
+
+	It("requires the editor role to add an entry", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Add(ctx, 1, model.AddTimeEntryRequest{})).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the editor role to remove an entry", func() {
+		mockPolicy.EXPECT().AuthorizeTask(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1, 2)).To(Equal(errors.ErrForbidden))
+	})
+
+	It("reports on the readable lists only", func() {
+		userId := 2
+		userCtx := reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "john", UserId: &userId})
+		grants := rolesDAO.New(rolesDAO.WithGrants(rolesEntity.Grant{ListId: 1, UserId: 1, Role: rolesEntity.RoleOwner}))
+		authorizedSvc = service.Authorize(mockService, policy.New(policy.WithGrants(grants)))
+		mockService.EXPECT().GetReport(userCtx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, request model.GetTimeReportRequest) (model.GetTimeReportResponse, error) {
+				Expect(request.Readable(0)).To(BeTrue())
+				Expect(request.Readable(1)).To(BeFalse())
+				return model.GetTimeReportResponse{GroupBy: request.GroupBy}, nil
+			})
+
+		Expect(authorizedSvc.GetReport(userCtx, model.GetTimeReportRequest{GroupBy: model.GroupByList})).
+			To(HaveField("GroupBy", model.GroupByList))
+	})
+
+})
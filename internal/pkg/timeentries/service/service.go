@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tasksDAO "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+)
+
+type Service interface {
+	GetByTaskId(ctx context.Context, taskId int) ([]model.GetTimeEntryResponse, error)
+	Start(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error)
+	Stop(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error)
+	Add(ctx context.Context, taskId int, request model.AddTimeEntryRequest) (model.GetTimeEntryResponse, error)
+	RemoveById(ctx context.Context, taskId int, id int) error
+	GetReport(ctx context.Context, request model.GetTimeReportRequest) (model.GetTimeReportResponse, error)
+	RemoveTask(ctx context.Context, taskId int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	tasks      tasksDAO.Repository
+	clock      stubs.Clock
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{
+		clock: stubs.New(),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithTasks(tasks tasksDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.tasks = tasks
+		}
+	}
+}
+
+func WithClock(clock stubs.Clock) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil && clock != nil {
+			service.clock = clock
+		}
+	}
+}
+
+func (service *serviceImpl) GetByTaskId(ctx context.Context, taskId int) ([]model.GetTimeEntryResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return nil, err
+	}
+
+	entries, err := service.repository.GetByTaskId(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := service.clock.Now()
+	var dtos []model.GetTimeEntryResponse
+	for _, entry := range entries {
+		dtos = append(dtos, model.EntityToGetTimeEntryResponse(entry, now))
+	}
+	return dtos, nil
+}
+
+func (service *serviceImpl) Start(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+
+	now := service.clock.Now()
+	entry, err := service.repository.StartIfIdle(ctx, entity.TimeEntry{TaskId: taskId, StartedAt: now})
+	if err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return model.EntityToGetTimeEntryResponse(entry, now), nil
+}
+
+func (service *serviceImpl) Stop(ctx context.Context, taskId int) (model.GetTimeEntryResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+
+	entry, err := service.getRunning(ctx, taskId)
+	if err == errors.ErrNotFound {
+		return model.GetTimeEntryResponse{}, errors.ErrConflict
+	}
+	if err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+
+	now := service.clock.Now()
+	entry.EndedAt = &now
+	entry, err = service.repository.Update(ctx, entry)
+	if err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return model.EntityToGetTimeEntryResponse(entry, now), nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, taskId int, request model.AddTimeEntryRequest) (model.GetTimeEntryResponse, error) {
+	if err := service.checkTask(ctx, taskId); err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+
+	now := service.clock.Now()
+	entry, err := service.repository.Insert(ctx, request.ToEntity(taskId, now))
+	if err != nil {
+		return model.GetTimeEntryResponse{}, err
+	}
+	return model.EntityToGetTimeEntryResponse(entry, now), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, taskId int, id int) error {
+	entry, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if entry.TaskId != taskId {
+		return errors.ErrNotFound
+	}
+
+	_, err = service.repository.RemoveById(ctx, id)
+	return err
+}
+
+func (service *serviceImpl) GetReport(ctx context.Context, request model.GetTimeReportRequest) (model.GetTimeReportResponse, error) {
+	tasks, err := service.tasks.GetAll(ctx)
+	if err != nil {
+		return model.GetTimeReportResponse{}, err
+	}
+
+	entries, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return model.GetTimeReportResponse{}, err
+	}
+
+	groups := map[int]int{}
+	estimates := map[int]int{}
+	for _, task := range tasks {
+		if request.Readable != nil && !request.Readable(task.ListId) {
+			continue
+		}
+		group := groupOf(task, request.GroupBy)
+		groups[task.Id] = group
+		estimates[group] += task.Estimate
+	}
+
+	now := service.clock.Now()
+	logged := map[int]time.Duration{}
+	for _, entry := range entries {
+		group, found := groups[entry.TaskId]
+		if !found {
+			continue
+		}
+		if duration := overlap(entry, request.From, request.To, now); duration > 0 {
+			logged[group] += duration
+		}
+	}
+
+	dto := model.GetTimeReportResponse{
+		GroupBy: request.GroupBy,
+		From:    request.From,
+		To:      request.To,
+		Rows:    []model.TimeReportRow{},
+	}
+
+	for group, estimate := range estimates {
+		if estimate == 0 && logged[group] == 0 {
+			continue
+		}
+
+		row := model.TimeReportRow{
+			Id:       group,
+			Estimate: estimate,
+			Logged:   model.ToMinutes(logged[group]),
+		}
+		dto.Rows = append(dto.Rows, row)
+		dto.Total.Estimate += row.Estimate
+		dto.Total.Logged += row.Logged
+	}
+
+	sort.Slice(dto.Rows, func(i, j int) bool {
+		return dto.Rows[i].Id < dto.Rows[j].Id
+	})
+	return dto, nil
+}
+
+func (service *serviceImpl) RemoveTask(ctx context.Context, taskId int) error {
+	return service.repository.RemoveByTaskId(ctx, taskId)
+}
+
+func (service *serviceImpl) getRunning(ctx context.Context, taskId int) (entity.TimeEntry, error) {
+	entries, err := service.repository.GetByTaskId(ctx, taskId)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsRunning() {
+			return entry, nil
+		}
+	}
+	return entity.TimeEntry{}, errors.ErrNotFound
+}
+
+func (service *serviceImpl) checkTask(ctx context.Context, taskId int) error {
+	if service.tasks == nil {
+		return nil
+	}
+
+	_, err := service.tasks.GetById(ctx, taskId)
+	return err
+}
+
+func groupOf(task tasksEntity.Task, groupBy string) int {
+	switch groupBy {
+	case model.GroupByList:
+		return task.ListId
+	case model.GroupByStatus:
+		return task.StatusId
+	}
+	return task.Id
+}
+
+func overlap(entry entity.TimeEntry, from *time.Time, to *time.Time, now time.Time) time.Duration {
+	start, end := entry.StartedAt, now
+	if entry.EndedAt != nil {
+		end = *entry.EndedAt
+	}
+
+	if from != nil && start.Before(*from) {
+		start = *from
+	}
+	if to != nil && end.After(*to) {
+		end = *to
+	}
+	return end.Sub(start)
+}
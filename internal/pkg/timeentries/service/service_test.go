@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	stubs "github.com/aeon-fruit/dalil.git/internal/pkg/stub/time"
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/timeentries/service"
+	tasksDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/tasks/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	const taskId = 1
+
+	var (
+		mockCtrl       *gomock.Controller
+		mockTasks      *tasksDaoMock.MockRepository
+		repository     dao.Repository
+		clock          *stubs.FixedClock
+		timeEntriesSvc service.Service
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTasks = tasksDaoMock.NewMockRepository(mockCtrl)
+		mockTasks.EXPECT().GetById(gomock.Any(), taskId).Return(tasksEntity.Task{Id: taskId}, nil).AnyTimes()
+
+		repository = dao.New()
+		clock = stubs.NewFixed(time.Date(2023, 3, 12, 18, 0, 0, 0, time.UTC))
+		timeEntriesSvc = service.New(
+			service.WithRepository(repository),
+			service.WithTasks(mockTasks),
+			service.WithClock(clock),
+		)
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("Start", func() {
+		It("returns ErrNotFound for an unknown task", func() {
+			mockTasks.EXPECT().GetById(gomock.Any(), 2).Return(tasksEntity.Task{}, errors.ErrNotFound)
+
+			Expect(timeEntriesSvc.Start(ctx, 2)).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("starts a running entry now", func() {
+			dto, err := timeEntriesSvc.Start(ctx, taskId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Running).To(BeTrue())
+			Expect(dto.StartedAt).To(Equal(clock.Now()))
+		})
+
+		It("returns ErrConflict when a timer is already running on the task", func() {
+			_, _ = timeEntriesSvc.Start(ctx, taskId)
+
+			Expect(timeEntriesSvc.Start(ctx, taskId)).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("Stop", func() {
+		It("returns ErrConflict when no timer is running on the task", func() {
+			Expect(timeEntriesSvc.Stop(ctx, taskId)).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("ends the running entry now", func() {
+			_, _ = timeEntriesSvc.Start(ctx, taskId)
+			clock.Set(clock.Now().Add(25 * time.Minute))
+
+			dto, err := timeEntriesSvc.Stop(ctx, taskId)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Running).To(BeFalse())
+			Expect(dto.Minutes).To(Equal(25))
+			Expect(timeEntriesSvc.Start(ctx, taskId)).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Add", func() {
+		It("logs a manual entry", func() {
+			dto, err := timeEntriesSvc.Add(ctx, taskId, model.AddTimeEntryRequest{Minutes: 30, Note: "review"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Minutes).To(Equal(30))
+			Expect(timeEntriesSvc.GetByTaskId(ctx, taskId)).To(HaveExactElements(HaveField("Note", "review")))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns ErrNotFound for an entry of another task", func() {
+			dto, _ := timeEntriesSvc.Add(ctx, taskId, model.AddTimeEntryRequest{Minutes: 30})
+
+			Expect(timeEntriesSvc.RemoveById(ctx, 2, dto.Id)).To(Equal(errors.ErrNotFound))
+		})
+
+		It("removes the entry", func() {
+			dto, _ := timeEntriesSvc.Add(ctx, taskId, model.AddTimeEntryRequest{Minutes: 30})
+
+			Expect(timeEntriesSvc.RemoveById(ctx, taskId, dto.Id)).To(Succeed())
+			Expect(timeEntriesSvc.GetByTaskId(ctx, taskId)).To(BeEmpty())
+		})
+	})
+
+	Describe("GetReport", func() {
+
+		var day time.Time
+
+		BeforeEach(func() {
+			day = time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)
+			mockTasks.EXPECT().GetAll(gomock.Any()).Return([]tasksEntity.Task{
+				{Id: 1, ListId: 1, StatusId: tasksEntity.StatusIdTodo, Estimate: 60},
+				{Id: 2, ListId: 1, StatusId: 2, Estimate: 30},
+				{Id: 3, ListId: 2, StatusId: 2},
+				{Id: 4, ListId: 2, StatusId: 2},
+			}, nil)
+
+			entries := map[int]entity.TimeEntry{}
+			add := func(id int, taskId int, startedAt time.Time, minutes int) {
+				endedAt := startedAt.Add(time.Duration(minutes) * time.Minute)
+				entries[id] = entity.TimeEntry{Id: id, TaskId: taskId, StartedAt: startedAt, EndedAt: &endedAt}
+			}
+			add(0, 1, day.Add(9*time.Hour), 45)
+			add(1, 1, day.Add(24*time.Hour+9*time.Hour), 30)
+			add(2, 3, day.Add(23*time.Hour+30*time.Minute), 60)
+			add(3, 9, day.Add(9*time.Hour), 60)
+			entries[4] = entity.TimeEntry{Id: 4, TaskId: 2, StartedAt: clock.Now().Add(-10 * time.Minute)}
+
+			timeEntriesSvc = service.New(
+				service.WithRepository(dao.New(dao.WithEntries(entries))),
+				service.WithTasks(mockTasks),
+				service.WithClock(clock),
+			)
+		})
+
+		DescribeTable("sums the estimates and the logged time of every group",
+			func(groupBy string, expectedRows []model.TimeReportRow, expectedTotal model.TimeReportTotal) {
+				dto, err := timeEntriesSvc.GetReport(ctx, model.GetTimeReportRequest{GroupBy: groupBy})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.Rows).To(Equal(expectedRows))
+				Expect(dto.Total).To(Equal(expectedTotal))
+			},
+			Entry("per task", model.GroupByTask, []model.TimeReportRow{
+				{Id: 1, Estimate: 60, Logged: 75},
+				{Id: 2, Estimate: 30, Logged: 10},
+				{Id: 3, Logged: 60},
+			}, model.TimeReportTotal{Estimate: 90, Logged: 145}),
+			Entry("per list", model.GroupByList, []model.TimeReportRow{
+				{Id: 1, Estimate: 90, Logged: 85},
+				{Id: 2, Logged: 60},
+			}, model.TimeReportTotal{Estimate: 90, Logged: 145}),
+			Entry("per status", model.GroupByStatus, []model.TimeReportRow{
+				{Id: tasksEntity.StatusIdTodo, Estimate: 60, Logged: 75},
+				{Id: 2, Estimate: 30, Logged: 70},
+			}, model.TimeReportTotal{Estimate: 90, Logged: 145}),
+		)
+
+		It("skips the tasks of the lists that are not readable", func() {
+			readable := func(listId int) bool { return listId != 2 }
+
+			dto, err := timeEntriesSvc.GetReport(ctx, model.GetTimeReportRequest{GroupBy: model.GroupByList, Readable: readable})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Rows).To(Equal([]model.TimeReportRow{{Id: 1, Estimate: 90, Logged: 85}}))
+			Expect(dto.Total).To(Equal(model.TimeReportTotal{Estimate: 90, Logged: 85}))
+		})
+
+		It("only counts the logged time within the date range", func() {
+			from, to := day, day.Add(24*time.Hour)
+
+			dto, err := timeEntriesSvc.GetReport(ctx, model.GetTimeReportRequest{GroupBy: model.GroupByTask, From: &from, To: &to})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dto.Rows).To(Equal([]model.TimeReportRow{
+				{Id: 1, Estimate: 60, Logged: 45},
+				{Id: 2, Estimate: 30},
+				{Id: 3, Logged: 30},
+			}))
+		})
+	})
+
+	Describe("RemoveTask", func() {
+		It("removes the entries of the task", func() {
+			_, _ = timeEntriesSvc.Add(ctx, taskId, model.AddTimeEntryRequest{Minutes: 30})
+
+			Expect(timeEntriesSvc.RemoveTask(ctx, taskId)).To(Succeed())
+			Expect(repository.GetByTaskId(ctx, taskId)).To(BeEmpty())
+		})
+	})
+
+})
@@ -0,0 +1,193 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	chiMiddleware "github.com/go-chi/chi/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/aeon-fruit/dalil.git/internal/pkg/tracing"
+	unmatchedRoute      = "unmatched"
+	defaultFlushTimeout = 5 * time.Second
+)
+
+type Tracing interface {
+	TracerProvider() trace.TracerProvider
+	Middleware(next http.Handler) http.Handler
+	Flush() error
+}
+
+type tracingImpl struct {
+	serviceName  string
+	sampleRatio  float64
+	processors   []sdktrace.SpanProcessor
+	flushTimeout time.Duration
+	provider     *sdktrace.TracerProvider
+	propagator   propagation.TextMapPropagator
+	tracer       trace.Tracer
+}
+
+type TracingOption func(*tracingImpl)
+
+func New(options ...TracingOption) Tracing {
+	instance := tracingImpl{
+		serviceName:  constants.AppName,
+		sampleRatio:  1,
+		flushTimeout: defaultFlushTimeout,
+		propagator:   propagation.TraceContext{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	providerOptions := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(instance.serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(instance.sampleRatio))),
+	}
+	for _, processor := range instance.processors {
+		providerOptions = append(providerOptions, sdktrace.WithSpanProcessor(processor))
+	}
+
+	instance.provider = sdktrace.NewTracerProvider(providerOptions...)
+	instance.tracer = instance.provider.Tracer(instrumentationName)
+
+	return &instance
+}
+
+func WithServiceName(serviceName string) TracingOption {
+	return func(tracing *tracingImpl) {
+		if tracing != nil && serviceName != "" {
+			tracing.serviceName = serviceName
+		}
+	}
+}
+
+func WithSampleRatio(sampleRatio float64) TracingOption {
+	return func(tracing *tracingImpl) {
+		if tracing != nil && sampleRatio >= 0 && sampleRatio <= 1 {
+			tracing.sampleRatio = sampleRatio
+		}
+	}
+}
+
+func WithExporter(exporter sdktrace.SpanExporter) TracingOption {
+	return func(tracing *tracingImpl) {
+		if tracing != nil && exporter != nil {
+			tracing.processors = append(tracing.processors, sdktrace.NewBatchSpanProcessor(exporter))
+		}
+	}
+}
+
+func WithSpanProcessor(processor sdktrace.SpanProcessor) TracingOption {
+	return func(tracing *tracingImpl) {
+		if tracing != nil && processor != nil {
+			tracing.processors = append(tracing.processors, processor)
+		}
+	}
+}
+
+func WithFlushTimeout(flushTimeout time.Duration) TracingOption {
+	return func(tracing *tracingImpl) {
+		if tracing != nil && flushTimeout > 0 {
+			tracing.flushTimeout = flushTimeout
+		}
+	}
+}
+
+func NewStdoutExporter(writer io.Writer) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(writer))
+}
+
+func NewOtlpExporter(ctx context.Context, endpoint string) (sdktrace.SpanExporter, error) {
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpointUrl.Host)}
+	if endpointUrl.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	if endpointUrl.Path != "" && endpointUrl.Path != "/" {
+		options = append(options, otlptracehttp.WithURLPath(endpointUrl.Path))
+	}
+
+	return otlptracehttp.New(ctx, options...)
+}
+
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (tracing *tracingImpl) TracerProvider() trace.TracerProvider {
+	return tracing.provider
+}
+
+func (tracing *tracingImpl) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := tracing.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.tracer.Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPMethod(r.Method), semconv.HTTPTarget(r.URL.RequestURI())))
+
+		spanContext := span.SpanContext()
+		if logger, err := logr.FromContext(ctx); err == nil {
+			ctx = logr.NewContext(ctx, logger.WithValues(
+				"trace_id", spanContext.TraceID().String(),
+				"span_id", spanContext.SpanID().String()))
+		}
+
+		ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			route := unmatchedRoute
+			if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+				route = routeCtx.RoutePattern()
+			}
+
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			span.End()
+		}()
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+	})
+}
+
+func (tracing *tracingImpl) Flush() error {
+	ctx, cancel := context.WithTimeout(context.Background(), tracing.flushTimeout)
+	defer cancel()
+
+	return tracing.provider.Shutdown(ctx)
+}
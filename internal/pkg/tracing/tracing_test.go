@@ -0,0 +1,191 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+
+	ctx := context.Background()
+
+	var (
+		recorder *tracetest.SpanRecorder
+		instance tracing.Tracing
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		instance = tracing.New(tracing.WithSpanProcessor(recorder))
+	})
+
+	Describe("Middleware", func() {
+		var (
+			router chi.Router
+			logs   []string
+		)
+
+		BeforeEach(func() {
+			logs = nil
+			logger := funcr.New(func(prefix, args string) {
+				logs = append(logs, args)
+			}, funcr.Options{})
+
+			router = chi.NewRouter()
+			router.Use(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					next.ServeHTTP(w, r.WithContext(logr.NewContext(r.Context(), logger)))
+				})
+			})
+			router.Use(instance.Middleware)
+			router.Get("/tasks/{id}", func(w http.ResponseWriter, r *http.Request) {
+				logr.FromContextOrDiscard(r.Context()).Info("handled")
+				switch chi.URLParam(r, "id") {
+				case "0":
+					w.WriteHeader(http.StatusNotFound)
+				case "500":
+					w.WriteHeader(http.StatusInternalServerError)
+				default:
+					_, _ = w.Write([]byte("{}"))
+				}
+			})
+		})
+
+		serve := func(request *http.Request) {
+			router.ServeHTTP(httptest.NewRecorder(), request)
+		}
+
+		It("records a server span named after the route pattern", func() {
+			serve(httptest.NewRequest(http.MethodGet, "/tasks/1", nil))
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("GET /tasks/{id}"))
+			Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindServer))
+			Expect(spans[0].Attributes()).To(ContainElements(
+				semconv.HTTPMethod(http.MethodGet),
+				semconv.HTTPRoute("/tasks/{id}"),
+				semconv.HTTPStatusCode(http.StatusOK),
+			))
+			Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+		})
+
+		It("groups the requests matching no route", func() {
+			serve(httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+			Expect(recorder.Ended()).To(ConsistOf(HaveField("Name()", "GET unmatched")))
+		})
+
+		It("marks the server errors only", func() {
+			serve(httptest.NewRequest(http.MethodGet, "/tasks/0", nil))
+			serve(httptest.NewRequest(http.MethodGet, "/tasks/500", nil))
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+			Expect(spans[1].Status().Code).To(Equal(codes.Error))
+		})
+
+		It("continues the trace of the W3C traceparent header", func() {
+			request := httptest.NewRequest(http.MethodGet, "/tasks/1", nil)
+			request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+			serve(request)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+			Expect(spans[0].Parent().IsRemote()).To(BeTrue())
+		})
+
+		It("adds the trace and span ids to the logger in context", func() {
+			serve(httptest.NewRequest(http.MethodGet, "/tasks/1", nil))
+
+			spanContext := recorder.Ended()[0].SpanContext()
+			Expect(logs).To(ConsistOf(And(
+				ContainSubstring(fmt.Sprintf(`"trace_id"="%v"`, spanContext.TraceID())),
+				ContainSubstring(fmt.Sprintf(`"span_id"="%v"`, spanContext.SpanID())),
+			)))
+		})
+	})
+
+	Describe("WithSampleRatio", func() {
+		It("does not record the spans when the ratio is zero", func() {
+			instance = tracing.New(tracing.WithSpanProcessor(recorder), tracing.WithSampleRatio(0))
+
+			_, span := instance.TracerProvider().Tracer("test").Start(ctx, "operation")
+			span.End()
+
+			Expect(recorder.Ended()).To(BeEmpty())
+		})
+	})
+
+	Describe("End", func() {
+		It("records the error of the operation", func() {
+			_, span := instance.TracerProvider().Tracer("test").Start(ctx, "operation")
+
+			tracing.End(span, errors.ErrNotFound)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: errors.ErrNotFound.Error()}))
+			Expect(spans[0].Events()).To(ContainElement(HaveField("Name", "exception")))
+		})
+
+		It("leaves the status unset on success", func() {
+			_, span := instance.TracerProvider().Tracer("test").Start(ctx, "operation")
+
+			tracing.End(span, nil)
+
+			Expect(recorder.Ended()).To(ConsistOf(HaveField("Status().Code", codes.Unset)))
+		})
+	})
+
+	Describe("Flush", func() {
+		It("exports the pending spans to stdout", func() {
+			output := &bytes.Buffer{}
+			exporter, err := tracing.NewStdoutExporter(output)
+			Expect(err).NotTo(HaveOccurred())
+			instance = tracing.New(tracing.WithExporter(exporter), tracing.WithServiceName("dalil-test"))
+
+			_, span := instance.TracerProvider().Tracer("test").Start(ctx, "operation",
+				trace.WithAttributes(attribute.Int("task.id", 1)))
+			span.End()
+			Expect(output.String()).To(BeEmpty())
+
+			Expect(instance.Flush()).To(Succeed())
+
+			Expect(output.String()).To(ContainSubstring(`"Name":"operation"`))
+			Expect(output.String()).To(ContainSubstring(`"Value":"dalil-test"`))
+		})
+	})
+
+	Describe("NewOtlpExporter", func() {
+		It("accepts a collector URL", func() {
+			Expect(tracing.NewOtlpExporter(ctx, "http://localhost:4318/v1/traces")).NotTo(BeNil())
+		})
+
+		It("rejects an invalid URL", func() {
+			Expect(tracing.NewOtlpExporter(ctx, "http://[::1")).Error().To(HaveOccurred())
+		})
+	})
+})
@@ -15,10 +15,24 @@ func ParsePathParam(r *http.Request, key string) string {
 }
 
 func ParseQueryParam(r *http.Request, key string) string {
+	if r.URL == nil {
+		return ""
+	}
 	return r.URL.Query().Get(key)
 }
 
+func ParseQueryParams(r *http.Request, key string) []string {
+	if r.URL == nil {
+		return nil
+	}
+	return r.URL.Query()[key]
+}
+
 func ParseQueryFlag(r *http.Request, key string) bool {
+	if r.URL == nil {
+		return false
+	}
+
 	query := r.URL.Query()
 	if !query.Has(key) {
 		return false
@@ -70,6 +70,40 @@ var _ = Describe("URLParams", func() {
 			})
 		})
 
+		When("the request has no URL", func() {
+			It("returns an empty string", func() {
+				Expect(urlparams.ParseQueryParam(&http.Request{}, key)).To(BeEmpty())
+			})
+		})
+
+	})
+
+	Describe("ParseQueryParams", func() {
+
+		var request *http.Request
+
+		BeforeEach(func() {
+			request = httptest.NewRequest("", "http://url?id=one&id=two", strings.NewReader(""))
+		})
+
+		When("the param is not found", func() {
+			It("returns nil", func() {
+				Expect(urlparams.ParseQueryParams(request, key+"-suffix")).To(BeNil())
+			})
+		})
+
+		When("the param is found", func() {
+			It("returns all its values", func() {
+				Expect(urlparams.ParseQueryParams(request, key)).To(Equal([]string{"one", "two"}))
+			})
+		})
+
+		When("the request has no URL", func() {
+			It("returns nil", func() {
+				Expect(urlparams.ParseQueryParams(&http.Request{}, key)).To(BeNil())
+			})
+		})
+
 	})
 
 	Describe("ParseQueryFlag", func() {
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/controller/common"
+	errorModel "github.com/aeon-fruit/dalil.git/internal/pkg/model/error"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/model/marshaller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/urlparams"
+	model "github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+	service "github.com/aeon-fruit/dalil.git/internal/pkg/users/service"
+	"github.com/go-logr/logr"
+)
+
+const (
+	getByIdFailed      = "GetById failed"
+	getByIdResponse    = "GetById response"
+	getAllFailed       = "GetAll failed"
+	getAllResponse     = "GetAll response"
+	addFailed          = "Add failed"
+	addResponse        = "Add response"
+	deactivateFailed   = "Deactivate failed"
+	deactivateResponse = "Deactivate response"
+
+	duplicateUsername = "The username is already taken"
+)
+
+type Controller interface {
+	GetById(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
+	Add(w http.ResponseWriter, r *http.Request)
+	Deactivate(w http.ResponseWriter, r *http.Request)
+}
+
+type controllerImpl struct {
+	service service.Service
+}
+
+type ControllerOption func(*controllerImpl)
+
+func New(options ...ControllerOption) Controller {
+	instance := controllerImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithService(service service.Service) ControllerOption {
+	return func(controller *controllerImpl) {
+		if controller != nil {
+			controller.service = service
+		}
+	}
+}
+
+func (ctrl *controllerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.UserId)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.GetById(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			logger.Error(err, getByIdFailed, constants.UserId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(getByIdResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	request := model.GetUsersRequest{
+		IncludeInactive: urlparams.ParseQueryFlag(r, constants.IncludeInactive),
+	}
+
+	entity, err := ctrl.service.GetAll(r.Context(), request)
+	if err != nil {
+		logger.Error(err, getAllFailed)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	if len(entity) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logger.V(1).Info(getAllResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Add(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	request, stop := common.GetRequestOrStop[model.AddUserRequest](w, r, addFailed)
+	if stop {
+		return
+	}
+
+	if !request.IsValid() {
+		logger.Error(errors.ErrInvalidArgument, addFailed, constants.Payload, request)
+		_ = marshaller.SerializeError(w, errorModel.New(http.StatusBadRequest, "Invalid request content"))
+		return
+	}
+
+	entity, err := ctrl.service.Add(r.Context(), request)
+	if err != nil {
+		if err == errors.ErrConflict {
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusConflict, duplicateUsername))
+		} else {
+			logger.Error(err, addFailed)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	location := fmt.Sprintf("%s/%d", r.Host, entity.Id)
+	logger.V(1).Info("Added entity location", constants.Location, location)
+	logger.V(1).Info(addResponse, constants.Payload, entity)
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	_ = marshaller.SerializeEntity(w, entity)
+}
+
+func (ctrl *controllerImpl) Deactivate(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+
+	id, stop := common.GetPathParamOrStop(w, r, constants.UserId)
+	if stop {
+		return
+	}
+
+	entity, err := ctrl.service.Deactivate(r.Context(), id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else if err == errors.ErrNotModified {
+			w.WriteHeader(http.StatusNotModified)
+		} else {
+			logger.Error(err, deactivateFailed, constants.UserId, id)
+			_ = marshaller.SerializeError(w, errorModel.New(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	logger.V(1).Info(deactivateResponse, constants.Payload, entity)
+
+	_ = marshaller.SerializeEntity(w, entity)
+}
@@ -0,0 +1,131 @@
+package controller_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/users/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		usersCtrl   controller.Controller
+	)
+
+	withUserId := func(request *http.Request, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), constants.UserId, value))
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		usersCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("responds with status NoContent when there are no users", func() {
+			mockService.EXPECT().GetAll(gomock.Any(), model.GetUsersRequest{}).Return(nil, nil)
+
+			usersCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("forwards the includeInactive flag", func() {
+			mockService.EXPECT().GetAll(gomock.Any(), model.GetUsersRequest{IncludeInactive: true}).
+				Return([]model.GetUserResponse{{Id: 1, Username: "jane"}}, nil)
+
+			usersCtrl.GetAll(recorder, httptest.NewRequest("", url+"?includeInactive", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"username":"jane"`))
+		})
+	})
+
+	Describe("GetById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().GetById(gomock.Any(), 1).Return(model.GetUserResponse{}, err)
+
+				usersCtrl.GetById(recorder, withUserId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("found", nil, http.StatusOK),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("Add", func() {
+		It("responds with status BadRequest when the email is invalid", func() {
+			usersCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"username":"jane","email":"jane"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("responds with status Created and the user in the payload", func() {
+			mockService.EXPECT().Add(gomock.Any(), model.AddUserRequest{Username: "jane"}).
+				Return(model.GetUserResponse{Id: 2, Username: "jane", Active: true}, nil)
+
+			usersCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"username":"jane"}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/2"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"active":true`))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Add(gomock.Any(), gomock.Any()).Return(model.GetUserResponse{}, err)
+
+				usersCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"username":"jane"}`)))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("duplicate username", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+	Describe("Deactivate", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Deactivate(gomock.Any(), 1).Return(model.GetUserResponse{}, err)
+
+				usersCtrl.Deactivate(recorder, withUserId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("deactivated", nil, http.StatusOK),
+			Entry("already inactive", errors.ErrNotModified, http.StatusNotModified),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+	})
+
+})
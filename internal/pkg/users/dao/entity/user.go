@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type User struct {
+	Id            int        `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	Username      string     `json:"username" gorm:"column:username;type:varchar;size:255;uniqueIndex"`
+	Name          string     `json:"name,omitempty" gorm:"column:name;type:varchar;size:255"`
+	Email         string     `json:"email,omitempty" gorm:"column:email;type:varchar;size:255"`
+	CreatedAt     time.Time  `json:"createdAt" gorm:"column:created_at;type:timestamp"`
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty" gorm:"column:deactivated_at;type:timestamp"`
+	Tenant        string     `json:"tenant,omitempty" gorm:"column:tenant;type:varchar;size:63;index"`
+}
+
+func (user User) IsActive() bool {
+	return user.DeactivatedAt == nil
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/persistence"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.User, error)
+	GetByUsername(ctx context.Context, username string) (entity.User, error)
+	GetAll(ctx context.Context) ([]entity.User, error)
+	Insert(ctx context.Context, user entity.User) (entity.User, error)
+	Deactivate(ctx context.Context, id int, deactivatedAt time.Time) (entity.User, error)
+	Flush() error
+}
+
+type snapshot struct {
+	Seq   int            `json:"seq,omitempty"`
+	Seqs  map[string]int `json:"seqs"`
+	Users []entity.User  `json:"users"`
+}
+
+type memoryRepository struct {
+	mutex sync.RWMutex
+	users map[string]map[int]entity.User
+	seqs  map[string]int
+	path  string
+	err   error
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		users: map[string]map[int]entity.User{},
+		seqs:  map[string]int{},
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	if instance.path != "" {
+		instance.err = instance.load()
+	}
+
+	return &instance
+}
+
+func WithFile(path string) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil {
+			repository.path = path
+		}
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.User, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return entity.User{}, repo.err
+	}
+
+	user, found := repo.users[tenancy.Of(ctx)][id]
+	if !found {
+		return entity.User{}, errors.ErrNotFound
+	}
+	return user, nil
+}
+
+func (repo *memoryRepository) GetByUsername(ctx context.Context, username string) (entity.User, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return entity.User{}, repo.err
+	}
+
+	for _, user := range repo.users[tenancy.Of(ctx)] {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return entity.User{}, errors.ErrNotFound
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.User, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return nil, repo.err
+	}
+
+	return repo.sorted(tenancy.Of(ctx)), nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, user entity.User) (entity.User, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.User{}, repo.err
+	}
+
+	tenant := tenancy.Of(ctx)
+	users := repo.partition(tenant)
+	for _, other := range users {
+		if other.Username == user.Username {
+			return entity.User{}, errors.ErrConflict
+		}
+	}
+
+	user.Id = repo.seqs[tenant]
+	user.Tenant = tenant
+	user.CreatedAt = time.Now()
+	users[user.Id] = user
+	repo.seqs[tenant]++
+	if err := repo.save(); err != nil {
+		delete(users, user.Id)
+		repo.seqs[tenant]--
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+func (repo *memoryRepository) Deactivate(ctx context.Context, id int, deactivatedAt time.Time) (entity.User, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.err != nil {
+		return entity.User{}, repo.err
+	}
+
+	users := repo.users[tenancy.Of(ctx)]
+	oldUser, found := users[id]
+	if !found {
+		return entity.User{}, errors.ErrNotFound
+	}
+	if !oldUser.IsActive() {
+		return entity.User{}, errors.ErrNotModified
+	}
+
+	user := oldUser
+	user.DeactivatedAt = &deactivatedAt
+	users[id] = user
+	if err := repo.save(); err != nil {
+		users[id] = oldUser
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+func (repo *memoryRepository) Flush() error {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	if repo.err != nil {
+		return repo.err
+	}
+
+	return repo.save()
+}
+
+func (repo *memoryRepository) partition(tenant string) map[int]entity.User {
+	users, found := repo.users[tenant]
+	if !found {
+		users = map[int]entity.User{}
+		repo.users[tenant] = users
+	}
+	return users
+}
+
+func (repo *memoryRepository) sorted(tenants ...string) []entity.User {
+	var users []entity.User
+	for _, tenant := range tenants {
+		for _, user := range repo.users[tenant] {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Tenant != users[j].Tenant {
+			return users[i].Tenant < users[j].Tenant
+		}
+		return users[i].Id < users[j].Id
+	})
+	return users
+}
+
+func (repo *memoryRepository) load() error {
+	state := snapshot{}
+	err := persistence.ReadJSON(repo.path, &state)
+	if err == errors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for tenant, seq := range state.Seqs {
+		repo.seqs[tenant] = seq
+	}
+	if state.Seqs == nil {
+		repo.seqs[tenancy.DefaultTenant] = state.Seq
+	}
+	for _, user := range state.Users {
+		if user.Tenant == "" {
+			user.Tenant = tenancy.DefaultTenant
+		}
+		repo.partition(user.Tenant)[user.Id] = user
+		if user.Id >= repo.seqs[user.Tenant] {
+			repo.seqs[user.Tenant] = user.Id + 1
+		}
+	}
+	return nil
+}
+
+func (repo *memoryRepository) save() error {
+	if repo.path == "" {
+		return nil
+	}
+
+	var tenants []string
+	for tenant := range repo.users {
+		tenants = append(tenants, tenant)
+	}
+	return persistence.WriteJSON(repo.path, snapshot{
+		Seqs:  repo.seqs,
+		Users: repo.sorted(tenants...),
+	})
+}
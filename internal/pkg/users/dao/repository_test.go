@@ -0,0 +1,190 @@
+package repository_test
+
+import (
+	"context"
+
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	user := func(username string) entity.User {
+		return entity.User{Username: username, Name: "Jane Doe", Email: username + "@example.com"}
+	}
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(repository.New()).NotTo(BeNil())
+		})
+	})
+
+	Context("in memory", func() {
+		var repo repository.Repository
+
+		BeforeEach(func() {
+			repo = repository.New()
+		})
+
+		It("assigns sequential ids and the creation date on insert", func() {
+			first, err := repo.Insert(ctx, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(ctx, user("john"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first.Id).To(Equal(0))
+			Expect(second.Id).To(Equal(1))
+			Expect(first.CreatedAt).NotTo(BeZero())
+			Expect(first.IsActive()).To(BeTrue())
+			Expect(repo.GetAll(ctx)).To(Equal([]entity.User{first, second}))
+		})
+
+		It("rejects a duplicate username", func() {
+			_, err := repo.Insert(ctx, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.Insert(ctx, user("jane"))).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("finds the users by id and by username", func() {
+			inserted, _ := repo.Insert(ctx, user("jane"))
+
+			Expect(repo.GetById(ctx, inserted.Id)).To(Equal(inserted))
+			Expect(repo.GetByUsername(ctx, "jane")).To(Equal(inserted))
+			Expect(repo.GetById(ctx, 10)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByUsername(ctx, "john")).Error().To(Equal(errors.ErrNotFound))
+		})
+
+		It("deactivates existing active users only", func() {
+			inserted, _ := repo.Insert(ctx, user("jane"))
+			deactivatedAt := time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+
+			deactivated, err := repo.Deactivate(ctx, inserted.Id, deactivatedAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deactivated.IsActive()).To(BeFalse())
+			Expect(repo.GetById(ctx, inserted.Id)).To(HaveField("DeactivatedAt", HaveValue(Equal(deactivatedAt))))
+			Expect(repo.Deactivate(ctx, inserted.Id, time.Now())).Error().To(Equal(errors.ErrNotModified))
+			Expect(repo.Deactivate(ctx, 10, time.Now())).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Context("backed by a file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "users.json")
+		})
+
+		It("survives a restart", func() {
+			repo := repository.New(repository.WithFile(path))
+			first, err := repo.Insert(ctx, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = repo.Insert(ctx, user("john"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = repo.Deactivate(ctx, first.Id, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			restarted := repository.New(repository.WithFile(path))
+
+			Expect(restarted.GetAll(ctx)).To(HaveExactElements(
+				HaveField("DeactivatedAt", Not(BeNil())),
+				HaveField("Username", "john"),
+			))
+			Expect(restarted.Insert(ctx, user("jack"))).To(HaveField("Id", 2))
+			Expect(restarted.Flush()).To(Succeed())
+		})
+
+		When("the file cannot be loaded", func() {
+			It("fails every operation", func() {
+				Expect(os.WriteFile(path, []byte("not json"), 0o644)).To(Succeed())
+
+				repo := repository.New(repository.WithFile(path))
+
+				Expect(repo.GetAll(ctx)).Error().To(HaveOccurred())
+				Expect(repo.GetById(ctx, 0)).Error().To(HaveOccurred())
+				Expect(repo.GetByUsername(ctx, "jane")).Error().To(HaveOccurred())
+				Expect(repo.Insert(ctx, user("jane"))).Error().To(HaveOccurred())
+				Expect(repo.Deactivate(ctx, 0, time.Now())).Error().To(HaveOccurred())
+				Expect(repo.Flush()).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("Tenants", func() {
+		acme := tenancy.With(ctx, "acme")
+
+		It("stamps the users with the tenant of the context", func() {
+			repo := repository.New()
+
+			Expect(repo.Insert(acme, user("jane"))).To(HaveField("Tenant", "acme"))
+			Expect(repo.Insert(ctx, user("john"))).To(HaveField("Tenant", tenancy.DefaultTenant))
+		})
+
+		It("keeps the users of each tenant apart", func() {
+			repo := repository.New()
+			jane, _ := repo.Insert(acme, user("jane"))
+			globex := tenancy.With(ctx, "globex")
+
+			Expect(repo.GetById(globex, jane.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByUsername(globex, "jane")).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetByUsername(ctx, "jane")).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.GetAll(globex)).To(BeEmpty())
+			Expect(repo.Deactivate(globex, jane.Id, time.Now())).Error().To(Equal(errors.ErrNotFound))
+
+			Expect(repo.GetByUsername(acme, "jane")).To(Equal(jane))
+			Expect(repo.GetAll(acme)).To(HaveExactElements(jane))
+		})
+
+		It("scopes the usernames and the ids to the tenant", func() {
+			repo := repository.New()
+			globex := tenancy.With(ctx, "globex")
+
+			first, err := repo.Insert(acme, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+			second, err := repo.Insert(acme, user("john"))
+			Expect(err).NotTo(HaveOccurred())
+			other, err := repo.Insert(globex, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect([]int{first.Id, second.Id, other.Id}).To(Equal([]int{0, 1, 0}))
+			Expect(repo.GetById(globex, 0)).To(Equal(other))
+			Expect(repo.GetById(acme, 0)).To(Equal(first))
+		})
+
+		It("keeps the ids of each tenant across a restart", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "users.json")
+			repo := repository.New(repository.WithFile(path))
+			_, err := repo.Insert(acme, user("jane"))
+			Expect(err).NotTo(HaveOccurred())
+
+			restarted := repository.New(repository.WithFile(path))
+
+			Expect(restarted.Insert(acme, user("john"))).To(HaveField("Id", 1))
+			Expect(restarted.Insert(ctx, user("john"))).To(HaveField("Id", 0))
+		})
+
+		It("loads the snapshots with a single sequence into the default tenant", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "users.json")
+			legacy := `{"seq":3,"users":[{"id":0,"username":"jane"},{"id":2,"username":"john","tenant":"acme"}]}`
+			Expect(os.WriteFile(path, []byte(legacy), 0o644)).To(Succeed())
+
+			repo := repository.New(repository.WithFile(path))
+
+			Expect(repo.GetByUsername(ctx, "jane")).To(HaveField("Tenant", tenancy.DefaultTenant))
+			Expect(repo.Insert(ctx, user("jim"))).To(HaveField("Id", 3))
+			Expect(repo.Insert(acme, user("jim"))).To(HaveField("Id", 3))
+		})
+	})
+
+})
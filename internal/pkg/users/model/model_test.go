@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+)
+
+var _ = Describe("Model", func() {
+
+	DescribeTable("AddUserRequest.IsValid",
+		func(request model.AddUserRequest, expected bool) {
+			Expect(request.IsValid()).To(Equal(expected))
+		},
+		Entry("a username only", model.AddUserRequest{Username: "jane"}, true),
+		Entry("a full user", model.AddUserRequest{Username: "jane", Name: "Jane Doe", Email: "jane@example.com"}, true),
+		Entry("a blank username", model.AddUserRequest{Username: "  "}, false),
+		Entry("a too long username", model.AddUserRequest{Username: strings.Repeat("a", 256)}, false),
+		Entry("a too long name", model.AddUserRequest{Username: "jane", Name: strings.Repeat("a", 256)}, false),
+		Entry("an invalid email", model.AddUserRequest{Username: "jane", Email: "jane"}, false),
+		Entry("a named email", model.AddUserRequest{Username: "jane", Email: "Jane <jane@example.com>"}, false),
+	)
+
+	Describe("AddUserRequest.ToEntity", func() {
+		It("trims the fields", func() {
+			Expect(model.AddUserRequest{Username: " jane ", Name: " Jane Doe ", Email: " jane@example.com "}.ToEntity()).
+				To(Equal(entity.User{Username: "jane", Name: "Jane Doe", Email: "jane@example.com"}))
+		})
+	})
+
+	Describe("EntityToGetUserResponse", func() {
+		It("reports whether the user is active", func() {
+			deactivatedAt := time.Now()
+
+			Expect(model.EntityToGetUserResponse(entity.User{Id: 1, Username: "jane"})).
+				To(Equal(model.GetUserResponse{Id: 1, Username: "jane", Active: true}))
+			Expect(model.EntityToGetUserResponse(entity.User{Id: 1, Username: "jane", DeactivatedAt: &deactivatedAt})).
+				To(Equal(model.GetUserResponse{Id: 1, Username: "jane", Active: false, DeactivatedAt: &deactivatedAt}))
+		})
+
+		It("exposes the tenant of the user", func() {
+			Expect(model.EntityToGetUserResponse(entity.User{Id: 1, Username: "jane", Tenant: "acme"}).Tenant).To(Equal("acme"))
+		})
+	})
+
+})
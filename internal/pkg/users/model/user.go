@@ -0,0 +1,64 @@
+package model
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+)
+
+type GetUserResponse struct {
+	Id            int        `json:"id"`
+	Username      string     `json:"username"`
+	Name          string     `json:"name,omitempty"`
+	Email         string     `json:"email,omitempty"`
+	Active        bool       `json:"active"`
+	Tenant        string     `json:"tenant,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt,omitempty"`
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+}
+
+func EntityToGetUserResponse(entity entity.User) GetUserResponse {
+	return GetUserResponse{
+		Id:            entity.Id,
+		Username:      entity.Username,
+		Name:          entity.Name,
+		Email:         entity.Email,
+		Active:        entity.IsActive(),
+		Tenant:        entity.Tenant,
+		CreatedAt:     entity.CreatedAt,
+		DeactivatedAt: entity.DeactivatedAt,
+	}
+}
+
+type AddUserRequest struct {
+	Username string `json:"username"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+func (dto AddUserRequest) IsValid() bool {
+	username := strings.TrimSpace(dto.Username)
+	if username == "" || len(username) > 255 || len(dto.Name) > 255 {
+		return false
+	}
+
+	if email := strings.TrimSpace(dto.Email); email != "" {
+		address, err := mail.ParseAddress(email)
+		return err == nil && address.Address == email
+	}
+	return true
+}
+
+func (dto AddUserRequest) ToEntity() entity.User {
+	return entity.User{
+		Username: strings.TrimSpace(dto.Username),
+		Name:     strings.TrimSpace(dto.Name),
+		Email:    strings.TrimSpace(dto.Email),
+	}
+}
+
+type GetUsersRequest struct {
+	IncludeInactive bool
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/users/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetUserResponse, error)
+	GetAll(ctx context.Context, request model.GetUsersRequest) ([]model.GetUserResponse, error)
+	Add(ctx context.Context, request model.AddUserRequest) (model.GetUserResponse, error)
+	Deactivate(ctx context.Context, id int) (model.GetUserResponse, error)
+	Resolve(ctx context.Context, principal reqctx.Principal) (reqctx.Principal, error)
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetUserResponse, error) {
+	user, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetUserResponse{}, err
+	}
+	return model.EntityToGetUserResponse(user), nil
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context, request model.GetUsersRequest) ([]model.GetUserResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetUserResponse
+	for _, user := range entities {
+		if request.IncludeInactive || user.IsActive() {
+			dto = append(dto, model.EntityToGetUserResponse(user))
+		}
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Add(ctx context.Context, request model.AddUserRequest) (model.GetUserResponse, error) {
+	user, err := service.repository.Insert(ctx, request.ToEntity())
+	if err != nil {
+		return model.GetUserResponse{}, err
+	}
+	return model.EntityToGetUserResponse(user), nil
+}
+
+func (service *serviceImpl) Deactivate(ctx context.Context, id int) (model.GetUserResponse, error) {
+	user, err := service.repository.Deactivate(ctx, id, time.Now())
+	if err != nil {
+		return model.GetUserResponse{}, err
+	}
+	return model.EntityToGetUserResponse(user), nil
+}
+
+func (service *serviceImpl) Resolve(ctx context.Context, principal reqctx.Principal) (reqctx.Principal, error) {
+	var user entity.User
+	var err error
+	if principal.Tenant != "" {
+		ctx = tenancy.With(ctx, principal.Tenant)
+	}
+	switch {
+	case principal.UserId != nil:
+		user, err = service.repository.GetById(ctx, *principal.UserId)
+		if err == errors.ErrNotFound {
+			return reqctx.Principal{}, errors.ErrUnauthorized
+		}
+	case principal.Method == reqctx.AuthMethodJwt:
+		user, err = service.repository.GetByUsername(ctx, principal.Id)
+		if err == errors.ErrNotFound {
+			return principal, nil
+		}
+	default:
+		return principal, nil
+	}
+
+	if err != nil {
+		return reqctx.Principal{}, err
+	}
+	if !user.IsActive() {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+	if principal.Tenant != "" && !tenancy.Owns(principal.Tenant, user.Tenant) {
+		return reqctx.Principal{}, errors.ErrUnauthorized
+	}
+
+	principal.UserId = &user.Id
+	if principal.Tenant == "" {
+		principal.Tenant = user.Tenant
+	}
+	return principal, nil
+}
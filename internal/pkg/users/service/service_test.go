@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/users/service"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/users/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	var (
+		customErr      error
+		deactivatedAt  time.Time
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		usersSvc       service.Service
+	)
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		deactivatedAt = time.Date(2023, time.March, 6, 9, 0, 0, 0, time.UTC)
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		usersSvc = service.New(service.WithRepository(mockRepository))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetById", func() {
+		It("returns the user", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(entity.User{Id: 1, Username: "jane"}, nil)
+
+			Expect(usersSvc.GetById(ctx, 1)).To(Equal(model.GetUserResponse{Id: 1, Username: "jane", Active: true}))
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), 1).Return(entity.User{}, errors.ErrNotFound)
+
+			Expect(usersSvc.GetById(ctx, 1)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("GetAll", func() {
+		BeforeEach(func() {
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.User{
+				{Id: 1, Username: "jane"},
+				{Id: 2, Username: "john", DeactivatedAt: &deactivatedAt},
+			}, nil)
+		})
+
+		It("omits the inactive users by default", func() {
+			Expect(usersSvc.GetAll(ctx, model.GetUsersRequest{})).To(HaveExactElements(HaveField("Id", 1)))
+		})
+
+		It("includes the inactive users on demand", func() {
+			Expect(usersSvc.GetAll(ctx, model.GetUsersRequest{IncludeInactive: true})).
+				To(HaveExactElements(HaveField("Id", 1), HaveField("Id", 2)))
+		})
+	})
+
+	Describe("Add", func() {
+		It("inserts the user", func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.User{Username: "jane"}).Return(entity.User{Id: 3, Username: "jane"}, nil)
+
+			Expect(usersSvc.Add(ctx, model.AddUserRequest{Username: " jane "})).To(HaveField("Id", 3))
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(entity.User{}, errors.ErrConflict)
+
+			Expect(usersSvc.Add(ctx, model.AddUserRequest{Username: "jane"})).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("Deactivate", func() {
+		It("deactivates the user", func() {
+			mockRepository.EXPECT().Deactivate(gomock.Any(), 1, gomock.Any()).
+				Return(entity.User{Id: 1, Username: "jane", DeactivatedAt: &deactivatedAt}, nil)
+
+			Expect(usersSvc.Deactivate(ctx, 1)).To(HaveField("Active", false))
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().Deactivate(gomock.Any(), 1, gomock.Any()).Return(entity.User{}, errors.ErrNotModified)
+
+			Expect(usersSvc.Deactivate(ctx, 1)).Error().To(Equal(errors.ErrNotModified))
+		})
+	})
+
+	Describe("Resolve", func() {
+		userId := 1
+
+		It("keeps the principals that are not bound to users", func() {
+			principal := reqctx.Principal{Id: "admin", Method: reqctx.AuthMethodAdminToken, Admin: true}
+
+			Expect(usersSvc.Resolve(ctx, principal)).To(Equal(principal))
+		})
+
+		It("checks the user of a principal bound to one", func() {
+			principal := reqctx.Principal{Id: "3", Method: reqctx.AuthMethodApiKey, UserId: &userId}
+			mockRepository.EXPECT().GetById(gomock.Any(), userId).Return(entity.User{Id: userId, Username: "jane"}, nil)
+
+			Expect(usersSvc.Resolve(ctx, principal)).To(Equal(principal))
+		})
+
+		It("rejects a principal bound to a missing user", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), userId).Return(entity.User{}, errors.ErrNotFound)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{UserId: &userId})).Error().To(Equal(errors.ErrUnauthorized))
+		})
+
+		It("rejects a principal bound to an inactive user", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), userId).
+				Return(entity.User{Id: userId, Username: "jane", DeactivatedAt: &deactivatedAt}, nil)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{UserId: &userId})).Error().To(Equal(errors.ErrUnauthorized))
+		})
+
+		It("binds a token subject to the user with the same username", func() {
+			mockRepository.EXPECT().GetByUsername(gomock.Any(), "jane").Return(entity.User{Id: userId, Username: "jane"}, nil)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{Id: "jane", Method: reqctx.AuthMethodJwt})).
+				To(HaveField("UserId", HaveValue(Equal(userId))))
+		})
+
+		It("binds the principal to the tenant of its user", func() {
+			mockRepository.EXPECT().GetByUsername(gomock.Any(), "jane").Return(entity.User{Id: userId, Username: "jane", Tenant: "acme"}, nil)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{Id: "jane", Method: reqctx.AuthMethodJwt})).To(HaveField("Tenant", "acme"))
+		})
+
+		It("looks the user up in the tenant of the token", func() {
+			mockRepository.EXPECT().GetByUsername(gomock.Any(), "jane").DoAndReturn(func(ctx context.Context, username string) (entity.User, error) {
+				Expect(tenancy.Of(ctx)).To(Equal("globex"))
+				return entity.User{Id: userId, Username: username, Tenant: "globex"}, nil
+			})
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{Id: "jane", Method: reqctx.AuthMethodJwt, Tenant: "globex"})).
+				To(HaveField("UserId", HaveValue(Equal(userId))))
+		})
+
+		It("rejects a principal of another tenant than its user", func() {
+			mockRepository.EXPECT().GetById(gomock.Any(), userId).Return(entity.User{Id: userId, Username: "jane", Tenant: "acme"}, nil)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{UserId: &userId, Tenant: "globex"})).Error().To(Equal(errors.ErrUnauthorized))
+		})
+
+		It("keeps a token subject without a user", func() {
+			principal := reqctx.Principal{Id: "jane", Method: reqctx.AuthMethodJwt}
+			mockRepository.EXPECT().GetByUsername(gomock.Any(), "jane").Return(entity.User{}, errors.ErrNotFound)
+
+			Expect(usersSvc.Resolve(ctx, principal)).To(Equal(principal))
+		})
+
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetByUsername(gomock.Any(), "jane").Return(entity.User{}, customErr)
+
+			Expect(usersSvc.Resolve(ctx, reqctx.Principal{Id: "jane", Method: reqctx.AuthMethodJwt})).Error().To(Equal(customErr))
+		})
+	})
+
+})
@@ -0,0 +1,122 @@
+package controller_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/constants"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/controller"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/wiplimits/service"
+)
+
+var _ = Describe("Controller", func() {
+
+	const url = "http://url"
+
+	var (
+		recorder    *httptest.ResponseRecorder
+		mockCtrl    *gomock.Controller
+		mockService *serviceMock.MockService
+		limitsCtrl  controller.Controller
+	)
+
+	withLimitId := func(request *http.Request, value string) *http.Request {
+		return request.WithContext(reqctx.SetPathParam(request.Context(), constants.LimitId, value))
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		limitsCtrl = controller.New(controller.WithService(mockService))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(controller.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("responds with status NoContent when there are no limits", func() {
+			mockService.EXPECT().GetAll(gomock.Any()).Return(nil, nil)
+
+			limitsCtrl.GetAll(recorder, httptest.NewRequest("", url, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("Add", func() {
+		It("responds with status BadRequest when the status is unknown", func() {
+			limitsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"statusId":9,"limit":3}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetWipLimitResponse{}, err)
+
+				limitsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"statusId":1,"listId":7,"limit":3}`)))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("unknown list", errors.ErrInvalidArgument, http.StatusBadRequest),
+			Entry("duplicate scope", errors.ErrConflict, http.StatusConflict),
+			Entry("unexpected", fmt.Errorf("custom error"), http.StatusInternalServerError),
+		)
+
+		It("responds with status Created and the limit in the payload", func() {
+			mockService.EXPECT().Upsert(gomock.Any(), model.UpsertWipLimitRequest{StatusId: 1, Limit: 3}).
+				Return(model.GetWipLimitResponse{Id: 2, StatusId: 1, Limit: 3}, nil)
+
+			limitsCtrl.Add(recorder, httptest.NewRequest("", url, strings.NewReader(`{"statusId":1,"limit":3}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(HaveSuffix("/2"))
+		})
+	})
+
+	Describe("Update", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(model.GetWipLimitResponse{}, err)
+
+				limitsCtrl.Update(recorder, withLimitId(httptest.NewRequest("", url, strings.NewReader(`{"id":1,"statusId":1,"limit":4}`)), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+			Entry("not modified", errors.ErrNotModified, http.StatusNotModified),
+			Entry("duplicate scope", errors.ErrConflict, http.StatusConflict),
+		)
+	})
+
+	Describe("RemoveById", func() {
+		DescribeTable("maps the service errors",
+			func(err error, code int) {
+				mockService.EXPECT().RemoveById(gomock.Any(), 1).Return(err)
+
+				limitsCtrl.RemoveById(recorder, withLimitId(httptest.NewRequest("", url, nil), "1"))
+
+				Expect(recorder.Code).To(Equal(code))
+			},
+			Entry("forbidden", errors.ErrForbidden, http.StatusForbidden),
+			Entry("removed", nil, http.StatusNoContent),
+			Entry("not found", errors.ErrNotFound, http.StatusNotFound),
+		)
+	})
+
+})
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type WipLimit struct {
+	Id        int       `json:"id" gorm:"column:id;type:int;primaryKey;autoIncrement"`
+	StatusId  int       `json:"statusId" gorm:"column:status_id;type:int"`
+	ListId    *int      `json:"listId,omitempty" gorm:"column:list_id;type:int"`
+	Limit     int       `json:"limit" gorm:"column:limit;type:int"`
+	CreatedAt time.Time `json:"createdAt,omitempty" gorm:"column:created_at;type:timestamp"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at;type:timestamp"`
+}
+
+func (limit WipLimit) SameScope(other WipLimit) bool {
+	if limit.StatusId != other.StatusId {
+		return false
+	}
+	if limit.ListId == nil || other.ListId == nil {
+		return limit.ListId == nil && other.ListId == nil
+	}
+	return *limit.ListId == *other.ListId
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao/entity"
+)
+
+type Repository interface {
+	GetById(ctx context.Context, id int) (entity.WipLimit, error)
+	GetAll(ctx context.Context) ([]entity.WipLimit, error)
+	Insert(ctx context.Context, limit entity.WipLimit) (entity.WipLimit, error)
+	Update(ctx context.Context, limit entity.WipLimit) (entity.WipLimit, error)
+	RemoveById(ctx context.Context, id int) (entity.WipLimit, error)
+}
+
+type partition struct {
+	limits map[int]entity.WipLimit
+	seq    int
+}
+
+type memoryRepository struct {
+	mutex      sync.RWMutex
+	partitions *tenancy.Partitions[partition]
+}
+
+type RepositoryOption func(*memoryRepository)
+
+func New(options ...RepositoryOption) Repository {
+	instance := memoryRepository{
+		partitions: tenancy.NewPartitions(newPartition),
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithLimits(limits map[int]entity.WipLimit) RepositoryOption {
+	return func(repository *memoryRepository) {
+		if repository != nil && limits != nil {
+			repository.partitions.Of(tenancy.DefaultTenant).limits = limits
+		}
+	}
+}
+
+func newPartition() *partition {
+	return &partition{
+		limits: map[int]entity.WipLimit{},
+		seq:    0,
+	}
+}
+
+func (repo *memoryRepository) GetById(ctx context.Context, id int) (entity.WipLimit, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	limit, found := partition.limits[id]
+	if !found {
+		return entity.WipLimit{}, errors.ErrNotFound
+	}
+	return limit, nil
+}
+
+func (repo *memoryRepository) GetAll(ctx context.Context) ([]entity.WipLimit, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	var ids []int
+	var limits []entity.WipLimit
+	for _, limit := range partition.limits {
+		ids = append(ids, limit.Id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		limits = append(limits, partition.limits[id])
+	}
+
+	return limits, nil
+}
+
+func (repo *memoryRepository) Insert(ctx context.Context, limit entity.WipLimit) (entity.WipLimit, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	limit.Id = partition.seq
+	if partition.conflicts(limit) {
+		return entity.WipLimit{}, errors.ErrConflict
+	}
+
+	partition.seq++
+	limit.UpdatedAt = time.Now()
+	limit.CreatedAt = limit.UpdatedAt
+	partition.limits[limit.Id] = limit
+	return limit, nil
+}
+
+func (repo *memoryRepository) Update(ctx context.Context, limit entity.WipLimit) (entity.WipLimit, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	oldLimit, found := partition.limits[limit.Id]
+	if !found {
+		return entity.WipLimit{}, errors.ErrNotFound
+	}
+
+	if oldLimit.SameScope(limit) && oldLimit.Limit == limit.Limit {
+		return entity.WipLimit{}, errors.ErrNotModified
+	}
+
+	if partition.conflicts(limit) {
+		return entity.WipLimit{}, errors.ErrConflict
+	}
+
+	limit.UpdatedAt = time.Now()
+	limit.CreatedAt = oldLimit.CreatedAt
+	partition.limits[limit.Id] = limit
+	return limit, nil
+}
+
+func (repo *memoryRepository) RemoveById(ctx context.Context, id int) (entity.WipLimit, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	partition := repo.partitions.Get(ctx)
+
+	limit, found := partition.limits[id]
+	if !found {
+		return entity.WipLimit{}, errors.ErrNotFound
+	}
+	delete(partition.limits, id)
+	return limit, nil
+}
+
+func (partition *partition) conflicts(limit entity.WipLimit) bool {
+	for _, other := range partition.limits {
+		if other.Id != limit.Id && other.SameScope(limit) {
+			return true
+		}
+	}
+	return false
+}
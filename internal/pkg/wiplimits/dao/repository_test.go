@@ -0,0 +1,90 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/tenancy"
+	repository "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao/entity"
+)
+
+var _ = Describe("Repository", func() {
+
+	ctx := context.Background()
+
+	var repo repository.Repository
+
+	listId := func(id int) *int {
+		return &id
+	}
+
+	BeforeEach(func() {
+		repo = repository.New()
+	})
+
+	Describe("Insert", func() {
+		It("assigns increasing ids", func() {
+			Expect(repo.Insert(ctx, entity.WipLimit{StatusId: 1, Limit: 3})).To(HaveField("Id", 0))
+			Expect(repo.Insert(ctx, entity.WipLimit{StatusId: 1, ListId: listId(0), Limit: 2})).To(HaveField("Id", 1))
+			Expect(repo.GetAll(ctx)).To(HaveLen(2))
+		})
+
+		It("returns ErrConflict when the status already has a limit in the same scope", func() {
+			_, _ = repo.Insert(ctx, entity.WipLimit{StatusId: 1, ListId: listId(2), Limit: 3})
+
+			Expect(repo.Insert(ctx, entity.WipLimit{StatusId: 1, ListId: listId(2), Limit: 1})).Error().To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("Update", func() {
+		It("returns the updated limit", func() {
+			limit, _ := repo.Insert(ctx, entity.WipLimit{StatusId: 1, Limit: 3})
+			limit.Limit = 5
+
+			Expect(repo.Update(ctx, limit)).To(HaveField("Limit", 5))
+		})
+
+		It("returns ErrNotModified when nothing changes", func() {
+			limit, _ := repo.Insert(ctx, entity.WipLimit{StatusId: 1, ListId: listId(2), Limit: 3})
+
+			Expect(repo.Update(ctx, entity.WipLimit{Id: limit.Id, StatusId: 1, ListId: listId(2), Limit: 3})).Error().
+				To(Equal(errors.ErrNotModified))
+		})
+
+		It("returns ErrConflict when moved to the scope of another limit", func() {
+			_, _ = repo.Insert(ctx, entity.WipLimit{StatusId: 1, Limit: 3})
+			limit, _ := repo.Insert(ctx, entity.WipLimit{StatusId: 1, ListId: listId(2), Limit: 3})
+			limit.ListId = nil
+
+			Expect(repo.Update(ctx, limit)).Error().To(Equal(errors.ErrConflict))
+		})
+
+		It("returns ErrNotFound for an unknown limit", func() {
+			Expect(repo.Update(ctx, entity.WipLimit{Id: 42, Limit: 1})).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("removes the limit", func() {
+			limit, _ := repo.Insert(ctx, entity.WipLimit{StatusId: 1, Limit: 3})
+
+			Expect(repo.RemoveById(ctx, limit.Id)).Error().NotTo(HaveOccurred())
+			Expect(repo.GetById(ctx, limit.Id)).Error().To(Equal(errors.ErrNotFound))
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("isolates the limits of each tenant", func() {
+			acme := tenancy.With(ctx, "acme")
+			limit, _ := repo.Insert(ctx, entity.WipLimit{StatusId: 1, Limit: 3})
+
+			Expect(repo.GetById(acme, limit.Id)).Error().To(Equal(errors.ErrNotFound))
+			Expect(repo.Insert(acme, entity.WipLimit{StatusId: 1, Limit: 5})).To(HaveField("Id", limit.Id))
+		})
+	})
+
+})
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+)
+
+var _ = Describe("Model", func() {
+
+	anchor := func(id int) *int {
+		return &id
+	}
+
+	DescribeTable("UpsertWipLimitRequest.IsValid",
+		func(request model.UpsertWipLimitRequest, id *int, expected bool) {
+			Expect(request.IsValid(id)).To(Equal(expected))
+		},
+		Entry("a global limit", model.UpsertWipLimitRequest{StatusId: 1, Limit: 3}, nil, true),
+		Entry("a limit of a list", model.UpsertWipLimitRequest{StatusId: 1, ListId: anchor(2), Limit: 3}, nil, true),
+		Entry("an unknown status", model.UpsertWipLimitRequest{StatusId: 7, Limit: 3}, nil, false),
+		Entry("a negative limit", model.UpsertWipLimitRequest{StatusId: 1, Limit: -1}, nil, false),
+		Entry("an id on creation", model.UpsertWipLimitRequest{Id: anchor(1), StatusId: 1, Limit: 3}, nil, false),
+		Entry("a matching id on update", model.UpsertWipLimitRequest{Id: anchor(1), StatusId: 1, Limit: 3}, anchor(1), true),
+		Entry("another id on update", model.UpsertWipLimitRequest{Id: anchor(2), StatusId: 1, Limit: 3}, anchor(1), false),
+	)
+
+	Describe("ExceededError", func() {
+		It("describes the occupancy of a global limit", func() {
+			err := model.ExceededError{StatusId: 1, Limit: 3, Count: 3}
+
+			Expect(err.Error()).To(Equal("the WIP limit of status 1 in all lists is reached: 3 of 3 tasks"))
+		})
+
+		It("describes the occupancy of the limit of a list", func() {
+			err := model.ExceededError{StatusId: 1, ListId: anchor(2), Limit: 2, Count: 4}
+
+			Expect(err.Error()).To(Equal("the WIP limit of status 1 in list 2 is reached: 4 of 2 tasks"))
+		})
+	})
+
+})
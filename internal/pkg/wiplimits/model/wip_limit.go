@@ -0,0 +1,72 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	tasksEntity "github.com/aeon-fruit/dalil.git/internal/pkg/tasks/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao/entity"
+)
+
+type GetWipLimitResponse struct {
+	Id        int       `json:"id"`
+	StatusId  int       `json:"statusId"`
+	ListId    *int      `json:"listId,omitempty"`
+	Limit     int       `json:"limit"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+func EntityToGetWipLimitResponse(entity entity.WipLimit) GetWipLimitResponse {
+	return GetWipLimitResponse{
+		Id:        entity.Id,
+		StatusId:  entity.StatusId,
+		ListId:    entity.ListId,
+		Limit:     entity.Limit,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+type UpsertWipLimitRequest struct {
+	Id       *int `json:"id,omitempty"`
+	StatusId int  `json:"statusId"`
+	ListId   *int `json:"listId,omitempty"`
+	Limit    int  `json:"limit"`
+}
+
+func (dto UpsertWipLimitRequest) IsValid(id *int) bool {
+	return tasksEntity.IsValidStatus(dto.StatusId) &&
+		dto.Limit >= 0 &&
+		((id == nil && dto.Id == nil) ||
+			(id != nil && dto.Id != nil && *id == *dto.Id))
+}
+
+func (dto UpsertWipLimitRequest) ToEntity() entity.WipLimit {
+	var id int
+	if dto.Id != nil {
+		id = *dto.Id
+	}
+
+	return entity.WipLimit{
+		Id:       id,
+		StatusId: dto.StatusId,
+		ListId:   dto.ListId,
+		Limit:    dto.Limit,
+	}
+}
+
+type ExceededError struct {
+	StatusId int  `json:"statusId"`
+	ListId   *int `json:"listId,omitempty"`
+	Limit    int  `json:"limit"`
+	Count    int  `json:"count"`
+}
+
+func (err ExceededError) Error() string {
+	scope := "all lists"
+	if err.ListId != nil {
+		scope = fmt.Sprintf("list %d", *err.ListId)
+	}
+	return fmt.Sprintf("the WIP limit of status %d in %s is reached: %d of %d tasks", err.StatusId, scope, err.Count, err.Limit)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+)
+
+type authorizedService struct {
+	service Service
+	policy  policy.Policy
+}
+
+func Authorize(service Service, policy policy.Policy) Service {
+	if policy == nil {
+		return service
+	}
+
+	return &authorizedService{
+		service: service,
+		policy:  policy,
+	}
+}
+
+func (authorized *authorizedService) GetById(ctx context.Context, id int) (model.GetWipLimitResponse, error) {
+	limit, err := authorized.service.GetById(ctx, id)
+	if err != nil {
+		return model.GetWipLimitResponse{}, err
+	}
+
+	if limit.ListId != nil {
+		if err = authorized.policy.AuthorizeList(ctx, *limit.ListId, rolesEntity.RoleViewer); err != nil {
+			return model.GetWipLimitResponse{}, err
+		}
+	}
+	return limit, nil
+}
+
+func (authorized *authorizedService) GetAll(ctx context.Context) ([]model.GetWipLimitResponse, error) {
+	limits, err := authorized.service.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := authorized.policy.ReadableLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetWipLimitResponse
+	for _, limit := range limits {
+		if limit.ListId == nil || lists.Contains(*limit.ListId) {
+			dto = append(dto, limit)
+		}
+	}
+	return dto, nil
+}
+
+func (authorized *authorizedService) GetByStatus(ctx context.Context, statusId int) ([]model.GetWipLimitResponse, error) {
+	return authorized.service.GetByStatus(ctx, statusId)
+}
+
+func (authorized *authorizedService) Upsert(ctx context.Context, request model.UpsertWipLimitRequest) (model.GetWipLimitResponse, error) {
+	if request.Id != nil {
+		if err := authorized.authorizeExisting(ctx, *request.Id); err != nil {
+			return model.GetWipLimitResponse{}, err
+		}
+	}
+	if err := authorized.authorizeScope(ctx, request.ListId); err != nil {
+		return model.GetWipLimitResponse{}, err
+	}
+	return authorized.service.Upsert(ctx, request)
+}
+
+func (authorized *authorizedService) RemoveById(ctx context.Context, id int) error {
+	if err := authorized.authorizeExisting(ctx, id); err != nil {
+		return err
+	}
+	return authorized.service.RemoveById(ctx, id)
+}
+
+func (authorized *authorizedService) authorizeExisting(ctx context.Context, id int) error {
+	limit, err := authorized.service.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	return authorized.authorizeScope(ctx, limit.ListId)
+}
+
+func (authorized *authorizedService) authorizeScope(ctx context.Context, listId *int) error {
+	if listId != nil {
+		return authorized.policy.AuthorizeList(ctx, *listId, rolesEntity.RoleEditor)
+	}
+
+	if _, err := reqctx.GetPrincipal(ctx); err != nil || reqctx.IsAdmin(ctx) {
+		return nil
+	}
+	return errors.ErrForbidden
+}
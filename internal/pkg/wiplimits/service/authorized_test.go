@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	reqctx "github.com/aeon-fruit/dalil.git/internal/pkg/context/request"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/policy"
+	rolesDAO "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao"
+	rolesEntity "github.com/aeon-fruit/dalil.git/internal/pkg/roles/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/service"
+	policyMock "github.com/aeon-fruit/dalil.git/test/mocks/policy"
+	serviceMock "github.com/aeon-fruit/dalil.git/test/mocks/wiplimits/service"
+)
+
+var _ = Describe("Authorized service", func() {
+
+	ctx := context.Background()
+
+	var (
+		mockCtrl      *gomock.Controller
+		mockService   *serviceMock.MockService
+		mockPolicy    *policyMock.MockPolicy
+		authorizedSvc service.Service
+	)
+
+	intPtr := func(value int) *int {
+		return &value
+	}
+
+	userCtx := func(admin bool) context.Context {
+		userId := 2
+		return reqctx.SetAdmin(reqctx.SetPrincipal(ctx, reqctx.Principal{Id: "carol", UserId: &userId}), admin)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockService = serviceMock.NewMockService(mockCtrl)
+		mockPolicy = policyMock.NewMockPolicy(mockCtrl)
+		authorizedSvc = service.Authorize(mockService, mockPolicy)
+	})
+
+	It("returns the service as is without policy", func() {
+		Expect(service.Authorize(mockService, nil)).To(BeIdenticalTo(mockService))
+	})
+
+	It("requires the viewer role to get the limit of a list", func() {
+		mockService.EXPECT().GetById(ctx, 1).Return(model.GetWipLimitResponse{Id: 1, ListId: intPtr(3)}, nil)
+		mockPolicy.EXPECT().AuthorizeList(ctx, 3, rolesEntity.RoleViewer).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.GetById(ctx, 1)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("keeps the global limits and the limits of the readable lists", func() {
+		grants := rolesDAO.New(rolesDAO.WithGrants(rolesEntity.Grant{ListId: 1, UserId: 1, Role: rolesEntity.RoleOwner}))
+		authorizedSvc = service.Authorize(mockService, policy.New(policy.WithGrants(grants)))
+		mockService.EXPECT().GetAll(userCtx(false)).Return([]model.GetWipLimitResponse{
+			{Id: 0}, {Id: 1, ListId: intPtr(1)}, {Id: 2, ListId: intPtr(2)},
+		}, nil)
+
+		Expect(authorizedSvc.GetAll(userCtx(false))).To(HaveExactElements(HaveField("Id", 0), HaveField("Id", 2)))
+	})
+
+	It("requires the editor role to add a limit to a list", func() {
+		request := model.UpsertWipLimitRequest{StatusId: 1, ListId: intPtr(1), Limit: 1}
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Upsert(ctx, request)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the admin role to add a global limit", func() {
+		request := model.UpsertWipLimitRequest{StatusId: 1, Limit: 1}
+
+		Expect(authorizedSvc.Upsert(userCtx(false), request)).Error().To(Equal(errors.ErrForbidden))
+
+		mockService.EXPECT().Upsert(userCtx(true), request).Return(model.GetWipLimitResponse{Id: 4}, nil)
+
+		Expect(authorizedSvc.Upsert(userCtx(true), request)).To(HaveField("Id", 4))
+	})
+
+	It("requires the editor role on the current list of an updated limit", func() {
+		request := model.UpsertWipLimitRequest{Id: intPtr(1), StatusId: 1, ListId: intPtr(2), Limit: 1}
+		mockService.EXPECT().GetById(ctx, 1).Return(model.GetWipLimitResponse{Id: 1, ListId: intPtr(1)}, nil)
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(errors.ErrForbidden)
+
+		Expect(authorizedSvc.Upsert(ctx, request)).Error().To(Equal(errors.ErrForbidden))
+	})
+
+	It("requires the admin role to remove a global limit", func() {
+		mockService.EXPECT().GetById(userCtx(false), 1).Return(model.GetWipLimitResponse{Id: 1}, nil)
+
+		Expect(authorizedSvc.RemoveById(userCtx(false), 1)).To(Equal(errors.ErrForbidden))
+	})
+
+	It("removes the limit of a list with the editor role", func() {
+		mockService.EXPECT().GetById(ctx, 1).Return(model.GetWipLimitResponse{Id: 1, ListId: intPtr(1)}, nil)
+		mockPolicy.EXPECT().AuthorizeList(ctx, 1, rolesEntity.RoleEditor).Return(nil)
+		mockService.EXPECT().RemoveById(ctx, 1).Return(nil)
+
+		Expect(authorizedSvc.RemoveById(ctx, 1)).To(Succeed())
+	})
+
+})
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsDAO "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao"
+	dao "github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+)
+
+type Service interface {
+	GetById(ctx context.Context, id int) (model.GetWipLimitResponse, error)
+	GetAll(ctx context.Context) ([]model.GetWipLimitResponse, error)
+	GetByStatus(ctx context.Context, statusId int) ([]model.GetWipLimitResponse, error)
+	Upsert(ctx context.Context, request model.UpsertWipLimitRequest) (model.GetWipLimitResponse, error)
+	RemoveById(ctx context.Context, id int) error
+}
+
+type serviceImpl struct {
+	repository dao.Repository
+	lists      listsDAO.Repository
+}
+
+type ServiceOption func(*serviceImpl)
+
+func New(options ...ServiceOption) Service {
+	instance := serviceImpl{}
+
+	for _, option := range options {
+		if option != nil {
+			option(&instance)
+		}
+	}
+
+	return &instance
+}
+
+func WithRepository(repository dao.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.repository = repository
+		}
+	}
+}
+
+func WithLists(lists listsDAO.Repository) ServiceOption {
+	return func(service *serviceImpl) {
+		if service != nil {
+			service.lists = lists
+		}
+	}
+}
+
+func (service *serviceImpl) GetById(ctx context.Context, id int) (model.GetWipLimitResponse, error) {
+	limit, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		return model.GetWipLimitResponse{}, err
+	}
+	return model.EntityToGetWipLimitResponse(limit), nil
+}
+
+func (service *serviceImpl) GetAll(ctx context.Context) ([]model.GetWipLimitResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetWipLimitResponse
+	for _, limit := range entities {
+		dto = append(dto, model.EntityToGetWipLimitResponse(limit))
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) GetByStatus(ctx context.Context, statusId int) ([]model.GetWipLimitResponse, error) {
+	entities, err := service.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto []model.GetWipLimitResponse
+	for _, limit := range entities {
+		if limit.StatusId == statusId {
+			dto = append(dto, model.EntityToGetWipLimitResponse(limit))
+		}
+	}
+	return dto, nil
+}
+
+func (service *serviceImpl) Upsert(ctx context.Context, request model.UpsertWipLimitRequest) (model.GetWipLimitResponse, error) {
+	limit := request.ToEntity()
+
+	if limit.ListId != nil && service.lists != nil {
+		if _, err := service.lists.GetById(ctx, *limit.ListId); err == errors.ErrNotFound {
+			return model.GetWipLimitResponse{}, errors.ErrInvalidArgument
+		} else if err != nil {
+			return model.GetWipLimitResponse{}, err
+		}
+	}
+
+	var err error
+	if request.Id == nil {
+		limit, err = service.repository.Insert(ctx, limit)
+	} else {
+		limit, err = service.repository.Update(ctx, limit)
+	}
+
+	if err != nil {
+		return model.GetWipLimitResponse{}, err
+	}
+	return model.EntityToGetWipLimitResponse(limit), nil
+}
+
+func (service *serviceImpl) RemoveById(ctx context.Context, id int) error {
+	_, err := service.repository.RemoveById(ctx, id)
+	return err
+}
@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/aeon-fruit/dalil.git/internal/pkg/common/errors"
+	listsEntity "github.com/aeon-fruit/dalil.git/internal/pkg/lists/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/dao/entity"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/model"
+	"github.com/aeon-fruit/dalil.git/internal/pkg/wiplimits/service"
+	listsDaoMock "github.com/aeon-fruit/dalil.git/test/mocks/lists/dao"
+	daoMock "github.com/aeon-fruit/dalil.git/test/mocks/wiplimits/dao"
+)
+
+var _ = Describe("Service", func() {
+
+	ctx := context.Background()
+
+	var (
+		customErr      error
+		mockCtrl       *gomock.Controller
+		mockRepository *daoMock.MockRepository
+		mockLists      *listsDaoMock.MockRepository
+		limitsSvc      service.Service
+	)
+
+	listId := func(id int) *int {
+		return &id
+	}
+
+	BeforeEach(func() {
+		customErr = fmt.Errorf("custom error")
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepository = daoMock.NewMockRepository(mockCtrl)
+		mockLists = listsDaoMock.NewMockRepository(mockCtrl)
+		limitsSvc = service.New(service.WithRepository(mockRepository), service.WithLists(mockLists))
+	})
+
+	Describe("New", func() {
+		It("returns a non-nil instance", func() {
+			Expect(service.New()).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetByStatus", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return(nil, customErr)
+
+			Expect(limitsSvc.GetByStatus(ctx, 1)).Error().To(Equal(customErr))
+		})
+
+		It("returns the limits of the status only", func() {
+			mockRepository.EXPECT().GetAll(gomock.Any()).Return([]entity.WipLimit{
+				{Id: 0, StatusId: 1, Limit: 3},
+				{Id: 1, StatusId: 0, Limit: 10},
+				{Id: 2, StatusId: 1, ListId: listId(2), Limit: 1},
+			}, nil)
+
+			Expect(limitsSvc.GetByStatus(ctx, 1)).To(Equal([]model.GetWipLimitResponse{
+				{Id: 0, StatusId: 1, Limit: 3},
+				{Id: 2, StatusId: 1, ListId: listId(2), Limit: 1},
+			}))
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("returns ErrInvalidArgument when the list is not found", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 7).Return(listsEntity.List{}, errors.ErrNotFound)
+
+			Expect(limitsSvc.Upsert(ctx, model.UpsertWipLimitRequest{StatusId: 1, ListId: listId(7), Limit: 1})).Error().
+				To(Equal(errors.ErrInvalidArgument))
+		})
+
+		It("inserts a new limit", func() {
+			mockLists.EXPECT().GetById(gomock.Any(), 2).Return(listsEntity.List{Id: 2}, nil)
+			mockRepository.EXPECT().Insert(gomock.Any(), entity.WipLimit{StatusId: 1, ListId: listId(2), Limit: 1}).
+				Return(entity.WipLimit{Id: 4, StatusId: 1, ListId: listId(2), Limit: 1}, nil)
+
+			Expect(limitsSvc.Upsert(ctx, model.UpsertWipLimitRequest{StatusId: 1, ListId: listId(2), Limit: 1})).
+				To(HaveField("Id", 4))
+		})
+
+		It("updates an existing limit", func() {
+			mockRepository.EXPECT().Update(gomock.Any(), entity.WipLimit{Id: 4, StatusId: 1, Limit: 5}).Return(entity.WipLimit{}, errors.ErrConflict)
+
+			Expect(limitsSvc.Upsert(ctx, model.UpsertWipLimitRequest{Id: listId(4), StatusId: 1, Limit: 5})).Error().
+				To(Equal(errors.ErrConflict))
+		})
+	})
+
+	Describe("RemoveById", func() {
+		It("returns the error of the repository", func() {
+			mockRepository.EXPECT().RemoveById(gomock.Any(), 4).Return(entity.WipLimit{}, errors.ErrNotFound)
+
+			Expect(limitsSvc.RemoveById(ctx, 4)).To(Equal(errors.ErrNotFound))
+		})
+	})
+
+})